@@ -1,6 +1,8 @@
 package r8e
 
 import (
+	"context"
+	"errors"
 	"sync"
 	"time"
 )
@@ -34,6 +36,16 @@ type (
 	// while in-flight is at the current limit are rejected with
 	// [ErrConcurrencyLimited]; the original request still surfaces that error.
 	//
+	// Under a policy, a call that times out on a deadline the policy set
+	// ([ErrTimeout], or a [context.DeadlineExceeded] from the policy's
+	// [WithTimeout] or a [PerAttemptTimeout]) is a drop: rather than folding its
+	// truncated RTT into the baseline, the limit is cut multiplicatively (AIMD),
+	// so a downstream that stops answering sheds concurrency at once instead of
+	// waiting for the latency gradient to catch up. The caller's own deadline
+	// expiring says nothing about the downstream and is not a drop, and other
+	// errors are ordinary latency samples — a fast 404 says nothing about
+	// downstream saturation.
+	//
 	// The limit only grows while the limiter is actually loaded (in-flight at or
 	// above half the limit), so a quiet service is never pushed to probe higher,
 	// and it never leaves the configured [MinLimit, MaxLimit] band. Because it is
@@ -71,12 +83,15 @@ const (
 	adaptiveQueueSize  = 4.0
 	adaptiveSmoothing  = 0.2
 	adaptiveLongWindow = 600.0
+	// adaptiveDropRatio is the multiplicative decrease applied when a call
+	// times out, matching Netflix's AIMD backoff ratio.
+	adaptiveDropRatio = 0.9
 
 	// Default limiter parameters. minLimit is 1 (not Netflix's 20) so the limiter
 	// is usable for small services; the band can be widened with options.
-	defaultInitialLimit  = 20
-	defaultMinLimit      = 1
-	defaultMaxLimit      = 200
+	defaultInitialLimit = 20
+	defaultMinLimit     = 1
+	defaultMaxLimit     = 200
 	defaultRTTTolerance = 1.5
 	minRTTTolerance     = 1.0
 )
@@ -171,6 +186,17 @@ func (c *adaptiveConfig) clamp() {
 // Returning a closure rather than a raw start time keeps the timing token
 // internal, so it cannot be lost or mis-threaded by the caller.
 func (a *AdaptiveLimiter) Acquire() (func(), error) {
+	done, err := a.acquire()
+	if err != nil {
+		return nil, err
+	}
+
+	return func() { done(false) }, nil
+}
+
+// acquire is Acquire with an outcome-aware completion: the policy reports
+// whether the call timed out, so it is treated as a drop (see isLimiterDrop).
+func (a *AdaptiveLimiter) acquire() (func(drop bool), error) {
 	start, ok := a.admit()
 	if !ok {
 		a.hooks.emitConcurrencyRejected()
@@ -178,7 +204,7 @@ func (a *AdaptiveLimiter) Acquire() (func(), error) {
 		return nil, ErrConcurrencyLimited
 	}
 
-	return func() { a.complete(start) }, nil
+	return func(drop bool) { a.complete(start, drop) }, nil
 }
 
 // admit increments in-flight and returns the admission time if a slot is free.
@@ -197,13 +223,22 @@ func (a *AdaptiveLimiter) admit() (time.Time, bool) {
 	return a.clock.Now(), true
 }
 
-// complete folds a finished call's round-trip time into the controller. The
-// OnConcurrencyLimitChanged hook is emitted after the lock is released, on the
-// limit captured under it, so no user callback runs under the mutex.
-func (a *AdaptiveLimiter) complete(start time.Time) {
-	rtt := a.clock.Since(start)
+// complete folds a finished call into the controller: a drop (see
+// isLimiterDrop) cuts the limit, anything else contributes its round-trip time.
+// The OnConcurrencyLimitChanged hook is emitted after the lock is released, on
+// the limit captured under it, so no user callback runs under the mutex.
+func (a *AdaptiveLimiter) complete(start time.Time, drop bool) {
+	var (
+		newLimit int
+		changed  bool
+	)
+
+	if drop {
+		newLimit, changed = a.drop()
+	} else {
+		newLimit, changed = a.observe(a.clock.Since(start))
+	}
 
-	newLimit, changed := a.observe(rtt)
 	if changed {
 		a.hooks.emitConcurrencyLimitChanged(newLimit)
 	}
@@ -225,6 +260,39 @@ func (a *AdaptiveLimiter) observe(rtt time.Duration) (int, bool) {
 	return newLimit, newLimit != oldLimit
 }
 
+// drop decrements in-flight and cuts the limit by adaptiveDropRatio under the
+// lock, returning the new integer limit and whether it moved. The limit never
+// leaves the [minLimit, maxLimit] band.
+func (a *AdaptiveLimiter) drop() (int, bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.inFlight--
+	oldLimit := int(a.limit)
+	a.limit = max(a.limit*adaptiveDropRatio, a.minLimit)
+	newLimit := int(a.limit)
+
+	return newLimit, newLimit != oldLimit
+}
+
+// isLimiterDrop reports whether a call run under ctx and failing with err
+// timed out on a deadline the policy set, so the downstream stopped answering
+// in time: [ErrTimeout], a deadline set inside the limiter (a per-attempt
+// timeout) expiring while ctx is live, or ctx itself expiring on the policy's
+// [WithTimeout], whose cause is ErrTimeout. The caller's own deadline is not a
+// drop.
+func isLimiterDrop(ctx context.Context, err error) bool {
+	if errors.Is(err, ErrTimeout) {
+		return true
+	}
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	return ctx.Err() == nil || errors.Is(context.Cause(ctx), ErrTimeout)
+}
+
 // recompute runs one Gradient2 control step: fold the sample into the latency
 // baseline, then, while the limiter is loaded, adjust the limit from the latency
 // gradient. The limit is left unchanged while app-limited (in-flight under half
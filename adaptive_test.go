@@ -7,6 +7,7 @@ import (
 	"sync"
 	"sync/atomic"
 	"testing"
+	"testing/synctest"
 	"time"
 
 	"github.com/stretchr/testify/assert"
@@ -261,7 +262,7 @@ func TestAdaptiveRecordEmitsLimitChanged(t *testing.T) {
 	a.inFlight = 21
 
 	clk.setElapsed(5 * time.Millisecond)
-	a.complete(time.Time{})
+	a.complete(time.Time{}, false)
 
 	require.Len(t, changed, 1)
 	assert.Equal(t, 21, changed[0])
@@ -286,7 +287,7 @@ func TestAdaptiveCompleteEmitsLimitDecrease(t *testing.T) {
 	a.inFlight = 50
 
 	clk.setElapsed(100 * time.Millisecond)
-	a.complete(time.Time{})
+	a.complete(time.Time{}, false)
 
 	require.Len(t, changed, 1)
 	assert.Less(t, changed[0], 50)
@@ -392,6 +393,199 @@ func TestAdaptiveConcurrentAcquireRecordRaceSafe(t *testing.T) {
 	assert.GreaterOrEqual(t, a.Limit(), 1)
 }
 
+// ---------------------------------------------------------------------------
+// Latency-driven contraction and recovery, timeout drops
+// ---------------------------------------------------------------------------
+
+// driveAdaptive runs rounds of fully loaded traffic through the limiter: each
+// round fills every slot, then completes them all with the given RTT, as drops
+// when drop is set.
+func driveAdaptive(
+	t *testing.T,
+	a *AdaptiveLimiter,
+	clk *stubClock,
+	rtt time.Duration,
+	drop bool,
+	rounds int,
+) {
+	t.Helper()
+
+	clk.setElapsed(rtt)
+
+	for range rounds {
+		var done []func(bool)
+
+		for {
+			d, acqErr := a.acquire()
+			if acqErr != nil {
+				break
+			}
+
+			done = append(done, d)
+		}
+
+		for _, d := range done {
+			d(drop)
+		}
+	}
+}
+
+func TestAdaptiveContractsOnRisingLatencyThenRecovers(t *testing.T) {
+	t.Parallel()
+
+	clk := &stubClock{}
+	a := NewAdaptiveLimiter(clk, &Hooks{}, InitialLimit(10), MinLimit(2), MaxLimit(40))
+
+	// Healthy, steady latency under load: the limit grows to the ceiling.
+	driveAdaptive(t, a, clk, 10*time.Millisecond, false, 100)
+	require.Equal(t, 40, a.Limit())
+
+	// Latency climbs 10x: queueing downstream, the limit contracts.
+	driveAdaptive(t, a, clk, 100*time.Millisecond, false, 20)
+	contracted := a.Limit()
+	assert.Less(t, contracted, 40)
+
+	// Latency drops back: the limit recovers.
+	driveAdaptive(t, a, clk, 10*time.Millisecond, false, 100)
+	assert.Greater(t, a.Limit(), contracted)
+	assert.Zero(t, a.InFlight())
+}
+
+func TestAdaptiveTimeoutCutsLimit(t *testing.T) {
+	t.Parallel()
+
+	var changes []int
+
+	clk := &stubClock{}
+	a := NewAdaptiveLimiter(clk, &Hooks{
+		OnConcurrencyLimitChanged: func(n int) { changes = append(changes, n) },
+	}, InitialLimit(20), MinLimit(5))
+
+	done, err := a.acquire()
+	require.NoError(t, err)
+	done(true)
+
+	assert.Equal(t, 18, a.Limit())
+	assert.Equal(t, []int{18}, changes)
+	assert.Zero(t, a.InFlight())
+
+	// Repeated drops never cut below the floor.
+	driveAdaptive(t, a, clk, time.Millisecond, true, 30)
+	assert.Equal(t, 5, a.Limit())
+}
+
+func TestIsLimiterDrop(t *testing.T) {
+	t.Parallel()
+
+	live := context.Background()
+
+	expired, cancel := context.WithDeadline(live, time.Unix(0, 0))
+	defer cancel()
+
+	timedOut, cancelTimeout := context.WithDeadlineCause(live, time.Unix(0, 0), ErrTimeout)
+	defer cancelTimeout()
+
+	wrapped := &RetryError{Errors: []error{context.DeadlineExceeded}}
+
+	tests := []struct {
+		ctx  context.Context //nolint:containedctx // table input
+		err  error
+		name string
+		want bool
+	}{
+		{name: "policy timeout error", ctx: live, err: ErrTimeout, want: true},
+		{name: "inner deadline", ctx: live, err: wrapped, want: true},
+		{name: "policy timeout deadline", ctx: timedOut, err: context.DeadlineExceeded, want: true},
+		{name: "caller deadline", ctx: expired, err: context.DeadlineExceeded, want: false},
+		{name: "ordinary error", ctx: live, err: ErrCircuitOpen, want: false},
+		{name: "success", ctx: live, err: nil, want: false},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, isLimiterDrop(tt.ctx, tt.err), tt.name)
+	}
+}
+
+func TestAdaptiveBulkheadDropsOnlyOnPolicyDeadlines(t *testing.T) {
+	t.Parallel()
+
+	// waitCtx blocks until ctx ends, as a downstream that stopped answering.
+	waitCtx := func(ctx context.Context) (string, error) {
+		<-ctx.Done()
+
+		return "", ctx.Err()
+	}
+
+	run := func(t *testing.T, callerTimeout time.Duration, opts ...Option) int {
+		t.Helper()
+
+		var limit int
+
+		synctest.Test(t, func(t *testing.T) {
+			p := NewPolicy[string]("", append(opts, WithAdaptiveBulkhead(5, 20))...)
+
+			ctx, cancel := context.WithTimeout(context.Background(), callerTimeout)
+			defer cancel()
+
+			_, err := p.Do(ctx, waitCtx)
+			require.Error(t, err)
+
+			synctest.Wait()
+
+			limit, _ = p.ConcurrencyLimit()
+		})
+
+		return limit
+	}
+
+	t.Run("caller deadline", func(t *testing.T) {
+		t.Parallel()
+		assert.Equal(t, 20, run(t, 10*time.Millisecond))
+	})
+
+	t.Run("policy timeout", func(t *testing.T) {
+		t.Parallel()
+		assert.Equal(t, 18, run(t, time.Hour, WithTimeout(10*time.Millisecond)))
+	})
+
+	t.Run("per-attempt timeout", func(t *testing.T) {
+		t.Parallel()
+		assert.Equal(t, 18, run(t, time.Hour,
+			WithRetry(1, ConstantBackoff(0), PerAttemptTimeout(10*time.Millisecond))))
+	})
+}
+
+func TestPolicyConcurrencyLimit(t *testing.T) {
+	t.Parallel()
+
+	_, ok := NewPolicy[string]("").ConcurrencyLimit()
+	assert.False(t, ok)
+
+	limit, ok := NewPolicy[string]("", WithBulkhead(4)).ConcurrencyLimit()
+	assert.True(t, ok)
+	assert.Equal(t, 4, limit)
+
+	limit, ok = NewPolicy[string]("", WithAdaptiveBulkhead(3, 7)).ConcurrencyLimit()
+	assert.True(t, ok)
+	assert.Equal(t, 7, limit)
+}
+
+func TestWithAdaptiveBulkheadSetsBand(t *testing.T) {
+	t.Parallel()
+
+	p := NewPolicy[string]("", WithAdaptiveBulkhead(3, 7))
+
+	require.NotNil(t, p.adaptive)
+	assert.InDelta(t, 3.0, p.adaptive.minLimit, 1e-9)
+	assert.InDelta(t, 7.0, p.adaptive.maxLimit, 1e-9)
+	assert.Equal(t, 7, p.adaptive.Limit()) // default initial clamped into band
+	assert.Equal(t, int64(7), p.Metrics().ConcurrencyLimit)
+
+	assert.PanicsWithValue(t, ErrConcurrencyLimiterConflict, func() {
+		_ = NewPolicy[string]("", WithBulkhead(5), WithAdaptiveBulkhead(1, 5))
+	})
+}
+
 // ---------------------------------------------------------------------------
 // Policy integration
 // ---------------------------------------------------------------------------
@@ -526,8 +720,8 @@ func TestReconfigureAdaptiveAbsent(t *testing.T) {
 // loop is most likely to break on degenerate inputs (zero, huge, negative).
 func FuzzAdaptiveRecompute(f *testing.F) {
 	f.Add([]byte{})
-	f.Add([]byte{0, 0, 0, 0, 0, 0, 0, 10, 0, 0, 0, 50})            // 10ns rtt, inflight 50
-	f.Add([]byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0})              // zero rtt, zero inflight
+	f.Add([]byte{0, 0, 0, 0, 0, 0, 0, 10, 0, 0, 0, 50})               // 10ns rtt, inflight 50
+	f.Add([]byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0})                 // zero rtt, zero inflight
 	f.Add([]byte{255, 255, 255, 255, 255, 255, 255, 255, 0, 0, 0, 1}) // -1ns rtt
 
 	f.Fuzz(func(t *testing.T, data []byte) {
@@ -0,0 +1,38 @@
+package r8e
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+// Pattern: Attempt Hooks — observes every invocation of the user function,
+// whichever pattern drives it (a retry, a hedge, or the single first try), so
+// latency can be attributed per attempt rather than per Do call.
+
+// newAttemptEntry builds the middleware that fires [Hooks.OnAttemptStart] and
+// [Hooks.OnAttemptEnd] around each call to the user function. It sits inside
+// retry and hedge, so every retry and every hedged copy is an attempt, and
+// outside [WithRecover], so a recovered panic ends its attempt with the
+// [ErrPanic] error. Attempts are numbered from 1 per Do call: the counter lives
+// in the closure Do composes for that call, and is atomic because hedged
+// attempts run concurrently. The duration is measured on the policy's clock.
+func newAttemptEntry[T any](clock Clock, hooks *Hooks) PatternEntry[T] {
+	return PatternEntry[T]{
+		Priority: priorityAttempt,
+		Name:     "attempts",
+		MW: func(next func(context.Context) (T, error)) func(context.Context) (T, error) {
+			var attempts atomic.Int64
+
+			return func(ctx context.Context) (T, error) {
+				attempt := int(attempts.Add(1))
+				hooks.emitAttemptStart(attempt)
+
+				start := clock.Now()
+				result, err := next(ctx)
+				hooks.emitAttemptEnd(attempt, clock.Since(start), err)
+
+				return result, err
+			}
+		},
+	}
+}
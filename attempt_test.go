@@ -0,0 +1,133 @@
+package r8e_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/byte4ever/r8e"
+	"github.com/byte4ever/r8e/internal/clocktest"
+)
+
+// attemptRecord is one OnAttemptEnd callback.
+type attemptRecord struct {
+	err     error
+	attempt int
+	d       time.Duration
+}
+
+// attemptRecorder collects the attempt hook pair for assertions.
+type attemptRecorder struct {
+	starts []int
+	ends   []attemptRecord
+	mu     sync.Mutex
+}
+
+func (r *attemptRecorder) hooks() *r8e.Hooks {
+	return &r8e.Hooks{
+		OnAttemptStart: func(attempt int) {
+			r.mu.Lock()
+			defer r.mu.Unlock()
+
+			r.starts = append(r.starts, attempt)
+		},
+		OnAttemptEnd: func(attempt int, d time.Duration, err error) {
+			r.mu.Lock()
+			defer r.mu.Unlock()
+
+			r.ends = append(r.ends, attemptRecord{attempt: attempt, d: d, err: err})
+		},
+	}
+}
+
+func TestAttemptHooksFireOncePerRetryAttempt(t *testing.T) {
+	t.Parallel()
+
+	clock := clocktest.New()
+	rec := &attemptRecorder{}
+	p := r8e.NewPolicy[string]("",
+		r8e.WithClock(clock),
+		r8e.WithHooks(rec.hooks()),
+		r8e.WithRetry(3, r8e.ConstantBackoff(time.Second)),
+	)
+
+	errDown := errors.New("down")
+	calls := 0
+
+	got, err := p.Do(context.Background(), func(context.Context) (string, error) {
+		calls++
+		// Each attempt takes 10ms longer than the previous one.
+		clock.Advance(time.Duration(calls) * 10 * time.Millisecond)
+
+		if calls < 3 {
+			return "", errDown
+		}
+
+		return "ok", nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "ok", got)
+
+	assert.Equal(t, []int{1, 2, 3}, rec.starts)
+	assert.Equal(t, []attemptRecord{
+		{attempt: 1, d: 10 * time.Millisecond, err: errDown},
+		{attempt: 2, d: 20 * time.Millisecond, err: errDown},
+		{attempt: 3, d: 30 * time.Millisecond},
+	}, rec.ends, "the backoff between attempts is not counted")
+}
+
+func TestAttemptHooksNumberEachCallFromOne(t *testing.T) {
+	t.Parallel()
+
+	rec := &attemptRecorder{}
+	p := r8e.NewPolicy[string]("",
+		r8e.WithClock(clocktest.New()),
+		r8e.WithHooks(rec.hooks()),
+		r8e.WithTimeout(time.Second),
+	)
+
+	for range 2 {
+		_, err := p.Do(context.Background(), func(context.Context) (string, error) {
+			return "ok", nil
+		})
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, []int{1, 1}, rec.starts)
+	require.Len(t, rec.ends, 2)
+	assert.Equal(t, 1, rec.ends[1].attempt)
+	assert.Contains(t, p.Patterns(), "attempts")
+}
+
+func TestAttemptHooksSeeRecoveredPanic(t *testing.T) {
+	t.Parallel()
+
+	rec := &attemptRecorder{}
+	p := r8e.NewPolicy[string]("",
+		r8e.WithClock(clocktest.New()),
+		r8e.WithHooks(rec.hooks()),
+		r8e.WithRecover(),
+	)
+
+	_, err := p.Do(context.Background(), func(context.Context) (string, error) {
+		panic("boom")
+	})
+	require.ErrorIs(t, err, r8e.ErrPanic)
+
+	require.Len(t, rec.ends, 1)
+	require.ErrorIs(t, rec.ends[0].err, r8e.ErrPanic,
+		"the attempt ends with the recovered panic")
+}
+
+func TestAttemptHooksAbsentWithoutHooks(t *testing.T) {
+	t.Parallel()
+
+	p := r8e.NewPolicy[string]("", r8e.WithTimeout(time.Second))
+
+	assert.Equal(t, []string{"timeout"}, p.Patterns())
+}
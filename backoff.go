@@ -11,7 +11,7 @@ type (
 	//
 	// Pattern: Strategy — swap backoff algorithms (constant, exponential,
 	// linear,
-	// jitter) without changing retry logic.
+	// jitter — exponential, full, equal) without changing retry logic.
 	BackoffStrategy interface {
 		// Delay returns the duration to wait before the given retry attempt
 		// (0-indexed: attempt 0 is the delay before the first retry).
@@ -43,6 +43,24 @@ type (
 	exponentialJitterBackoff struct {
 		base time.Duration
 	}
+
+	// jitterBackoff draws each delay around the exponential value base *
+	// 2^attempt, capped by [MaxDelay] when one is set: uniformly in [0, exp]
+	// for full jitter, in [exp/2, exp] for equal jitter. randN returns a
+	// uniform int64 in [0, n); it is rand.Int64N in production and a
+	// deterministic source in tests.
+	jitterBackoff struct {
+		randN func(n int64) int64
+		base  time.Duration
+		equal bool
+	}
+
+	// cappedBackoff is implemented by strategies that must apply [MaxDelay]
+	// before drawing their delay rather than clamp the drawn one, so the cap
+	// bounds the range jitter spreads over instead of piling delays up at it.
+	cappedBackoff interface {
+		cappedDelay(attempt int, maxDelay time.Duration) time.Duration
+	}
 )
 
 // maxDurationFloat is math.MaxInt64 (the largest time.Duration) as a float64. A
@@ -148,3 +166,62 @@ func (b *exponentialJitterBackoff) Delay(attempt int) time.Duration {
 func ExponentialJitterBackoff(base time.Duration) BackoffStrategy {
 	return &exponentialJitterBackoff{base: base}
 }
+
+// ---------------------------------------------------------------------------
+// FullJitterBackoff / EqualJitterBackoff
+// ---------------------------------------------------------------------------.
+
+func (b *jitterBackoff) Delay(attempt int) time.Duration {
+	return b.cappedDelay(attempt, 0)
+}
+
+// cappedDelay draws the delay for attempt over the exponential value capped at
+// maxDelay (uncapped when maxDelay is not positive).
+func (b *jitterBackoff) cappedDelay(attempt int, maxDelay time.Duration) time.Duration {
+	ceiling := clampDuration(float64(b.base) * math.Pow(2, float64(attempt)))
+	if maxDelay > 0 {
+		ceiling = min(ceiling, maxDelay)
+	}
+
+	if ceiling <= 0 {
+		return 0
+	}
+
+	floor := time.Duration(0)
+	if b.equal {
+		floor = ceiling / 2
+	}
+
+	// The spread is inclusive of the ceiling, except at MaxInt64 where n + 1
+	// would overflow.
+	spread := int64(ceiling - floor)
+	if spread < math.MaxInt64 {
+		spread++
+	}
+
+	return floor + time.Duration(b.randN(spread))
+}
+
+// FullJitterBackoff returns a [BackoffStrategy] whose delay is drawn uniformly
+// in [0, base * 2^attempt] — "full jitter" in the AWS taxonomy. It spreads
+// retries the most, at the cost of sometimes retrying at once. With
+// [MaxDelay] the cap bounds the range before the draw, so delays stay uniform
+// in [0, MaxDelay] rather than piling up at the cap.
+//
+//nolint:ireturn,iface // each backoff function returns a distinct
+// implementation of BackoffStrategy.
+func FullJitterBackoff(base time.Duration) BackoffStrategy {
+	return &jitterBackoff{base: base, randN: rand.Int64N}
+}
+
+// EqualJitterBackoff returns a [BackoffStrategy] whose delay keeps half the
+// exponential value and draws the other half at random: exp/2 + random[0,
+// exp/2] with exp = base * 2^attempt — "equal jitter" in the AWS taxonomy. It
+// never retries sooner than half the exponential delay. With [MaxDelay] the
+// cap bounds exp before the draw.
+//
+//nolint:ireturn,iface // each backoff function returns a distinct
+// implementation of BackoffStrategy.
+func EqualJitterBackoff(base time.Duration) BackoffStrategy {
+	return &jitterBackoff{base: base, equal: true, randN: rand.Int64N}
+}
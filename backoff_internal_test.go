@@ -0,0 +1,77 @@
+package r8e
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fixedRand returns a jitter source that always draws pick(n) and records
+// the last bound it was asked for.
+func fixedRand(pick func(n int64) int64, lastN *int64) func(int64) int64 {
+	return func(n int64) int64 {
+		*lastN = n
+
+		return pick(n)
+	}
+}
+
+func lowest(int64) int64    { return 0 }
+func highest(n int64) int64 { return n - 1 }
+
+func TestFullJitterBackoffCanReturnZero(t *testing.T) {
+	t.Parallel()
+
+	var n int64
+
+	b := &jitterBackoff{base: 100 * time.Millisecond, randN: fixedRand(lowest, &n)}
+	require.Equal(t, time.Duration(0), b.Delay(3))
+
+	// The range is inclusive of the exponential value.
+	b.randN = fixedRand(highest, &n)
+	require.Equal(t, 800*time.Millisecond, b.Delay(3))
+	require.Equal(t, int64(800*time.Millisecond)+1, n)
+}
+
+func TestEqualJitterBackoffFloorsAtHalf(t *testing.T) {
+	t.Parallel()
+
+	var n int64
+
+	b := &jitterBackoff{base: 100 * time.Millisecond, equal: true, randN: fixedRand(lowest, &n)}
+	require.Equal(t, 400*time.Millisecond, b.Delay(3))
+
+	b.randN = fixedRand(highest, &n)
+	require.Equal(t, 800*time.Millisecond, b.Delay(3))
+	require.Equal(t, int64(400*time.Millisecond)+1, n)
+}
+
+func TestJitterBackoffCappedBeforeDraw(t *testing.T) {
+	t.Parallel()
+
+	var n int64
+
+	full := &jitterBackoff{base: time.Second, randN: fixedRand(highest, &n)}
+	equal := &jitterBackoff{base: time.Second, equal: true, randN: fixedRand(lowest, &n)}
+
+	// MaxDelay bounds the range the delay is drawn from.
+	delay := nextBackoffDelay(10, errors.New("boom"), full, retryConfig{maxDelay: 5 * time.Second})
+	require.Equal(t, 5*time.Second, delay)
+	require.Equal(t, int64(5*time.Second)+1, n)
+
+	delay = nextBackoffDelay(10, errors.New("boom"), equal, retryConfig{maxDelay: 5 * time.Second})
+	require.Equal(t, 2500*time.Millisecond, delay, "half the capped value, not half the raw one")
+}
+
+func TestJitterBackoffHugeAttemptDoesNotOverflow(t *testing.T) {
+	t.Parallel()
+
+	var n int64
+
+	b := &jitterBackoff{base: time.Second, randN: fixedRand(highest, &n)}
+	require.Equal(t, time.Duration(math.MaxInt64-1), b.Delay(1000))
+	require.Equal(t, int64(math.MaxInt64), n)
+}
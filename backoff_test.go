@@ -21,6 +21,8 @@ func TestBackoffStrategyInterfaceCompliance(t *testing.T) {
 	var _ r8e.BackoffStrategy = r8e.ExponentialBackoff(time.Second)
 	var _ r8e.BackoffStrategy = r8e.LinearBackoff(time.Second)
 	var _ r8e.BackoffStrategy = r8e.ExponentialJitterBackoff(time.Second)
+	var _ r8e.BackoffStrategy = r8e.FullJitterBackoff(time.Second)
+	var _ r8e.BackoffStrategy = r8e.EqualJitterBackoff(time.Second)
 	var _ r8e.BackoffStrategy = r8e.BackoffFunc(func(attempt int) time.Duration {
 		return time.Second
 	})
@@ -139,6 +141,60 @@ func TestExponentialJitterBackoffZeroBase(t *testing.T) {
 	}
 }
 
+// ---------------------------------------------------------------------------
+// FullJitterBackoff / EqualJitterBackoff
+// ---------------------------------------------------------------------------
+
+func TestFullJitterBackoffRange(t *testing.T) {
+	t.Parallel()
+
+	base := 100 * time.Millisecond
+	b := r8e.FullJitterBackoff(base)
+
+	for attempt := range 5 {
+		exp := time.Duration(float64(base) * math.Pow(2, float64(attempt)))
+
+		for range 200 {
+			got := b.Delay(attempt)
+			require.GreaterOrEqualf(t, got, time.Duration(0), "attempt %d", attempt)
+			require.LessOrEqualf(t, got, exp, "attempt %d", attempt)
+		}
+	}
+}
+
+func TestEqualJitterBackoffNeverBelowHalf(t *testing.T) {
+	t.Parallel()
+
+	base := 100 * time.Millisecond
+	b := r8e.EqualJitterBackoff(base)
+
+	for attempt := range 5 {
+		exp := time.Duration(float64(base) * math.Pow(2, float64(attempt)))
+
+		var sawAboveHalf bool
+
+		for range 200 {
+			got := b.Delay(attempt)
+			require.GreaterOrEqualf(t, got, exp/2, "attempt %d", attempt)
+			require.LessOrEqualf(t, got, exp, "attempt %d", attempt)
+
+			sawAboveHalf = sawAboveHalf || got > exp/2
+		}
+
+		require.Truef(t, sawAboveHalf, "attempt %d: jitter always returned exp/2", attempt)
+	}
+}
+
+func TestJitterBackoffZeroBase(t *testing.T) {
+	t.Parallel()
+
+	for _, b := range []r8e.BackoffStrategy{r8e.FullJitterBackoff(0), r8e.EqualJitterBackoff(0)} {
+		for attempt := range 5 {
+			require.Equalf(t, time.Duration(0), b.Delay(attempt), "attempt %d", attempt)
+		}
+	}
+}
+
 // ---------------------------------------------------------------------------
 // BackoffFunc
 // ---------------------------------------------------------------------------
@@ -204,6 +260,8 @@ func FuzzBackoffDelay(f *testing.F) {
 		"exponential": r8e.ExponentialBackoff(base),
 		"linear":      r8e.LinearBackoff(base),
 		"jitter":      r8e.ExponentialJitterBackoff(base),
+		"full":        r8e.FullJitterBackoff(base),
+		"equal":       r8e.EqualJitterBackoff(base),
 	}
 
 	f.Fuzz(func(t *testing.T, attempt int) {
@@ -0,0 +1,73 @@
+package r8e
+
+import (
+	"sync"
+	"time"
+)
+
+// Pattern: Registry — config names a backoff strategy by string; the names
+// resolve through a process-wide table that ships with the built-ins and that
+// [RegisterBackoff] extends, so a custom strategy is as config-expressible as
+// a built-in one.
+
+// BackoffFactory builds a named backoff strategy for [RegisterBackoff]. base is
+// the config's base_delay and maxDelay its max_delay (0 when unset). The retry
+// pattern caps every delay at maxDelay itself, so a factory may ignore it.
+type BackoffFactory func(base, maxDelay time.Duration) BackoffStrategy
+
+// backoffRegistry maps the backoff names config accepts to their factories.
+type backoffRegistry struct {
+	factories map[string]BackoffFactory
+	mu        sync.RWMutex
+}
+
+//nolint:gochecknoglobals // process-wide name table, by design
+var backoffs = &backoffRegistry{factories: map[string]BackoffFactory{
+	"constant":           ignoreMax(ConstantBackoff),
+	"exponential":        ignoreMax(ExponentialBackoff),
+	"linear":             ignoreMax(LinearBackoff),
+	"exponential_jitter": ignoreMax(ExponentialJitterBackoff),
+	"full_jitter":        ignoreMax(FullJitterBackoff),
+	"equal_jitter":       ignoreMax(EqualJitterBackoff),
+}}
+
+// RegisterBackoff makes the backoff strategy built by factory available to
+// config under name, so a [RetryConfig] with "backoff": name resolves to it in
+// [BuildOptions], [Policy.Reconfigure] and the r8econf loader. Register before
+// loading the config that uses it. The built-in names ("constant",
+// "exponential", "linear", "exponential_jitter", "full_jitter",
+// "equal_jitter") are registered from the start; registering an existing name
+// replaces its factory. It panics on an empty name or a nil factory. It is
+// safe for concurrent use.
+func RegisterBackoff(name string, factory func(base, maxDelay time.Duration) BackoffStrategy) {
+	if name == "" {
+		panic("r8e: RegisterBackoff with an empty name")
+	}
+
+	if factory == nil {
+		panic("r8e: RegisterBackoff with a nil factory")
+	}
+
+	backoffs.mu.Lock()
+	defer backoffs.mu.Unlock()
+
+	backoffs.factories[name] = factory
+}
+
+// lookup returns the factory registered under name.
+func (r *backoffRegistry) lookup(name string) (BackoffFactory, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	factory, ok := r.factories[name]
+
+	return factory, ok
+}
+
+// ignoreMax adapts a built-in constructor, which takes only the base delay, to
+// a [BackoffFactory].
+func ignoreMax(build func(time.Duration) BackoffStrategy) BackoffFactory {
+	return func(base, _ time.Duration) BackoffStrategy {
+		return build(base)
+	}
+}
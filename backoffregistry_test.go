@@ -0,0 +1,81 @@
+package r8e
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// countingBackoff is a constant backoff recording how often it is asked for a
+// delay.
+type countingBackoff struct {
+	delay time.Duration
+	calls atomic.Int64
+}
+
+func (b *countingBackoff) Delay(int) time.Duration {
+	b.calls.Add(1)
+
+	return b.delay
+}
+
+func TestRegisterBackoffResolvesFromConfig(t *testing.T) {
+	var (
+		gotBase, gotMax time.Duration
+		strategy        = &countingBackoff{delay: time.Millisecond}
+	)
+
+	RegisterBackoff("test_counting", func(base, maxDelay time.Duration) BackoffStrategy {
+		gotBase, gotMax = base, maxDelay
+
+		return strategy
+	})
+
+	opts, err := BuildOptions(&PolicyConfig{Retry: &RetryConfig{
+		MaxAttempts: intPtr(3),
+		Backoff:     strPtr("test_counting"),
+		BaseDelay:   strPtr("5ms"),
+		MaxDelay:    strPtr("50ms"),
+	}})
+	require.NoError(t, err)
+	assert.Equal(t, 5*time.Millisecond, gotBase)
+	assert.Equal(t, 50*time.Millisecond, gotMax)
+
+	p := NewPolicy[string]("custom-backoff", opts...)
+
+	got, err := p.Do(context.Background(), flakyFn(2))
+	require.NoError(t, err)
+	assert.Equal(t, "ok", got)
+	assert.Equal(t, int64(2), strategy.calls.Load())
+}
+
+func TestRegisterBackoffReplacesExisting(t *testing.T) {
+	RegisterBackoff("test_replaced", ignoreMax(ConstantBackoff))
+	RegisterBackoff("test_replaced", ignoreMax(LinearBackoff))
+
+	strategy, err := parseBackoffStrategy(strPtr("test_replaced"), strPtr("10ms"), 0)
+	require.NoError(t, err)
+	assert.Equal(t, 30*time.Millisecond, strategy.Delay(2))
+}
+
+func TestRegisterBackoffNilStrategy(t *testing.T) {
+	RegisterBackoff("test_nil", func(time.Duration, time.Duration) BackoffStrategy { return nil })
+
+	_, err := parseBackoffStrategy(strPtr("test_nil"), strPtr("10ms"), 0)
+	require.ErrorContains(t, err, "factory returned nil")
+}
+
+func TestRegisterBackoffPanics(t *testing.T) {
+	t.Parallel()
+
+	assert.PanicsWithValue(t, "r8e: RegisterBackoff with an empty name", func() {
+		RegisterBackoff("", ignoreMax(ConstantBackoff))
+	})
+	assert.PanicsWithValue(t, "r8e: RegisterBackoff with a nil factory", func() {
+		RegisterBackoff("test_nil_factory", nil)
+	})
+}
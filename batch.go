@@ -0,0 +1,193 @@
+package r8e
+
+import (
+	"context"
+	"sync"
+)
+
+// Pattern: Batch — fans a slice of inputs out through one policy, so every
+// call shares its rate limiter, bulkhead and breaker while a bounded number
+// run at once.
+
+type (
+	// BatchResult is the outcome of one input of [DoBatch]: what its call
+	// through the policy returned.
+	BatchResult[T any] struct {
+		Value T
+		Err   error
+	}
+
+	// BatchOption configures a [DoBatch] call.
+	BatchOption func(*batchConfig)
+
+	// batchConfig collects the [DoBatch] settings. A non-positive concurrency
+	// defers to the policy's bulkhead (see DoBatch).
+	batchConfig struct {
+		concurrency     int
+		failFast        bool
+		ignoreAdmission bool
+	}
+
+	// batchAbort records the first error of a fail-fast [DoBatch] and cancels
+	// the inputs still running.
+	batchAbort struct {
+		cancel context.CancelFunc
+		err    error
+		once   sync.Once
+	}
+)
+
+// BatchConcurrency caps how many inputs of a [DoBatch] run at once. A
+// non-positive n restores the default.
+func BatchConcurrency(n int) BatchOption {
+	return func(cfg *batchConfig) {
+		cfg.concurrency = n
+	}
+}
+
+// BatchFailFast makes a [DoBatch] abort on the first input that fails: the
+// context of the inputs still running is cancelled, no further input is
+// started, and DoBatch returns that input's error once the running ones have
+// returned — promptly, provided fn honours its context. The inputs not started
+// carry context.Canceled in their results. See [BatchIgnoreAdmissionErrors]
+// to keep a policy turning an input away from aborting the batch.
+func BatchFailFast() BatchOption {
+	return func(cfg *batchConfig) {
+		cfg.failFast = true
+	}
+}
+
+// BatchIgnoreAdmissionErrors keeps an input turned away by the policy's own
+// admission layer — [ErrCircuitOpen], [ErrRateLimited], [ErrBulkheadFull] and
+// the other rejections raised before the call runs — from aborting a
+// [BatchFailFast] batch: the rejection is reported in that input's result only.
+// It has no effect without BatchFailFast.
+func BatchIgnoreAdmissionErrors() BatchOption {
+	return func(cfg *batchConfig) {
+		cfg.ignoreAdmission = true
+	}
+}
+
+// DoBatch runs fn for every input through p concurrently and returns one
+// [BatchResult] per input, in input order. Each input is its own [Policy.Do],
+// so the inputs share the policy's rate limiter, bulkhead, circuit breaker and
+// budgets, and one input's failure does not affect the others: it is reported
+// in that input's result only.
+//
+// At most [BatchConcurrency] inputs run at once. By default that is the
+// capacity of the policy's bulkhead, so a batch larger than the bulkhead
+// queues behind it instead of being rejected with [ErrBulkheadFull] (calls
+// from outside the batch can still fill it); a policy without a bulkhead runs
+// every input at once.
+//
+// The returned error is non-nil only when ctx ends before every input has
+// started: the inputs not yet started are not run, their results carry
+// ctx.Err(), and DoBatch returns ctx.Err() once the running ones finish. With
+// [BatchFailFast], it is also the first input's error that aborted the batch.
+func DoBatch[I, T any](
+	ctx context.Context,
+	p *Policy[T],
+	inputs []I,
+	fn func(context.Context, I) (T, error),
+	opts ...BatchOption,
+) ([]BatchResult[T], error) {
+	var cfg batchConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	results := make([]BatchResult[T], len(inputs))
+	slots := make(chan struct{}, batchConcurrency(cfg, p, len(inputs)))
+
+	runCtx := ctx
+
+	var abort *batchAbort
+
+	if cfg.failFast {
+		var cancel context.CancelFunc
+
+		runCtx, cancel = context.WithCancel(ctx)
+		defer cancel()
+
+		abort = &batchAbort{cancel: cancel}
+	}
+
+	var wg sync.WaitGroup
+
+	for i, input := range inputs {
+		select {
+		case slots <- struct{}{}:
+		case <-runCtx.Done():
+		}
+
+		// Checked after the select, which picks at random when both cases are
+		// ready: an ended ctx must not start another input.
+		if err := runCtx.Err(); err != nil {
+			wg.Wait()
+
+			for j := i; j < len(inputs); j++ {
+				results[j].Err = err
+			}
+
+			return results, batchError(ctx, abort)
+		}
+
+		wg.Go(func() {
+			defer func() { <-slots }()
+
+			val, err := p.Do(runCtx, func(ctx context.Context) (T, error) {
+				return fn(ctx, input)
+			})
+			results[i] = BatchResult[T]{Value: val, Err: err}
+
+			if err != nil && abort != nil &&
+				!(cfg.ignoreAdmission && isAdmissionRejection(err)) {
+				abort.trip(err)
+			}
+		})
+	}
+
+	wg.Wait()
+
+	return results, batchError(ctx, abort)
+}
+
+// trip records err as the batch's first error and cancels the running inputs;
+// later calls do nothing, so the errors of the cancelled inputs never replace
+// it.
+func (a *batchAbort) trip(err error) {
+	a.once.Do(func() {
+		a.err = err
+		a.cancel()
+	})
+}
+
+// batchError returns the error a [DoBatch] reports once its started inputs
+// have returned: ctx's if it ended, else the error that aborted a fail-fast
+// batch, if any. abort is nil without [BatchFailFast].
+func batchError(ctx context.Context, abort *batchAbort) error {
+	if err := ctx.Err(); err != nil {
+		return err //nolint:wrapcheck // preserving context error identity
+	}
+
+	if abort != nil {
+		return abort.err
+	}
+
+	return nil
+}
+
+// batchConcurrency resolves how many of n inputs a [DoBatch] runs at once: the
+// configured cap, else the policy's bulkhead capacity, else all of them.
+func batchConcurrency[T any](cfg batchConfig, p *Policy[T], n int) int {
+	limit := cfg.concurrency
+	if limit <= 0 && p.bulkhead != nil {
+		limit = p.bulkhead.Limit()
+	}
+
+	if limit <= 0 || limit > n {
+		limit = n
+	}
+
+	return max(limit, 1)
+}
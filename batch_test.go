@@ -0,0 +1,296 @@
+package r8e_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"testing/synctest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/byte4ever/r8e"
+)
+
+// peakTracker records the highest number of concurrent calls it has seen.
+type peakTracker struct {
+	inFlight atomic.Int32
+	peak     atomic.Int32
+}
+
+func (p *peakTracker) enter() {
+	n := p.inFlight.Add(1)
+	for {
+		peak := p.peak.Load()
+		if n <= peak || p.peak.CompareAndSwap(peak, n) {
+			return
+		}
+	}
+}
+
+func (p *peakTracker) leave() { p.inFlight.Add(-1) }
+
+func TestDoBatchSharesBulkhead(t *testing.T) {
+	t.Parallel()
+
+	errOdd := errors.New("odd input")
+	p := r8e.NewPolicy[int]("batch-bulkhead", r8e.WithBulkhead(2))
+
+	var tracker peakTracker
+
+	results, err := r8e.DoBatch(
+		context.Background(),
+		p,
+		[]int{0, 1, 2, 3, 4, 5},
+		func(_ context.Context, in int) (int, error) {
+			tracker.enter()
+			defer tracker.leave()
+
+			time.Sleep(5 * time.Millisecond)
+
+			if in%2 == 1 {
+				return 0, errOdd
+			}
+
+			return in * 10, nil
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, results, 6)
+
+	for i, res := range results {
+		if i%2 == 1 {
+			require.ErrorIs(t, res.Err, errOdd, "input %d", i)
+			continue
+		}
+
+		require.NoError(t, res.Err, "input %d", i)
+		assert.Equal(t, i*10, res.Value)
+	}
+
+	assert.LessOrEqual(t, tracker.peak.Load(), int32(2))
+}
+
+func TestDoBatchConcurrencyOption(t *testing.T) {
+	t.Parallel()
+
+	p := r8e.NewPolicy[string]("batch-concurrency")
+
+	var tracker peakTracker
+
+	results, err := r8e.DoBatch(
+		context.Background(),
+		p,
+		[]string{"a", "b", "c", "d", "e"},
+		func(_ context.Context, in string) (string, error) {
+			tracker.enter()
+			defer tracker.leave()
+
+			time.Sleep(5 * time.Millisecond)
+
+			return in + in, nil
+		},
+		r8e.BatchConcurrency(1),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, []r8e.BatchResult[string]{
+		{Value: "aa"}, {Value: "bb"}, {Value: "cc"}, {Value: "dd"}, {Value: "ee"},
+	}, results)
+	assert.Equal(t, int32(1), tracker.peak.Load())
+}
+
+func TestDoBatchCancelledContext(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	p := r8e.NewPolicy[int]("batch-cancelled")
+
+	results, err := r8e.DoBatch(ctx, p, []int{1, 2, 3},
+		func(_ context.Context, in int) (int, error) {
+			return in, nil
+		},
+		r8e.BatchConcurrency(1),
+	)
+	require.ErrorIs(t, err, context.Canceled)
+	require.Len(t, results, 3)
+
+	for _, res := range results {
+		assert.ErrorIs(t, res.Err, context.Canceled)
+	}
+}
+
+func TestDoBatchEmpty(t *testing.T) {
+	t.Parallel()
+
+	p := r8e.NewPolicy[int]("batch-empty")
+
+	results, err := r8e.DoBatch(context.Background(), p, nil,
+		func(_ context.Context, in int) (int, error) {
+			return in, nil
+		},
+	)
+	require.NoError(t, err)
+	assert.Empty(t, results)
+}
+
+// waitOrFail returns a batch function that fails input bad after 10ms and
+// otherwise waits an hour for its context to be cancelled.
+func waitOrFail(bad int, errBad error) func(context.Context, int) (int, error) {
+	return func(ctx context.Context, in int) (int, error) {
+		if in == bad {
+			time.Sleep(10 * time.Millisecond)
+
+			return 0, errBad
+		}
+
+		select {
+		case <-time.After(time.Hour):
+			return in, nil
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		}
+	}
+}
+
+func TestDoBatchFailFastCancelsSiblings(t *testing.T) {
+	t.Parallel()
+
+	synctest.Test(t, func(t *testing.T) {
+		errBad := errors.New("bad input")
+		p := r8e.NewPolicy[int]("batch-fail-fast")
+
+		start := time.Now()
+		results, err := r8e.DoBatch(context.Background(), p, []int{0, 1, 2, 3},
+			waitOrFail(2, errBad), r8e.BatchFailFast())
+
+		require.ErrorIs(t, err, errBad)
+		assert.Equal(t, 10*time.Millisecond, time.Since(start), "siblings cancelled promptly")
+
+		for i, res := range results {
+			if i == 2 {
+				assert.ErrorIs(t, res.Err, errBad)
+				continue
+			}
+
+			assert.ErrorIs(t, res.Err, context.Canceled, "input %d", i)
+		}
+	})
+}
+
+func TestDoBatchFailFastSkipsUnstarted(t *testing.T) {
+	t.Parallel()
+
+	synctest.Test(t, func(t *testing.T) {
+		errBad := errors.New("bad input")
+		p := r8e.NewPolicy[int]("batch-fail-fast-unstarted")
+
+		var started atomic.Int32
+
+		fail := waitOrFail(1, errBad)
+
+		results, err := r8e.DoBatch(context.Background(), p, []int{0, 1, 2, 3, 4},
+			func(ctx context.Context, in int) (int, error) {
+				started.Add(1)
+
+				return fail(ctx, in)
+			},
+			r8e.BatchFailFast(), r8e.BatchConcurrency(2),
+		)
+
+		require.ErrorIs(t, err, errBad)
+		assert.Equal(t, int32(2), started.Load(), "no input starts after the failure")
+
+		for _, res := range results[2:] {
+			assert.ErrorIs(t, res.Err, context.Canceled)
+		}
+	})
+}
+
+func TestDoBatchFailFastAdmissionErrors(t *testing.T) {
+	t.Parallel()
+
+	run := func(t *testing.T, opts ...r8e.BatchOption) ([]r8e.BatchResult[int], error) {
+		t.Helper()
+
+		p := r8e.NewPolicy[int]("batch-admission", r8e.WithBulkhead(1))
+
+		return r8e.DoBatch(context.Background(), p, []int{0, 1, 2},
+			func(ctx context.Context, in int) (int, error) {
+				select {
+				case <-time.After(10 * time.Millisecond):
+					return in, nil
+				case <-ctx.Done():
+					return 0, ctx.Err()
+				}
+			},
+			append(opts, r8e.BatchFailFast(), r8e.BatchConcurrency(3))...,
+		)
+	}
+
+	count := func(results []r8e.BatchResult[int], target error) int {
+		n := 0
+
+		for _, res := range results {
+			if errors.Is(res.Err, target) {
+				n++
+			}
+		}
+
+		return n
+	}
+
+	t.Run("ignored", func(t *testing.T) {
+		t.Parallel()
+
+		synctest.Test(t, func(t *testing.T) {
+			results, err := run(t, r8e.BatchIgnoreAdmissionErrors())
+
+			require.NoError(t, err)
+			assert.Equal(t, 2, count(results, r8e.ErrBulkheadFull))
+			assert.Equal(t, 1, count(results, nil), "the admitted input completes")
+		})
+	})
+
+	t.Run("counted", func(t *testing.T) {
+		t.Parallel()
+
+		synctest.Test(t, func(t *testing.T) {
+			results, err := run(t)
+
+			require.ErrorIs(t, err, r8e.ErrBulkheadFull)
+			assert.Positive(t, count(results, r8e.ErrBulkheadFull))
+			assert.Zero(t, count(results, nil), "the rejection aborts the batch")
+		})
+	})
+}
+
+func TestDoBatchIgnoreAdmissionErrorsShed(t *testing.T) {
+	t.Parallel()
+
+	synctest.Test(t, func(t *testing.T) {
+		p := r8e.NewPolicy[int]("batch-shed")
+
+		results, err := r8e.DoBatch(context.Background(), p, []int{0, 1, 2},
+			func(_ context.Context, in int) (int, error) {
+				if in == 1 {
+					return 0, fmt.Errorf("downstream: %w", r8e.ErrLoadShed)
+				}
+
+				time.Sleep(10 * time.Millisecond)
+
+				return in, nil
+			},
+			r8e.BatchFailFast(), r8e.BatchIgnoreAdmissionErrors(),
+		)
+
+		require.NoError(t, err, "a shed does not abort the batch")
+		assert.ErrorIs(t, results[1].Err, r8e.ErrLoadShed)
+		assert.NoError(t, results[0].Err)
+		assert.NoError(t, results[2].Err)
+	})
+}
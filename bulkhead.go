@@ -17,6 +17,14 @@ type (
 	// caller that waits longer than max-wait gives up with [ErrBulkheadTimeout],
 	// and one whose context is cancelled while queued returns the context error.
 	//
+	// Queued callers may carry a priority (see [Bulkhead.AcquireWithPriority] and
+	// [WithCallPriority]): a freed slot goes to the highest-priority waiter, and
+	// among equal priorities to the oldest, so with no priorities the queue is
+	// plain FIFO. Aging bounds starvation — every [BulkheadPriorityAging] interval
+	// a caller has waited counts as one priority level, so a low-priority caller
+	// is overtaken only by higher-priority callers that arrived within that many
+	// intervals of it, and eventually outranks every newcomer.
+	//
 	// The controlled-delay discipline (see [BulkheadCoDel]) is an alternative — or
 	// addition — to the fixed max-wait: it watches the standing queue delay and,
 	// while the queue stays persistently backed up, sheds callers that have waited
@@ -40,16 +48,19 @@ type (
 		cur      int
 		maxWait  time.Duration
 		maxQueue int
+		aging    time.Duration
 	}
 
 	// bulkheadWaiter is one caller parked in the wait queue. ready is closed (once,
 	// under the bulkhead mutex) to wake it; shed, written before that close, tells
 	// the woken caller whether it was granted a slot (false) or dropped by the
 	// controlled-delay discipline (true). enqueued stamps when it joined the queue,
-	// for the CoDel dwell measurement.
+	// for the CoDel dwell measurement and priority aging; priority is the one it
+	// was acquired with.
 	bulkheadWaiter struct {
 		enqueued time.Time
 		ready    chan struct{}
+		priority int
 		shed     bool
 	}
 
@@ -66,9 +77,14 @@ type (
 		maxQueue      int
 		codelTarget   time.Duration
 		codelInterval time.Duration
+		aging         time.Duration
 	}
 )
 
+// defaultBulkheadPriorityAging is the wait worth one priority level when
+// [BulkheadPriorityAging] is not given.
+const defaultBulkheadPriorityAging = time.Second
+
 // BulkheadMaxWait enables the bounded FIFO wait: a full bulkhead queues callers
 // for up to d instead of rejecting immediately. A non-positive d (the default)
 // keeps the reject-immediately behaviour. The wait is measured with the
@@ -122,6 +138,21 @@ func BulkheadCoDel(target, interval time.Duration) BulkheadOption {
 	}
 }
 
+// BulkheadPriorityAging sets how long a queued caller must wait to gain one
+// priority level, bounding the starvation of low-priority callers: a caller of
+// priority p queued at time t ranks as if it had priority p + (now-t)/d, so it
+// is served before a newcomer of priority p+k once it has waited k×d. A smaller
+// d favors waiting time, a larger one the stamped priority. Non-positive values
+// are ignored. Defaults to 1s. Has no effect unless callers are queued with
+// different priorities (see [Bulkhead.AcquireWithPriority]).
+func BulkheadPriorityAging(d time.Duration) BulkheadOption {
+	return func(c *bulkheadConfig) {
+		if d > 0 {
+			c.aging = d
+		}
+	}
+}
+
 // NewBulkhead creates a bulkhead that allows at most maxConcurrent simultaneous
 // calls, using clock for max-wait timing (like the other limiters; a [Policy]
 // injects its own clock). By default a full bulkhead rejects immediately; pass
@@ -132,7 +163,7 @@ func NewBulkhead(
 	hooks *Hooks,
 	opts ...BulkheadOption,
 ) *Bulkhead {
-	var cfg bulkheadConfig
+	cfg := bulkheadConfig{aging: defaultBulkheadPriorityAging}
 	for _, o := range opts {
 		o(&cfg)
 	}
@@ -143,6 +174,7 @@ func NewBulkhead(
 		maxConc:  maxConcurrent,
 		maxWait:  cfg.maxWait,
 		maxQueue: effectiveQueueDepth(cfg.maxQueue, maxConcurrent),
+		aging:    cfg.aging,
 		codel:    codel{target: cfg.codelTarget, interval: cfg.codelInterval},
 	}
 }
@@ -161,7 +193,7 @@ func effectiveQueueDepth(maxQueue, maxConcurrent int) int {
 // replaced; max-wait, queue depth and the CoDel target/interval from opts are
 // applied (unset options keep their current value); the clock is not
 // reconfigurable. In-flight calls are unaffected. If the new concurrency limit
-// opened capacity, queued waiters are granted slots in FIFO order. Changing the
+// opened capacity, queued waiters are granted slots in priority order. Changing the
 // CoDel target/interval resets its overload latch.
 func (b *Bulkhead) Reconfigure(maxConcurrent int, opts ...BulkheadOption) {
 	b.mu.Lock()
@@ -172,6 +204,7 @@ func (b *Bulkhead) Reconfigure(maxConcurrent int, opts ...BulkheadOption) {
 		maxQueue:      b.maxQueue,
 		codelTarget:   b.codel.target,
 		codelInterval: b.codel.interval,
+		aging:         b.aging,
 	}
 	for _, o := range opts {
 		o(&cfg)
@@ -180,6 +213,7 @@ func (b *Bulkhead) Reconfigure(maxConcurrent int, opts ...BulkheadOption) {
 	b.maxConc = maxConcurrent
 	b.maxWait = cfg.maxWait
 	b.maxQueue = effectiveQueueDepth(cfg.maxQueue, maxConcurrent)
+	b.aging = cfg.aging
 	b.codel.reconfigure(cfg.codelTarget, cfg.codelInterval)
 	b.drainWaiters()
 }
@@ -192,8 +226,27 @@ func (b *Bulkhead) Reconfigure(maxConcurrent int, opts ...BulkheadOption) {
 //   - [ErrBulkheadTimeout] if the caller waited the full max-wait without a slot;
 //   - [ErrCoDelShed] if the controlled-delay discipline shed the caller because
 //     the queue was overloaded and it had waited past the slough timeout;
-//   - ctx.Err() if ctx is cancelled while waiting.
+//   - ctx.Err() if ctx is already done on entry (no slot is taken and nothing
+//     is queued) or is cancelled while waiting.
+//
+// A queued caller waits with the priority stamped on ctx by [WithCallPriority]
+// (0 when unstamped); see [Bulkhead.AcquireWithPriority].
 func (b *Bulkhead) Acquire(ctx context.Context) error {
+	return b.AcquireWithPriority(ctx, CallPriorityFromCtx(ctx))
+}
+
+// AcquireWithPriority is [Bulkhead.Acquire] with an explicit priority for the
+// wait queue: when the bulkhead is full and a wait is enabled, a freed slot goes
+// to the queued caller with the highest priority, aged by the time it has waited
+// (see [BulkheadPriorityAging]), and among equals to the oldest. Higher values
+// are served first; 0 is the default. A caller that finds a free slot takes it
+// at once whatever its priority. It returns what Acquire returns.
+func (b *Bulkhead) AcquireWithPriority(ctx context.Context, priority int) error {
+	// An already-done context never takes a slot or joins the queue.
+	if err := ctx.Err(); err != nil {
+		return err //nolint:wrapcheck // preserving context error identity
+	}
+
 	b.mu.Lock()
 
 	if b.cur < b.maxConc {
@@ -211,7 +264,11 @@ func (b *Bulkhead) Acquire(ctx context.Context) error {
 		return ErrBulkheadFull
 	}
 
-	w := &bulkheadWaiter{ready: make(chan struct{}), enqueued: b.clock.Now()}
+	w := &bulkheadWaiter{
+		ready:    make(chan struct{}),
+		enqueued: b.clock.Now(),
+		priority: priority,
+	}
 	b.waiters = append(b.waiters, w)
 	maxWait := b.maxWait // capture under the lock; Reconfigure may change it
 	b.mu.Unlock()
@@ -339,9 +396,9 @@ func (b *Bulkhead) releaseSlot() bool {
 
 // handOffLocked routes a freed slot. It first runs the controlled-delay pass,
 // which refreshes the overload latch and sheds any stale waiters, then hands the
-// slot to the next waiter — newest-first while overloaded (adaptive LIFO),
-// oldest-first while healthy (FIFO) — or returns it to the pool when the queue is
-// empty. Caller must hold mu.
+// slot to the next waiter — highest priority first, newest-first among equals
+// while overloaded (adaptive LIFO) and oldest-first while healthy — or returns it
+// to the pool when the queue is empty. Caller must hold mu.
 func (b *Bulkhead) handOffLocked() bool {
 	b.codelShedStaleLocked()
 
@@ -383,16 +440,49 @@ func (b *Bulkhead) codelShedStaleLocked() {
 	}
 }
 
-// nextWaiterIndexLocked picks which queued waiter receives a freed slot: the
-// newest (tail) while CoDel reports the queue overloaded — adaptive LIFO keeps
-// the freshest, likeliest-still-wanted callers moving — otherwise the oldest
-// (head), plain FIFO. Caller must hold mu and ensure the queue is non-empty.
+// nextWaiterIndexLocked picks which queued waiter receives a freed slot. While
+// CoDel reports the queue overloaded it is the highest-priority waiter, newest
+// first among equals — adaptive LIFO keeps the freshest, likeliest-still-wanted
+// callers moving; otherwise it is the highest-priority waiter after aging (see
+// [Bulkhead.agedWaiterIndexLocked]), which is plain FIFO when no priorities are
+// set. Caller must hold mu and ensure the queue is non-empty.
 func (b *Bulkhead) nextWaiterIndexLocked() int {
-	if b.codel.isOverloaded() {
-		return len(b.waiters) - 1
+	if !b.codel.isOverloaded() {
+		return b.agedWaiterIndexLocked()
+	}
+
+	best := len(b.waiters) - 1
+	for i := best - 1; i >= 0; i-- {
+		if b.waiters[i].priority > b.waiters[best].priority {
+			best = i
+		}
 	}
 
-	return 0
+	return best
+}
+
+// agedWaiterIndexLocked returns the waiter with the highest aged priority,
+// priority + waited/aging. Ranking by the virtual arrival time enqueued −
+// priority×aging is equivalent and independent of the current time: the
+// earliest virtual arrival wins, and the queue's arrival order breaks ties, so
+// equal priorities are served FIFO. Caller must hold mu and ensure the queue is
+// non-empty.
+func (b *Bulkhead) agedWaiterIndexLocked() int {
+	best, bestAt := 0, b.virtualArrival(b.waiters[0])
+	for i := 1; i < len(b.waiters); i++ {
+		if at := b.virtualArrival(b.waiters[i]); at.Before(bestAt) {
+			best, bestAt = i, at
+		}
+	}
+
+	return best
+}
+
+// virtualArrival is the time w would have had to join the queue with priority
+// 0 to rank where it ranks now: each priority level moves it one aging interval
+// earlier.
+func (b *Bulkhead) virtualArrival(w *bulkheadWaiter) time.Time {
+	return w.enqueued.Add(-time.Duration(w.priority) * b.aging)
 }
 
 // standingDelayLocked is the dwell of the oldest queued waiter — the standing
@@ -429,16 +519,16 @@ func (b *Bulkhead) closeWaiterAt(idx int) {
 	b.waiters = removeWaiterAt(b.waiters, idx)
 }
 
-// drainWaiters hands newly opened capacity to queued callers in FIFO order, used
-// after a concurrency-limit increase. Each grant consumes a fresh slot (cur++),
-// unlike a Release handoff which transfers an existing slot. A capacity increase
-// is a recovery signal, so it grants oldest-first and does not shed. Caller must
-// hold mu.
+// drainWaiters hands newly opened capacity to queued callers in aged priority
+// order, used after a concurrency-limit increase. Each grant consumes a fresh
+// slot (cur++), unlike a Release handoff which transfers an existing slot. A
+// capacity increase is a recovery signal, so it ignores the CoDel overload
+// latch and does not shed. Caller must hold mu.
 func (b *Bulkhead) drainWaiters() {
 	for b.cur < b.maxConc && len(b.waiters) > 0 {
 		b.cur++
 
-		b.grantWaiterAt(0)
+		b.grantWaiterAt(b.agedWaiterIndexLocked())
 	}
 }
 
@@ -476,6 +566,15 @@ func (b *Bulkhead) Cap() int64 {
 	return int64(b.maxConc)
 }
 
+// Limit returns the concurrency limit. For a Bulkhead it is the configured
+// capacity (see Cap), which only [Policy.Reconfigure] moves; it mirrors
+// [AdaptiveLimiter.Limit] so a fixed and an adaptive limiter can be observed
+// through the same method. [Policy.ConcurrencyLimit] reports whichever of the
+// two a policy has, live.
+func (b *Bulkhead) Limit() int {
+	return int(b.Cap())
+}
+
 // Queued returns the number of callers currently waiting for a slot; 0 unless a
 // wait is enabled (see [BulkheadMaxWait] and [BulkheadCoDel]).
 func (b *Bulkhead) Queued() int64 {
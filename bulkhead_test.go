@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/byte4ever/r8e"
+	"github.com/byte4ever/r8e/internal/clocktest"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -26,6 +27,24 @@ func TestBulkheadAcquireUnderLimit(t *testing.T) {
 	require.NoError(t, bh.Acquire(t.Context()))
 }
 
+// ---------------------------------------------------------------------------
+// Acquire with an already-cancelled context takes no slot
+// ---------------------------------------------------------------------------
+
+func TestBulkheadAcquireCancelledContext(t *testing.T) {
+	t.Parallel()
+
+	bh := r8e.NewBulkhead(1, r8e.RealClock{}, &r8e.Hooks{},
+		r8e.BulkheadMaxWait(time.Second))
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	require.ErrorIs(t, bh.Acquire(ctx), context.Canceled)
+	assert.Zero(t, bh.InUse())
+	assert.Zero(t, bh.Queued())
+}
+
 // ---------------------------------------------------------------------------
 // Acquire at limit returns ErrBulkheadFull
 // ---------------------------------------------------------------------------
@@ -545,6 +564,7 @@ func TestBulkheadReconfigureWaitParams(t *testing.T) {
 	// Keep one slot but widen the queue to depth 2.
 	bh.Reconfigure(1, r8e.BulkheadMaxWait(time.Hour), r8e.BulkheadQueueDepth(2))
 	require.Equal(t, int64(1), bh.Cap())
+	require.Equal(t, 1, bh.Limit())
 
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -649,3 +669,141 @@ func BenchmarkBulkheadAcquireRelease(b *testing.B) {
 		}
 	})
 }
+
+// TestBulkheadWaitPriorityOrder: with mixed priorities queued, freed slots go to
+// the higher-priority callers first even though the lower-priority ones queued
+// earlier, and equal priorities keep FIFO order.
+func TestBulkheadWaitPriorityOrder(t *testing.T) {
+	t.Parallel()
+
+	mc := &manualClock{}
+	bh := r8e.NewBulkhead(1, mc, &r8e.Hooks{},
+		r8e.BulkheadMaxWait(time.Hour), r8e.BulkheadQueueDepth(4))
+
+	require.NoError(t, bh.Acquire(t.Context())) // hold the only slot
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	background := startWaiter(r8e.WithCallPriority(ctx, -1), t, bh, 1)
+	normal := startWaiter(ctx, t, bh, 2)
+	interactiveA := startWaiter(r8e.WithCallPriority(ctx, 5), t, bh, 3)
+	interactiveB := startWaiter(r8e.WithCallPriority(ctx, 5), t, bh, 4)
+
+	for _, next := range []<-chan error{interactiveA, interactiveB, normal, background} {
+		bh.Release()
+		require.NoError(t, <-next)
+	}
+
+	require.Zero(t, bh.Queued())
+}
+
+// TestBulkheadAcquireWithPriority: an explicit priority outranks an earlier
+// caller queued with the default.
+func TestBulkheadAcquireWithPriority(t *testing.T) {
+	t.Parallel()
+
+	mc := &manualClock{}
+	bh := r8e.NewBulkhead(1, mc, &r8e.Hooks{},
+		r8e.BulkheadMaxWait(time.Hour), r8e.BulkheadQueueDepth(2))
+
+	require.NoError(t, bh.Acquire(t.Context()))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	low := startWaiter(ctx, t, bh, 1)
+
+	high := make(chan error, 1)
+	go func() { high <- bh.AcquireWithPriority(ctx, 1) }()
+
+	require.Eventually(t, func() bool { return bh.Queued() == 2 },
+		time.Second, time.Millisecond)
+
+	bh.Release()
+	require.NoError(t, <-high)
+	require.Equal(t, int64(1), bh.Queued(), "the earlier low-priority caller still waits")
+
+	cancel()
+	require.ErrorIs(t, <-low, context.Canceled)
+}
+
+// TestBulkheadWaitPriorityAging: a low-priority caller that has waited longer
+// than the priority gap times the aging interval outranks a newer high-priority
+// caller, so it cannot starve.
+func TestBulkheadWaitPriorityAging(t *testing.T) {
+	t.Parallel()
+
+	clock := clocktest.New()
+	// A CoDel-only wait installs no timer; the huge target never overloads.
+	bh := r8e.NewBulkhead(1, clock, &r8e.Hooks{},
+		r8e.BulkheadCoDel(time.Hour, time.Hour),
+		r8e.BulkheadQueueDepth(3),
+		r8e.BulkheadPriorityAging(100*time.Millisecond))
+
+	require.NoError(t, bh.Acquire(t.Context()))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	old := startWaiter(ctx, t, bh, 1)
+
+	clock.Advance(250 * time.Millisecond) // worth two and a half levels
+	fresh := startWaiter(r8e.WithCallPriority(ctx, 2), t, bh, 2)
+
+	clock.Advance(time.Millisecond)
+	fresher := startWaiter(r8e.WithCallPriority(ctx, 3), t, bh, 3)
+
+	for _, next := range []<-chan error{fresher, old, fresh} {
+		bh.Release()
+		require.NoError(t, <-next)
+	}
+}
+
+// TestPolicyBulkheadCallPriority: the policy's bulkhead honours the priority
+// stamped on the call's context.
+func TestPolicyBulkheadCallPriority(t *testing.T) {
+	t.Parallel()
+
+	p := r8e.NewPolicy[string]("bulkhead-priority",
+		r8e.WithClock(&manualClock{}),
+		r8e.WithBulkhead(1,
+			r8e.BulkheadMaxWait(time.Hour),
+			r8e.BulkheadQueueDepth(2)),
+	)
+
+	hold := make(chan struct{})
+	holding := make(chan struct{})
+
+	go func() {
+		_, _ = p.Do(t.Context(), func(_ context.Context) (string, error) {
+			close(holding)
+			<-hold // pin the only slot
+
+			return "holder", nil
+		})
+	}()
+	<-holding
+
+	order := make(chan string, 2)
+	call := func(ctx context.Context, name string) {
+		_, _ = p.Do(ctx, func(_ context.Context) (string, error) {
+			order <- name
+
+			return name, nil
+		})
+	}
+
+	go call(t.Context(), "background")
+	require.Eventually(t, func() bool { return p.Metrics().BulkheadQueued == 1 },
+		time.Second, time.Millisecond)
+
+	go call(r8e.WithCallPriority(t.Context(), 1), "interactive")
+	require.Eventually(t, func() bool { return p.Metrics().BulkheadQueued == 2 },
+		time.Second, time.Millisecond)
+
+	close(hold)
+
+	assert.Equal(t, "interactive", <-order)
+	assert.Equal(t, "background", <-order)
+}
@@ -0,0 +1,52 @@
+package r8e
+
+import (
+	"context"
+	"time"
+)
+
+type (
+	// callTimeoutKey is the context key under which [WithCallTimeout] stores a
+	// call's timeout override.
+	callTimeoutKey struct{}
+
+	// skipRetryKey is the context key under which [SkipRetry] marks a call.
+	skipRetryKey struct{}
+)
+
+// WithCallTimeout stamps ctx with a tighter timeout for the calls made with it,
+// returning the derived context. The policy's timeout pattern ([WithTimeout])
+// then bounds the call by the smaller of d and its own timeout — adaptive or
+// not — and reports it as usual, with [ErrTimeout] and OnTimeout. The stamp
+// only tightens: a d larger than the policy's timeout, or a non-positive d,
+// changes nothing, and a policy without a timeout ignores it (use
+// [context.WithTimeout] there).
+func WithCallTimeout(ctx context.Context, d time.Duration) context.Context {
+	return context.WithValue(ctx, callTimeoutKey{}, d)
+}
+
+// SkipRetry marks ctx so the calls made with it run once: the retry pattern
+// ([WithRetry], [DoRetry]) makes a single attempt, exactly as with a maxAttempts
+// of 1, so a failure is still reported as a [RetryError]. Use it for a call
+// whose failure is better surfaced at once than retried, on a policy that
+// otherwise retries.
+func SkipRetry(ctx context.Context) context.Context {
+	return context.WithValue(ctx, skipRetryKey{}, true)
+}
+
+// callTimeout returns the timeout for a call under ctx: configured, or the
+// [WithCallTimeout] stamp when it is positive and smaller.
+func callTimeout(ctx context.Context, configured time.Duration) time.Duration {
+	if d, ok := ctx.Value(callTimeoutKey{}).(time.Duration); ok && d > 0 && d < configured {
+		return d
+	}
+
+	return configured
+}
+
+// isSkipRetry reports whether ctx was marked by [SkipRetry].
+func isSkipRetry(ctx context.Context) bool {
+	skip, ok := ctx.Value(skipRetryKey{}).(bool)
+
+	return ok && skip
+}
@@ -0,0 +1,89 @@
+package r8e
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// deadlineBudget returns the time left until ctx's deadline, failing the test
+// when ctx has none.
+func deadlineBudget(t *testing.T, ctx context.Context) time.Duration {
+	t.Helper()
+
+	deadline, ok := ctx.Deadline()
+	require.True(t, ok, "fn must run under a deadline")
+
+	return time.Until(deadline)
+}
+
+func TestWithCallTimeoutTightensPolicyTimeout(t *testing.T) {
+	t.Parallel()
+
+	p := NewPolicy[string]("", WithTimeout(time.Hour, CooperativeTimeout()))
+
+	var budget time.Duration
+
+	ctx := WithCallTimeout(context.Background(), 20*time.Millisecond)
+	_, err := p.Do(ctx, func(ctx context.Context) (string, error) {
+		budget = deadlineBudget(t, ctx)
+		<-ctx.Done()
+
+		return "", ctx.Err()
+	})
+
+	require.ErrorIs(t, err, ErrTimeout)
+	assert.LessOrEqual(t, budget, 20*time.Millisecond)
+}
+
+func TestWithCallTimeoutNeverLoosens(t *testing.T) {
+	t.Parallel()
+
+	p := NewPolicy[string]("", WithTimeout(time.Second))
+
+	for _, d := range []time.Duration{time.Hour, 0, -time.Second} {
+		var budget time.Duration
+
+		_, err := p.Do(WithCallTimeout(context.Background(), d),
+			func(ctx context.Context) (string, error) {
+				budget = deadlineBudget(t, ctx)
+
+				return "ok", nil
+			})
+
+		require.NoError(t, err)
+		assert.LessOrEqual(t, budget, time.Second, "override %v", d)
+		assert.Greater(t, budget, time.Second/2, "override %v", d)
+	}
+}
+
+func TestSkipRetryMakesOneAttempt(t *testing.T) {
+	t.Parallel()
+
+	p := NewPolicy[string]("", WithRetry(3, ConstantBackoff(time.Millisecond)))
+
+	var calls atomic.Int64
+
+	fail := func(context.Context) (string, error) {
+		calls.Add(1)
+
+		return "", errors.New("down")
+	}
+
+	_, err := p.Do(SkipRetry(context.Background()), fail)
+	require.ErrorIs(t, err, ErrRetriesExhausted)
+	assert.Equal(t, int64(1), calls.Load())
+	assert.Zero(t, p.Metrics().Retries)
+
+	// Without the mark the same policy retries as configured.
+	calls.Store(0)
+
+	_, err = p.Do(context.Background(), fail)
+	require.Error(t, err)
+	assert.Equal(t, int64(3), calls.Load())
+}
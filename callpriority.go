@@ -0,0 +1,30 @@
+package r8e
+
+import "context"
+
+// callPriorityKey is the context key under which [WithCallPriority] stores a
+// call's priority.
+type callPriorityKey struct{}
+
+// WithCallPriority stamps ctx with the call's bulkhead priority, returning the
+// derived context. When the bulkhead is full and queueing is enabled, a freed
+// slot goes to the highest-priority waiter first (see
+// [Bulkhead.AcquireWithPriority]); higher values are served first and the zero
+// value is the default for unstamped calls, so interactive traffic can be
+// stamped with a positive priority and background jobs with a negative one.
+// Only the bulkhead's wait queue consults the stamp; every other pattern treats
+// the call as usual.
+//
+// Like [WithSheddability], the stamp propagates through child contexts but not
+// through [WithCoalesce], whose shared call runs under a detached context.
+func WithCallPriority(ctx context.Context, priority int) context.Context {
+	return context.WithValue(ctx, callPriorityKey{}, priority)
+}
+
+// CallPriorityFromCtx returns the priority stamped on ctx by [WithCallPriority],
+// or 0 if none was set.
+func CallPriorityFromCtx(ctx context.Context) int {
+	priority, _ := ctx.Value(callPriorityKey{}).(int)
+
+	return priority
+}
@@ -1,6 +1,7 @@
 package r8e
 
 import (
+	"context"
 	"math"
 	"math/rand/v2"
 	"sync"
@@ -28,6 +29,15 @@ type (
 		slowCallWindow        int
 		slowCallMinCalls      int
 
+		// Error-rate trip (opt-in via ErrorThresholdPercentage), Hystrix-style:
+		// the breaker opens when at least requestVolumeThreshold calls completed
+		// in the last errorRateWindow and errorThresholdPercentage of them failed.
+		// Detection is OFF while errorThresholdPercentage is 0; when on, it
+		// replaces the consecutive-failure trip.
+		errorThresholdPercentage int
+		requestVolumeThreshold   int
+		errorRateWindow          time.Duration
+
 		// Adaptive recovery backoff (opt-in via RecoveryBackoffMultiplier).
 		// After each failed half-open probe, the recovery wait is multiplied by
 		// recoveryBackoffMultiplier. A value <= 0 disables the feature (default).
@@ -43,6 +53,22 @@ type (
 		rampRecoveryWindow  time.Duration
 		rampAggression      float64
 		rampInitialFraction float64
+
+		// countAttempts places the breaker inside retry in a policy chain (opt-in
+		// via CountAttempts), so it admits and records every retry attempt
+		// instead of the whole retried call. Read once when the policy is built.
+		countAttempts bool
+
+		// ignoreIf, when non-nil, excludes the errors it matches from the
+		// breaker's accounting (opt-in via CircuitBreakerIgnoreIf).
+		ignoreIf func(error) bool
+
+		// Shared state (opt-in via SharedCircuitState): transitions are
+		// published to store under storeKey, and an open published by another
+		// breaker is adopted, reading the store at most every storeSync.
+		store     CircuitStore
+		storeKey  string
+		storeSync time.Duration
 	}
 
 	// CircuitBreakerOption configures a circuit breaker.
@@ -78,6 +104,10 @@ type (
 
 		lastFailure time.Time
 
+		// stateSince is when the breaker entered its current state, the origin
+		// of CBStats.TimeInState. Guarded by mu.
+		stateSince time.Time
+
 		// rampStart is when the breaker entered the ramping state, the origin for
 		// the slow-start curve. Guarded by mu.
 		rampStart time.Time
@@ -86,12 +116,21 @@ type (
 		// allocated lazily on first observation. Guarded by mu.
 		slowWin slowCallWindow
 
+		// errWin is the time-based error-rate window (see
+		// ErrorThresholdPercentage). Guarded by mu.
+		errWin errorRateWindow
+
 		cfg circuitBreakerConfig
 
 		failureCount      int
+		trips             int64 // open transitions since construction
 		halfOpenSuccesses int
 		halfOpenInFlight  int // probes currently admitted in half-open
 
+		// storeSyncedAt is when the shared store was last read (see
+		// syncFromStore); zero before the first read. Guarded by mu.
+		storeSyncedAt time.Time
+
 		// recoveryAttempt counts consecutive failed half-open probes since the last
 		// closed→open transition. Used by currentRecoveryTimeout to scale the next
 		// recovery wait. Reset to zero when the breaker closes, or on a new trip
@@ -114,6 +153,24 @@ type (
 		slow   int
 	}
 
+	// errorRateWindow is a rolling time window of call and failure counts,
+	// split into errorRateBuckets slices so old counts age out one slice at a
+	// time. bucketNanos is the slice width the buckets were counted with; a
+	// different width (after a reconfigured window) resets them. It is not safe
+	// for concurrent use — the circuit breaker guards it with its mutex.
+	errorRateWindow struct {
+		buckets     [errorRateBuckets]errorRateBucket
+		bucketNanos int64
+	}
+
+	// errorRateBucket counts the calls, and the failed ones, that completed
+	// during one slice of an errorRateWindow.
+	errorRateBucket struct {
+		epoch    int64
+		requests int64
+		failures int64
+	}
+
 	// callInput is the raw measurement of one completed call handed to the
 	// breaker: how long it took and whether it returned an error.
 	callInput struct {
@@ -130,6 +187,25 @@ type (
 		failed bool
 		slow   bool
 	}
+
+	// probeKey is the context key stamped by [WithProbe].
+	probeKey struct{}
+
+	// CBStats is a point-in-time snapshot of a [CircuitBreaker]'s internals,
+	// for dashboards that want more than the state name. All fields are read
+	// under the breaker's lock, so the snapshot is consistent.
+	CBStats struct {
+		// State is the current state.
+		State CircuitState
+		// ConsecutiveFailures is the closed-state failure streak counted
+		// toward [FailureThreshold]; a success resets it.
+		ConsecutiveFailures int
+		// Trips is how many times the breaker has opened since it was built,
+		// counting reopenings from half-open and ramping.
+		Trips int64
+		// TimeInState is how long the breaker has been in State.
+		TimeInState time.Duration
+	}
 )
 
 // Circuit breaker states.
@@ -151,6 +227,10 @@ const (
 	// fraction of traffic is admitted over the ramp window before the breaker
 	// fully closes (see [RampRecovery]).
 	CircuitRamping CircuitState = "ramping"
+
+	// errorRateBuckets is the number of slices the error-rate window is divided
+	// into. Internal, not exposed.
+	errorRateBuckets = 10
 )
 
 func defaultCircuitBreakerConfig() circuitBreakerConfig {
@@ -163,11 +243,17 @@ func defaultCircuitBreakerConfig() circuitBreakerConfig {
 		// SlowCallRate alone enables a usable detector without further tuning.
 		slowCallWindow:   100,
 		slowCallMinCalls: 10,
+		// Error-rate tripping is disabled by default (errorThresholdPercentage is
+		// zero); volume and window take Hystrix's defaults so
+		// ErrorThresholdPercentage alone yields a usable rule.
+		requestVolumeThreshold: 20,
+		errorRateWindow:        10 * time.Second,
 		// Ramp recovery is disabled by default (rampRecoveryWindow is zero); the
 		// curve params are pre-seeded so RampRecovery alone yields a sensible
 		// linear ramp from 10% without further tuning.
 		rampAggression:      1.0,
 		rampInitialFraction: 0.1,
+		storeSync:           defaultCircuitStoreSync,
 	}
 }
 
@@ -240,6 +326,47 @@ func SlowCallMinCalls(n int) CircuitBreakerOption {
 	}
 }
 
+// ErrorThresholdPercentage enables Hystrix-style error-rate tripping (off by
+// default): the breaker opens once at least [RequestVolumeThreshold] calls
+// completed within the rolling [ErrorRateWindow] and p percent or more of them
+// failed. Below the volume threshold the breaker never opens on errors, however
+// many fail, so a handful of failures on a quiet dependency cannot trip it.
+//
+// It replaces the consecutive-failure trip ([FailureThreshold] is then
+// ignored in the closed state) and is additive to the slow-call trip
+// ([SlowCallRate]). The window's counts are cleared whenever the breaker
+// closes, so a recovered dependency starts from a clean slate. p is clamped to
+// 100; p <= 0 disables the rule.
+func ErrorThresholdPercentage(p int) CircuitBreakerOption {
+	return func(cfg *circuitBreakerConfig) {
+		cfg.errorThresholdPercentage = min(max(p, 0), 100)
+	}
+}
+
+// RequestVolumeThreshold sets the minimum number of calls within the
+// [ErrorRateWindow] before the error percentage is evaluated. Values below 1
+// are ignored. Default 20. Has no effect unless error-rate tripping is enabled
+// via [ErrorThresholdPercentage].
+func RequestVolumeThreshold(n int) CircuitBreakerOption {
+	return func(cfg *circuitBreakerConfig) {
+		if n >= 1 {
+			cfg.requestVolumeThreshold = n
+		}
+	}
+}
+
+// ErrorRateWindow sets the span of the rolling window the request volume and
+// error percentage are counted over. Non-positive values are ignored. Default
+// 10s. Changing it on a live breaker clears the window. Has no effect unless
+// error-rate tripping is enabled via [ErrorThresholdPercentage].
+func ErrorRateWindow(d time.Duration) CircuitBreakerOption {
+	return func(cfg *circuitBreakerConfig) {
+		if d > 0 {
+			cfg.errorRateWindow = d
+		}
+	}
+}
+
 // RecoveryBackoffMultiplier enables exponential backoff on the recovery timeout
 // after consecutive failed half-open probes. After each probe that re-opens the
 // breaker, the next recovery wait is recoveryTimeout × factor^n, where n is the
@@ -265,6 +392,24 @@ func RecoveryMaxBackoff(d time.Duration) CircuitBreakerOption {
 	}
 }
 
+// RecoveryBackoff is the usual shape of recovery backoff in one option: the
+// breaker first stays open for initial, and each failed half-open probe doubles
+// the next open period up to maxWait. A successful close resets it to initial.
+// It is shorthand for [RecoveryTimeout](initial),
+// [RecoveryBackoffMultiplier](2) and [RecoveryMaxBackoff](maxWait). A
+// non-positive initial leaves the configuration unchanged.
+func RecoveryBackoff(initial, maxWait time.Duration) CircuitBreakerOption {
+	return func(cfg *circuitBreakerConfig) {
+		if initial <= 0 {
+			return
+		}
+
+		cfg.recoveryTimeout = initial
+		cfg.recoveryBackoffMultiplier = 2
+		cfg.recoveryMaxBackoff = maxWait
+	}
+}
+
 // RampRecovery enables slow-start ramp recovery (off by default). After the
 // breaker recovers through half-open it does not jump straight to full traffic
 // but enters the [CircuitRamping] state and admits a growing fraction over
@@ -303,6 +448,42 @@ func RampInitialFraction(f float64) CircuitBreakerOption {
 	}
 }
 
+// CountAttempts makes a policy's breaker see every retry attempt rather than
+// every call. By default the breaker sits outside retry, so it records exactly
+// one outcome per [Policy.Do]: a call that fails twice and then succeeds is one
+// success, and the two failed attempts never reach the breaker. With
+// CountAttempts the breaker moves inside retry: each attempt is admitted and
+// recorded on its own (three records for that call — two failures, then a
+// success), so the failure threshold and the slow-call rate reflect raw
+// downstream attempts. An attempt the breaker rejects ends the retry sequence
+// with a [Permanent] [ErrCircuitOpen] instead of backing off against an open
+// breaker.
+//
+// The placement is fixed when the policy is built; passing CountAttempts to
+// [CircuitBreaker.Reconfigure] has no effect. It has no effect on a standalone
+// breaker.
+func CountAttempts() CircuitBreakerOption {
+	return func(cfg *circuitBreakerConfig) {
+		cfg.countAttempts = true
+	}
+}
+
+// CircuitBreakerIgnoreIf makes the breaker ignore the errors fn matches: a call
+// failing with one counts as neither a success nor a failure, so it neither
+// advances nor resets the consecutive-failure streak and leaves the slow-call
+// window untouched. Use it for errors that are the caller's fault rather than
+// a sign of an unhealthy dependency — CircuitBreakerIgnoreIf([IsPermanent])
+// keeps a burst of 4xx-style [Permanent] errors from tripping the breaker. An
+// ignored half-open probe gives its slot back without deciding the state. A
+// nil fn restores the default, where every error is a failure. It applies to
+// [CircuitBreaker.Record] (and so to a policy's breaker), not to an explicit
+// [CircuitBreaker.RecordFailure].
+func CircuitBreakerIgnoreIf(fn func(error) bool) CircuitBreakerOption {
+	return func(cfg *circuitBreakerConfig) {
+		cfg.ignoreIf = fn
+	}
+}
+
 // clampUnitInterval clamps rate into [0, 1], the valid range for a fraction.
 func clampUnitInterval(rate float64) float64 {
 	if rate < 0 {
@@ -359,10 +540,11 @@ func NewCircuitBreaker(
 	}
 
 	return &CircuitBreaker{
-		clock:   clock,
-		hooks:   hooks,
-		sampler: rand.Float64,
-		cfg:     cfg,
+		clock:      clock,
+		hooks:      hooks,
+		sampler:    rand.Float64,
+		cfg:        cfg,
+		stateSince: clock.Now(),
 	}
 }
 
@@ -391,6 +573,73 @@ func (cb *CircuitBreaker) Reconfigure(opts ...CircuitBreakerOption) {
 	}
 }
 
+// AllowContext is [CircuitBreaker.Allow] for a call carrying ctx: a context
+// that is already done short-circuits with ctx.Err() before any admission
+// logic runs, so an abandoned call neither consumes a half-open probe slot nor
+// triggers the open→half-open transition. The policy middleware uses it, which
+// makes every admission layer (breaker, rate limiter, bulkhead) treat a
+// cancelled call the same way.
+//
+// A call whose ctx is marked with [WithProbe] is always admitted (see
+// [CircuitBreaker.AllowProbe]).
+func (cb *CircuitBreaker) AllowContext(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err //nolint:wrapcheck // preserving context error identity
+	}
+
+	if IsProbe(ctx) {
+		cb.AllowProbe()
+
+		return nil
+	}
+
+	return cb.Allow()
+}
+
+// WithProbe marks ctx as a synthetic health probe. A probe exists to test the
+// dependency, so failing it fast with [ErrCircuitOpen] would defeat it: the
+// circuit breaker admits a probe-marked call whatever its state and uses the
+// result to drive recovery, as a half-open probe (see
+// [CircuitBreaker.AllowProbe]). Only the breaker honors the mark; the rate
+// limiter, bulkhead, and other admission layers treat a probe like any call.
+func WithProbe(ctx context.Context) context.Context {
+	return context.WithValue(ctx, probeKey{}, true)
+}
+
+// IsProbe reports whether ctx was marked with [WithProbe].
+func IsProbe(ctx context.Context) bool {
+	probe, _ := ctx.Value(probeKey{}).(bool)
+
+	return probe
+}
+
+// AllowProbe admits a health-probe call unconditionally. An open breaker moves
+// to half-open without waiting out the recovery timeout, and the probe takes
+// the first probe slot; in half-open the probe is admitted even when every slot
+// is taken. Either way its recorded outcome is a half-open probe result: a
+// success counts toward closing, a failure reopens the breaker and restarts
+// the recovery timeout. Closed and ramping breakers simply admit it.
+func (cb *CircuitBreaker) AllowProbe() {
+	cb.mu.Lock()
+
+	var emit func()
+
+	switch cb.state {
+	case stateOpen:
+		emit = cb.halfOpenLocked()
+	case stateHalfOpen:
+		cb.halfOpenInFlight++
+	default:
+		// Closed or ramping: the probe is an ordinary admitted call.
+	}
+
+	cb.mu.Unlock()
+
+	if emit != nil {
+		emit()
+	}
+}
+
 // Allow checks if a call should be allowed. Returns nil if the breaker is
 // closed, or half-open with a probe slot available. Returns ErrCircuitOpen if
 // the breaker is open and the recovery timeout hasn't elapsed, or if half-open
@@ -403,6 +652,8 @@ func (cb *CircuitBreaker) Reconfigure(opts ...CircuitBreakerOption) {
 func (cb *CircuitBreaker) Allow() error {
 	cb.mu.Lock()
 
+	adopted := cb.syncFromStoreLocked()
+
 	var (
 		emit func()
 		err  error
@@ -418,10 +669,7 @@ func (cb *CircuitBreaker) Allow() error {
 
 		// Recovery timeout elapsed: transition to half-open and admit this
 		// call as the first probe.
-		cb.state = stateHalfOpen
-		cb.halfOpenSuccesses = 0
-		cb.halfOpenInFlight = 1
-		emit = cb.hooks.emitCircuitHalfOpen
+		emit = cb.halfOpenLocked()
 
 	case stateHalfOpen:
 		// Admit at most halfOpenMaxAttempts concurrent probes; reject the rest
@@ -455,6 +703,10 @@ func (cb *CircuitBreaker) Allow() error {
 
 	cb.mu.Unlock()
 
+	if adopted != nil {
+		adopted()
+	}
+
 	if emit != nil {
 		emit()
 	}
@@ -469,9 +721,31 @@ func (cb *CircuitBreaker) Allow() error {
 // / [CircuitBreaker.RecordFailure] when slow-call detection is enabled, so the
 // call's latency is taken into account; those two treat the call as fast.
 func (cb *CircuitBreaker) Record(elapsed time.Duration, err error) {
+	if err != nil && cb.ignores(err) {
+		return
+	}
+
 	cb.recordOutcome(callInput{elapsed: elapsed, failed: err != nil})
 }
 
+// ignores reports whether err is excluded from the breaker's accounting (see
+// [CircuitBreakerIgnoreIf]). An ignored half-open probe releases its slot so
+// the next probe can be admitted.
+func (cb *CircuitBreaker) ignores(err error) bool {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	if cb.cfg.ignoreIf == nil || !cb.cfg.ignoreIf(err) {
+		return false
+	}
+
+	if cb.state == stateHalfOpen {
+		cb.releaseProbe()
+	}
+
+	return true
+}
+
 // RecordSuccess records a successful call, treated as fast (latency 0). With
 // slow-call detection enabled (see [SlowCallRate]) it records a non-slow verdict
 // regardless of the real latency, so use [CircuitBreaker.Record] for
@@ -527,15 +801,23 @@ func (cb *CircuitBreaker) recordOutcome(in callInput) {
 	}
 }
 
-// openLocked transitions the breaker to open: it sets the state, resets the
-// half-open probe counters, and (re)starts the recovery clock from now. It is
-// the sole writer of lastFailure on an open transition (the non-opening failure
-// paths stamp it themselves), and returns the supplied trip hook for the caller
-// to fire after unlock. Callers are responsible for updating recoveryAttempt
+// openLocked trips the breaker (see tripLocked) and publishes the open to the
+// shared store, if any. Callers are responsible for updating recoveryAttempt
 // before calling (recordClosed resets it; recordHalfOpen bumps it via
 // bumpRecoveryAttemptLocked). Caller must hold mu.
 func (cb *CircuitBreaker) openLocked(emit func()) func() {
-	cb.state = stateOpen
+	return cb.publishLocked(CircuitOpen, cb.tripLocked(emit))
+}
+
+// tripLocked transitions the breaker to open: it sets the state, resets the
+// half-open probe counters, and (re)starts the recovery clock from now. It is
+// the sole writer of lastFailure on an open transition (the non-opening failure
+// paths stamp it themselves), and returns the supplied trip hook for the caller
+// to fire after unlock. An open adopted from the shared store trips without
+// publishing it back (see syncFromStore). Caller must hold mu.
+func (cb *CircuitBreaker) tripLocked(emit func()) func() {
+	emit = cb.setStateLocked(stateOpen, emit)
+	cb.trips++
 	cb.halfOpenSuccesses = 0
 	cb.halfOpenInFlight = 0
 	cb.lastFailure = cb.clock.Now()
@@ -554,14 +836,15 @@ func (cb *CircuitBreaker) bumpRecoveryAttemptLocked() {
 
 // recordClosed applies a closed-state outcome and returns the hook to fire (or
 // nil). The breaker opens on whichever trips first: the consecutive-failure
-// count reaching failureThreshold — which takes precedence on a call that is
-// both failing and slow — or, independently, the slow-call rate reaching its
-// threshold (which can happen on a slow but successful call). Caller must hold
-// mu.
+// count reaching failureThreshold — or, with error-rate tripping enabled, the
+// error percentage reaching its threshold over enough volume — which takes
+// precedence on a call that is both failing and slow; or, independently, the
+// slow-call rate reaching its threshold (which can happen on a slow but
+// successful call). Caller must hold mu.
 func (cb *CircuitBreaker) recordClosed(out callOutcome) func() {
 	if out.failed {
 		cb.failureCount++
-		if cb.failureCount >= cb.cfg.failureThreshold {
+		if !cb.errorRateEnabled() && cb.failureCount >= cb.cfg.failureThreshold {
 			cb.recoveryAttempt = 0
 			return cb.openLocked(cb.hooks.emitCircuitOpen)
 		}
@@ -569,6 +852,18 @@ func (cb *CircuitBreaker) recordClosed(out callOutcome) func() {
 		cb.failureCount = 0
 	}
 
+	if cb.errorRateEnabled() {
+		now := cb.clock.Now()
+		cb.errWin.observe(now, out.failed, cb.cfg.errorRateWindow)
+
+		if out.failed && cb.errWin.tripped(
+			now, cb.cfg.requestVolumeThreshold, cb.cfg.errorThresholdPercentage,
+		) {
+			cb.recoveryAttempt = 0
+			return cb.openLocked(cb.hooks.emitCircuitOpen)
+		}
+	}
+
 	if cb.slowCallEnabled() &&
 		cb.slowWin.tripped(cb.cfg.slowCallMinCalls, cb.cfg.slowCallRateThreshold) {
 		cb.recoveryAttempt = 0
@@ -618,18 +913,21 @@ func (cb *CircuitBreaker) recordHalfOpen(out callOutcome) func() {
 }
 
 // closeLocked transitions the breaker to the closed state, clearing the failure
-// and probe counters and resetting the adaptive-recovery backoff so the next
-// trip starts from the base recoveryTimeout. It returns the close hook for the
-// caller to fire after unlock. Used both when half-open closes directly and when
-// the ramp window completes (see Allow). Caller must hold mu.
+// and probe counters and the error-rate window, and resetting the
+// adaptive-recovery backoff so the next trip starts from the base
+// recoveryTimeout. It returns the close hook, which also publishes the close to
+// the shared store if any, for the caller to fire after unlock. Used both when
+// half-open closes directly and when the ramp window completes (see Allow).
+// Caller must hold mu.
 func (cb *CircuitBreaker) closeLocked() func() {
-	cb.state = stateClosed
+	emit := cb.setStateLocked(stateClosed, cb.hooks.emitCircuitClose)
 	cb.failureCount = 0
 	cb.halfOpenSuccesses = 0
 	cb.halfOpenInFlight = 0
 	cb.recoveryAttempt = 0
+	cb.errWin.reset()
 
-	return cb.hooks.emitCircuitClose
+	return cb.publishLocked(CircuitClosed, emit)
 }
 
 // enterRampLocked transitions a recovered half-open breaker into the ramping
@@ -640,12 +938,12 @@ func (cb *CircuitBreaker) closeLocked() func() {
 // ramp keeps growing the adaptive backoff; only a full close (closeLocked)
 // resets it. Caller must hold mu.
 func (cb *CircuitBreaker) enterRampLocked() func() {
-	cb.state = stateRamping
+	emit := cb.setStateLocked(stateRamping, cb.hooks.emitCircuitRamping)
 	cb.rampStart = cb.clock.Now()
 	cb.halfOpenSuccesses = 0
 	cb.halfOpenInFlight = 0
 
-	return cb.hooks.emitCircuitRamping
+	return emit
 }
 
 // recordRamping applies an outcome observed while ramping. A failed or slow call
@@ -683,6 +981,12 @@ func (cb *CircuitBreaker) slowCallEnabled() bool {
 	return cb.cfg.slowCallDuration > 0 && cb.cfg.slowCallRateThreshold > 0
 }
 
+// errorRateEnabled reports whether error-rate tripping is active (see
+// [ErrorThresholdPercentage]).
+func (cb *CircuitBreaker) errorRateEnabled() bool {
+	return cb.cfg.errorThresholdPercentage > 0
+}
+
 // rampEnabled reports whether slow-start ramp recovery is active (see
 // [RampRecovery]).
 func (cb *CircuitBreaker) rampEnabled() bool {
@@ -783,6 +1087,64 @@ func (w *slowCallWindow) tripped(minCalls int, threshold float64) bool {
 	return w.fraction() >= threshold
 }
 
+// observe counts one completed call at now in its slice of window, first
+// clearing every bucket when the slice width changed.
+func (w *errorRateWindow) observe(now time.Time, failed bool, window time.Duration) {
+	width := max(int64(window)/errorRateBuckets, 1)
+	if width != w.bucketNanos {
+		w.reset()
+		w.bucketNanos = width
+	}
+
+	epoch := now.UnixNano() / w.bucketNanos
+
+	bucket := &w.buckets[epoch%errorRateBuckets]
+	if bucket.epoch != epoch {
+		*bucket = errorRateBucket{epoch: epoch}
+	}
+
+	bucket.requests++
+	if failed {
+		bucket.failures++
+	}
+}
+
+// tripped reports whether at least volume calls completed in the window ending
+// at now and percentage percent or more of them failed.
+func (w *errorRateWindow) tripped(now time.Time, volume, percentage int) bool {
+	if w.bucketNanos == 0 {
+		return false
+	}
+
+	current := now.UnixNano() / w.bucketNanos
+	oldest := current - errorRateBuckets + 1
+
+	var requests, failures int64
+
+	for i := range w.buckets {
+		bucket := &w.buckets[i]
+		// Skip slices outside the window: older than it, or — if the clock
+		// ever steps backward — stamped in the future.
+		if bucket.epoch < oldest || bucket.epoch > current {
+			continue
+		}
+
+		requests += bucket.requests
+		failures += bucket.failures
+	}
+
+	if requests == 0 || requests < int64(volume) {
+		return false
+	}
+
+	return failures*100 >= int64(percentage)*requests
+}
+
+// reset clears every bucket.
+func (w *errorRateWindow) reset() {
+	w.buckets = [errorRateBuckets]errorRateBucket{}
+}
+
 // SlowCallFraction returns the current fraction of slow calls in the breaker's
 // window, in [0, 1]. It is 0 when slow-call detection is disabled (see
 // [SlowCallRate]) or no calls have been observed yet. Useful as a gauge to
@@ -798,6 +1160,18 @@ func (cb *CircuitBreaker) SlowCallFraction() float64 {
 	return cb.slowWin.fraction()
 }
 
+// CurrentRecoveryTimeout returns how long the breaker stays open before
+// admitting its next half-open probe: the base recovery timeout, grown by
+// [RecoveryBackoffMultiplier] for each consecutive failed probe and capped by
+// [RecoveryMaxBackoff]. Useful as a gauge to watch a flapping dependency being
+// probed less and less often.
+func (cb *CircuitBreaker) CurrentRecoveryTimeout() time.Duration {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	return cb.currentRecoveryTimeout()
+}
+
 // RampRecoveryFraction returns the fraction of traffic the breaker is currently
 // admitting while ramping back to full load after recovery, in [0, 1]. It is 0
 // when the breaker is not in the [CircuitRamping] state — closed, open or
@@ -826,12 +1200,64 @@ func (cb *CircuitBreaker) releaseProbe() {
 	}
 }
 
+// halfOpenLocked transitions an open breaker to half-open, admitting the caller
+// as the first probe, and returns the half-open hook for the caller to fire
+// after unlock. Caller must hold mu.
+func (cb *CircuitBreaker) halfOpenLocked() func() {
+	emit := cb.setStateLocked(stateHalfOpen, cb.hooks.emitCircuitHalfOpen)
+	cb.halfOpenSuccesses = 0
+	cb.halfOpenInFlight = 1
+
+	return emit
+}
+
+// setStateLocked moves the breaker to state, restarts the time-in-state clock,
+// and returns the hook to fire after unlock: emit (the discrete hook for the
+// new state) followed by OnCircuitStateChange with the from/to pair. When no
+// state-change hook is set, emit is returned as-is. Caller must hold mu.
+func (cb *CircuitBreaker) setStateLocked(state uint32, emit func()) func() {
+	from := cb.stateLocked()
+	cb.state = state
+	cb.stateSince = cb.clock.Now()
+
+	if cb.hooks == nil || cb.hooks.OnCircuitStateChange == nil {
+		return emit
+	}
+
+	to := cb.stateLocked()
+
+	return func() {
+		emit()
+		cb.hooks.emitCircuitStateChange(from, to)
+	}
+}
+
+// Stats returns a consistent snapshot of the breaker's state, failure streak,
+// trip count, and time in the current state.
+func (cb *CircuitBreaker) Stats() CBStats {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	return CBStats{
+		State:               cb.stateLocked(),
+		ConsecutiveFailures: cb.failureCount,
+		Trips:               cb.trips,
+		TimeInState:         cb.clock.Since(cb.stateSince),
+	}
+}
+
 // State returns the current state: [CircuitClosed], [CircuitOpen],
 // [CircuitHalfOpen], or [CircuitRamping].
 func (cb *CircuitBreaker) State() CircuitState {
 	cb.mu.Lock()
 	defer cb.mu.Unlock()
 
+	return cb.stateLocked()
+}
+
+// stateLocked maps the internal state to its [CircuitState]. Caller must hold
+// mu.
+func (cb *CircuitBreaker) stateLocked() CircuitState {
 	switch cb.state {
 	case stateClosed:
 		return CircuitClosed
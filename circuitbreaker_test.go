@@ -353,6 +353,89 @@ func TestCircuitBreakerHookOnReopenFromHalfOpen(t *testing.T) {
 	require.Equal(t, int64(2), openCount.Load())
 }
 
+// transitionLog records every OnCircuitStateChange pair, interleaved with the
+// discrete hooks so their relative order is checked too.
+type transitionLog struct {
+	mu     sync.Mutex
+	events []string
+}
+
+func (l *transitionLog) add(event string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.events = append(l.events, event)
+}
+
+func (l *transitionLog) hooks() *Hooks {
+	return &Hooks{
+		OnCircuitOpen:     func() { l.add("open") },
+		OnCircuitClose:    func() { l.add("close") },
+		OnCircuitHalfOpen: func() { l.add("half_open") },
+		OnCircuitRamping:  func() { l.add("ramping") },
+		OnCircuitStateChange: func(from, to CircuitState) {
+			l.add(string(from) + "->" + string(to))
+		},
+	}
+}
+
+func TestCircuitBreakerStateChangeSequence(t *testing.T) {
+	t.Parallel()
+
+	clk := &stubClock{now: time.Now()}
+	log := &transitionLog{}
+
+	cb := NewCircuitBreaker(clk, log.hooks(),
+		FailureThreshold(1),
+		RecoveryTimeout(time.Second),
+		HalfOpenMaxAttempts(1),
+	)
+
+	cb.RecordFailure() // closed -> open
+	clk.setElapsed(2 * time.Second)
+	require.NoError(t, cb.Allow()) // open -> half_open
+	cb.RecordFailure()             // half_open -> open
+	require.NoError(t, cb.Allow()) // open -> half_open
+	cb.RecordSuccess()             // half_open -> closed
+
+	assert.Equal(t, []string{
+		"open", "closed->open",
+		"half_open", "open->half_open",
+		"open", "half_open->open",
+		"half_open", "open->half_open",
+		"close", "half_open->closed",
+	}, log.events)
+}
+
+func TestCircuitBreakerStateChangeThroughRamp(t *testing.T) {
+	t.Parallel()
+
+	clk := &originClock{now: time.Now()}
+	log := &transitionLog{}
+
+	cb := NewCircuitBreaker(clk, log.hooks(),
+		FailureThreshold(1),
+		RecoveryTimeout(time.Second),
+		HalfOpenMaxAttempts(1),
+		RampRecovery(10*time.Second),
+	)
+	cb.sampler = func() float64 { return 0 } // admit every ramping call
+
+	cb.RecordFailure()
+	clk.advance(2 * time.Second)
+	require.NoError(t, cb.Allow())
+	cb.RecordSuccess() // half_open -> ramping
+	clk.advance(11 * time.Second)
+	require.NoError(t, cb.Allow()) // ramping -> closed
+
+	assert.Equal(t, []string{
+		"open", "closed->open",
+		"half_open", "open->half_open",
+		"ramping", "half_open->ramping",
+		"close", "ramping->closed",
+	}, log.events)
+}
+
 // ---------------------------------------------------------------------------
 // Concurrent access: 100 goroutines doing Allow/RecordSuccess/RecordFailure
 // ---------------------------------------------------------------------------
@@ -388,6 +471,70 @@ func TestCircuitBreakerConcurrentAccess(t *testing.T) {
 	assert.Contains(t, []CircuitState{CircuitClosed, CircuitOpen, CircuitHalfOpen}, state)
 }
 
+// ---------------------------------------------------------------------------
+// Half-open under load: only halfOpenMaxAttempts concurrent probes admitted
+// ---------------------------------------------------------------------------
+
+func TestCircuitBreakerHalfOpenGatesConcurrentAllow(t *testing.T) {
+	t.Parallel()
+
+	const (
+		goroutines  = 10
+		maxAttempts = 3
+	)
+
+	clk := &stubClock{now: time.Now()}
+	cb := NewCircuitBreaker(clk, &Hooks{},
+		FailureThreshold(1),
+		RecoveryTimeout(1*time.Second),
+		HalfOpenMaxAttempts(maxAttempts),
+	)
+
+	cb.RecordFailure() // open
+	clk.setElapsed(2 * time.Second)
+
+	var (
+		admitted atomic.Int64
+		rejected atomic.Int64
+		wg       sync.WaitGroup
+	)
+
+	start := make(chan struct{})
+
+	wg.Add(goroutines)
+
+	for range goroutines {
+		go func() {
+			defer wg.Done()
+
+			<-start
+
+			err := cb.Allow()
+			if err == nil {
+				admitted.Add(1)
+
+				return
+			}
+
+			assert.ErrorIs(t, err, ErrCircuitOpen)
+			rejected.Add(1)
+		}()
+	}
+
+	close(start)
+	wg.Wait()
+
+	require.Equal(t, CircuitHalfOpen, cb.State())
+	assert.Equal(t, int64(maxAttempts), admitted.Load())
+	assert.Equal(t, int64(goroutines-maxAttempts), rejected.Load())
+
+	// A resolved probe frees its slot for exactly one more caller.
+	cb.RecordSuccess()
+	require.Equal(t, CircuitHalfOpen, cb.State())
+	require.NoError(t, cb.Allow())
+	require.ErrorIs(t, cb.Allow(), ErrCircuitOpen)
+}
+
 // TestCircuitBreakerStateFailsSafe forces an unrecognised internal state and
 // asserts State() reports open rather than healthy — so a future state added
 // without updating the State() switch can never look ready.
@@ -458,6 +605,132 @@ func TestSlowCallRateOpensOnSlowSuccesses(t *testing.T) {
 	require.Equal(t, int64(1), opens.Load())
 }
 
+// TestErrorRateLowVolumeStaysClosed checks that, below the request volume
+// threshold, the error-rate trip never opens the breaker even at 100% errors —
+// and that it also replaces the consecutive-failure trip.
+func TestErrorRateLowVolumeStaysClosed(t *testing.T) {
+	t.Parallel()
+
+	clk := &originClock{now: time.Now()}
+	cb := NewCircuitBreaker(clk, &Hooks{},
+		FailureThreshold(1), // would open on the first failure without error-rate
+		ErrorThresholdPercentage(50),
+		RequestVolumeThreshold(10),
+	)
+
+	for range 9 {
+		cb.RecordFailure()
+	}
+
+	require.Equal(t, CircuitClosed, cb.State())
+}
+
+// TestErrorRateOpensAboveVolumeAndPercentage checks the breaker opens only once
+// both the volume and the error percentage are crossed within the window.
+func TestErrorRateOpensAboveVolumeAndPercentage(t *testing.T) {
+	t.Parallel()
+
+	clk := &originClock{now: time.Now()}
+
+	var opens atomic.Int64
+
+	cb := NewCircuitBreaker(clk, &Hooks{OnCircuitOpen: func() { opens.Add(1) }},
+		ErrorThresholdPercentage(50),
+		RequestVolumeThreshold(10),
+		ErrorRateWindow(10*time.Second),
+	)
+
+	for range 6 {
+		cb.RecordSuccess()
+	}
+
+	for range 4 {
+		cb.RecordFailure()
+	}
+
+	// Volume reached (10) but only 40% failed.
+	require.Equal(t, CircuitClosed, cb.State())
+
+	cb.RecordFailure() // 5/11 = 45%
+	require.Equal(t, CircuitClosed, cb.State())
+
+	cb.RecordFailure() // 6/12 = 50% -> open
+	require.Equal(t, CircuitOpen, cb.State())
+	require.Equal(t, int64(1), opens.Load())
+}
+
+// TestErrorRateWindowAgesOutOldCalls checks calls older than the window no
+// longer count toward the volume or the percentage.
+func TestErrorRateWindowAgesOutOldCalls(t *testing.T) {
+	t.Parallel()
+
+	clk := &originClock{now: time.Unix(1_000, 0)}
+	cb := NewCircuitBreaker(clk, &Hooks{},
+		ErrorThresholdPercentage(50),
+		RequestVolumeThreshold(4),
+		ErrorRateWindow(10*time.Second),
+	)
+
+	for range 3 {
+		cb.RecordFailure()
+	}
+
+	// The three failures leave the window before the next one arrives.
+	clk.advance(11 * time.Second)
+	cb.RecordFailure()
+	require.Equal(t, CircuitClosed, cb.State())
+
+	for range 3 {
+		cb.RecordFailure()
+	}
+
+	require.Equal(t, CircuitOpen, cb.State())
+}
+
+// TestErrorRateWindowClearedOnClose checks a recovered breaker starts from an
+// empty window rather than reopening on the failures that tripped it.
+func TestErrorRateWindowClearedOnClose(t *testing.T) {
+	t.Parallel()
+
+	clk := &originClock{now: time.Unix(1_000, 0)}
+	cb := NewCircuitBreaker(clk, &Hooks{},
+		ErrorThresholdPercentage(50),
+		RequestVolumeThreshold(2),
+		RecoveryTimeout(time.Second),
+		HalfOpenMaxAttempts(1),
+	)
+
+	cb.RecordFailure()
+	cb.RecordFailure()
+	require.Equal(t, CircuitOpen, cb.State())
+
+	clk.advance(2 * time.Second)
+	require.NoError(t, cb.Allow())
+	cb.RecordSuccess()
+	require.Equal(t, CircuitClosed, cb.State())
+
+	cb.RecordFailure() // 1 call in the fresh window: below volume
+	require.Equal(t, CircuitClosed, cb.State())
+}
+
+// TestErrorRateOptionBounds checks the option clamps and ignores out-of-range
+// values.
+func TestErrorRateOptionBounds(t *testing.T) {
+	t.Parallel()
+
+	cb := NewCircuitBreaker(&stubClock{}, &Hooks{},
+		ErrorThresholdPercentage(150),
+		RequestVolumeThreshold(0),
+		ErrorRateWindow(-time.Second),
+	)
+	assert.Equal(t, 100, cb.cfg.errorThresholdPercentage)
+	assert.Equal(t, 20, cb.cfg.requestVolumeThreshold)
+	assert.Equal(t, 10*time.Second, cb.cfg.errorRateWindow)
+
+	off := NewCircuitBreaker(&stubClock{}, &Hooks{}, ErrorThresholdPercentage(-5))
+	assert.False(t, off.errorRateEnabled())
+}
+
 // TestSlowCallFailedAndSlowTripsBySlowRate covers the failed-and-slow path: when
 // failing calls are also slow but stay below the failure threshold, the breaker
 // opens on the slow-call rate and attributes the open to the slow-call cause.
@@ -1009,6 +1282,54 @@ func TestRecoveryBackoffResetsOnClose(t *testing.T) {
 	require.Equal(t, CircuitHalfOpen, cb.State())
 }
 
+// TestRecoveryBackoffOption verifies that RecoveryBackoff doubles the open
+// period after each failed half-open probe, caps it, and resets it on close.
+func TestRecoveryBackoffOption(t *testing.T) {
+	t.Parallel()
+
+	clk := &stubClock{now: time.Now()}
+	cb := NewCircuitBreaker(clk, &Hooks{},
+		FailureThreshold(1),
+		RecoveryBackoff(10*time.Second, 30*time.Second),
+	)
+	require.Equal(t, 10*time.Second, cb.CurrentRecoveryTimeout())
+
+	cb.RecordFailure() // trip: open for the initial 10s
+
+	// Two failed half-open probes: 10s → 20s → 40s, capped at 30s.
+	clk.setElapsed(10*time.Second + 1)
+	require.NoError(t, cb.Allow())
+	cb.RecordFailure()
+	require.Equal(t, 20*time.Second, cb.CurrentRecoveryTimeout())
+
+	clk.setElapsed(20*time.Second + 1)
+	require.NoError(t, cb.Allow())
+	cb.RecordFailure()
+	require.Equal(t, 30*time.Second, cb.CurrentRecoveryTimeout())
+
+	clk.setElapsed(10*time.Second + 1)
+	require.ErrorIs(t, cb.Allow(), ErrCircuitOpen, "longer than initial")
+
+	clk.setElapsed(30*time.Second + 1)
+	require.NoError(t, cb.Allow())
+	cb.RecordSuccess()
+	require.Equal(t, CircuitClosed, cb.State())
+	require.Equal(t, 10*time.Second, cb.CurrentRecoveryTimeout())
+}
+
+// TestRecoveryBackoffOptionIgnoresNonPositiveInitial verifies that a
+// non-positive initial leaves the recovery configuration unchanged.
+func TestRecoveryBackoffOptionIgnoresNonPositiveInitial(t *testing.T) {
+	t.Parallel()
+
+	cb := NewCircuitBreaker(&stubClock{now: time.Now()}, &Hooks{},
+		RecoveryTimeout(7*time.Second),
+		RecoveryBackoff(0, time.Minute),
+	)
+
+	require.Equal(t, 7*time.Second, cb.CurrentRecoveryTimeout())
+}
+
 // TestRecoveryBackoffSlowProbeIncrementsAttempt verifies that a slow (not failed)
 // probe re-opening the breaker also increments the backoff counter.
 func TestRecoveryBackoffSlowProbeIncrementsAttempt(t *testing.T) {
@@ -1693,3 +2014,426 @@ func BenchmarkCircuitBreakerRecordSuccess(b *testing.B) {
 		}
 	})
 }
+
+// ---------------------------------------------------------------------------
+// AllowContext
+// ---------------------------------------------------------------------------
+
+func TestCircuitBreakerAllowContextCancelled(t *testing.T) {
+	t.Parallel()
+
+	clk := &stubClock{now: time.Now()}
+	cb := NewCircuitBreaker(clk, &Hooks{},
+		FailureThreshold(1), RecoveryTimeout(time.Second))
+
+	cb.RecordFailure()
+	clk.setElapsed(2 * time.Second)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	require.ErrorIs(t, cb.AllowContext(ctx), context.Canceled)
+	assert.Equal(t, CircuitOpen, cb.State(), "a cancelled call must not start a probe")
+
+	require.NoError(t, cb.AllowContext(context.Background()))
+	assert.Equal(t, CircuitHalfOpen, cb.State())
+}
+
+// ---------------------------------------------------------------------------
+// Health probes bypass the open breaker
+// ---------------------------------------------------------------------------
+
+func TestCircuitBreakerProbeAdmittedWhenOpen(t *testing.T) {
+	t.Parallel()
+
+	var halfOpens int
+
+	clk := &stubClock{now: time.Now()}
+	cb := NewCircuitBreaker(clk, &Hooks{OnCircuitHalfOpen: func() { halfOpens++ }},
+		FailureThreshold(1), RecoveryTimeout(time.Hour))
+
+	cb.RecordFailure()
+	require.ErrorIs(t, cb.Allow(), ErrCircuitOpen)
+
+	probe := WithProbe(context.Background())
+	assert.True(t, IsProbe(probe))
+	assert.False(t, IsProbe(context.Background()))
+
+	// Well before the recovery timeout, the probe is admitted as a half-open
+	// probe; ordinary calls still find the only slot taken.
+	require.NoError(t, cb.AllowContext(probe))
+	assert.Equal(t, CircuitHalfOpen, cb.State())
+	assert.Equal(t, 1, halfOpens)
+	require.ErrorIs(t, cb.Allow(), ErrCircuitOpen)
+
+	// A successful probe completes recovery.
+	cb.RecordSuccess()
+	assert.Equal(t, CircuitClosed, cb.State())
+}
+
+func TestCircuitBreakerFailedProbeReopens(t *testing.T) {
+	t.Parallel()
+
+	clk := &stubClock{now: time.Now()}
+	cb := NewCircuitBreaker(clk, &Hooks{},
+		FailureThreshold(1), RecoveryTimeout(time.Hour), HalfOpenMaxAttempts(1))
+
+	cb.RecordFailure()
+
+	// A probe is admitted even when half-open has no free slot.
+	require.NoError(t, cb.AllowContext(WithProbe(context.Background())))
+	require.NoError(t, cb.AllowContext(WithProbe(context.Background())))
+
+	cb.RecordFailure()
+	assert.Equal(t, CircuitOpen, cb.State())
+	require.ErrorIs(t, cb.Allow(), ErrCircuitOpen)
+}
+
+func TestPolicyProbeBypassesOpenBreaker(t *testing.T) {
+	t.Parallel()
+
+	p := NewPolicy[string]("",
+		WithClock(&stubClock{now: time.Now()}),
+		WithCircuitBreaker(FailureThreshold(1), RecoveryTimeout(time.Hour)),
+	)
+
+	_, err := p.Do(context.Background(), func(_ context.Context) (string, error) {
+		return "", errors.New("down")
+	})
+	require.Error(t, err)
+
+	calls := 0
+	ok := func(_ context.Context) (string, error) {
+		calls++
+		return "up", nil
+	}
+
+	_, err = p.Do(context.Background(), ok)
+	require.ErrorIs(t, err, ErrCircuitOpen)
+	assert.Zero(t, calls)
+
+	got, err := p.Do(WithProbe(context.Background()), ok)
+	require.NoError(t, err)
+	assert.Equal(t, "up", got)
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, CircuitClosed, p.circuitBreaker.State())
+}
+
+// ---------------------------------------------------------------------------
+// Policy: breaker records per call vs per attempt (CountAttempts)
+// ---------------------------------------------------------------------------
+
+// breakerRecords runs one Do through a breaker wrapped around retry (3 attempts)
+// and reports how many outcomes the breaker recorded in total and the
+// consecutive-failure count it was left with. The slow-call window (with an
+// unreachable threshold) serves as the record counter.
+func breakerRecords(
+	t *testing.T,
+	fn func(context.Context) (string, error),
+	cbOpts ...CircuitBreakerOption,
+) (records, failures int) {
+	t.Helper()
+
+	cbOpts = append([]CircuitBreakerOption{
+		FailureThreshold(100),
+		SlowCallRate(time.Hour, 1),
+		SlowCallMinCalls(1000),
+	}, cbOpts...)
+
+	p := NewPolicy[string]("",
+		WithClock(newImmediateTestClock()),
+		WithCircuitBreaker(cbOpts...),
+		WithRetry(3, ConstantBackoff(time.Millisecond)),
+	)
+
+	_, _ = p.Do(context.Background(), fn)
+
+	cb := p.circuitBreaker
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	return cb.slowWin.filled, cb.failureCount
+}
+
+// failingThen returns a function that fails n times with err, then succeeds.
+func failingThen(n int, err error) func(context.Context) (string, error) {
+	calls := 0
+
+	return func(_ context.Context) (string, error) {
+		calls++
+		if calls <= n {
+			return "", err
+		}
+
+		return "ok", nil
+	}
+}
+
+func TestCircuitBreakerRecordsPerCallOrPerAttempt(t *testing.T) {
+	t.Parallel()
+
+	transient := errors.New("transient")
+
+	tests := []struct {
+		name         string
+		fn           func(context.Context) (string, error)
+		perAttempt   bool
+		wantRecords  int
+		wantFailures int
+	}{
+		{
+			name:        "retry then success records one success",
+			fn:          failingThen(2, transient),
+			wantRecords: 1,
+		},
+		{
+			name:        "retry then success records every attempt",
+			fn:          failingThen(2, transient),
+			perAttempt:  true,
+			wantRecords: 3,
+		},
+		{
+			name:         "permanent stop records one failure",
+			fn:           failingThen(1, Permanent(transient)),
+			wantRecords:  1,
+			wantFailures: 1,
+		},
+		{
+			name:         "permanent stop records the attempts made",
+			fn:           failingThen(1, Permanent(transient)),
+			perAttempt:   true,
+			wantRecords:  1,
+			wantFailures: 1,
+		},
+		{
+			name:         "exhausted retries record one failure",
+			fn:           failingThen(10, transient),
+			wantRecords:  1,
+			wantFailures: 1,
+		},
+		{
+			name:         "exhausted retries record every failed attempt",
+			fn:           failingThen(10, transient),
+			perAttempt:   true,
+			wantRecords:  3,
+			wantFailures: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var opts []CircuitBreakerOption
+			if tt.perAttempt {
+				opts = append(opts, CountAttempts())
+			}
+
+			records, failures := breakerRecords(t, tt.fn, opts...)
+			assert.Equal(t, tt.wantRecords, records, "records")
+			assert.Equal(t, tt.wantFailures, failures, "consecutive failures")
+		})
+	}
+}
+
+func TestCircuitBreakerCountAttemptsStopsRetryWhenOpen(t *testing.T) {
+	t.Parallel()
+
+	p := NewPolicy[string]("",
+		WithClock(newImmediateTestClock()),
+		WithCircuitBreaker(FailureThreshold(2), RecoveryTimeout(time.Hour), CountAttempts()),
+		WithRetry(5, ConstantBackoff(time.Millisecond)),
+	)
+
+	calls := 0
+	_, err := p.Do(context.Background(), func(_ context.Context) (string, error) {
+		calls++
+		return "", errors.New("down")
+	})
+
+	// Two attempts trip the breaker; the third is rejected, and the rejection
+	// ends the retry sequence instead of burning the remaining attempts.
+	require.ErrorIs(t, err, ErrCircuitOpen)
+	assert.True(t, IsPermanent(err))
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, CircuitOpen, p.circuitBreaker.State())
+}
+
+// TestCountAttemptsConfig verifies the config path maps CountAttempts to the
+// breaker option, and that an explicit false leaves it off.
+func TestCountAttemptsConfig(t *testing.T) {
+	t.Parallel()
+
+	for _, on := range []bool{true, false} {
+		opts, err := cbOptionsFromConfig(&CircuitBreakerConfig{CountAttempts: &on})
+		require.NoError(t, err)
+
+		cb := NewCircuitBreaker(&stubClock{now: time.Now()}, &Hooks{}, opts...)
+		assert.Equal(t, on, cb.cfg.countAttempts)
+	}
+}
+
+// ---------------------------------------------------------------------------
+// Stats
+// ---------------------------------------------------------------------------
+
+func TestCircuitBreakerStats(t *testing.T) {
+	t.Parallel()
+
+	clk := &originClock{now: time.Now()}
+	cb := NewCircuitBreaker(clk, &Hooks{},
+		FailureThreshold(3), RecoveryTimeout(time.Second), HalfOpenMaxAttempts(1))
+
+	cb.RecordFailure()
+	cb.RecordFailure()
+	clk.advance(5 * time.Second)
+
+	stats := cb.Stats()
+	assert.Equal(t, CircuitClosed, stats.State)
+	assert.Equal(t, 2, stats.ConsecutiveFailures)
+	assert.Zero(t, stats.Trips)
+	assert.Equal(t, 5*time.Second, stats.TimeInState)
+
+	cb.RecordFailure() // trips
+	clk.advance(200 * time.Millisecond)
+
+	stats = cb.Stats()
+	assert.Equal(t, CircuitOpen, stats.State)
+	assert.Equal(t, int64(1), stats.Trips)
+	assert.Equal(t, 200*time.Millisecond, stats.TimeInState)
+
+	// A failed half-open probe reopens: a second trip, and the time-in-state
+	// clock restarts.
+	clk.advance(2 * time.Second)
+	require.NoError(t, cb.Allow())
+	cb.RecordFailure()
+
+	stats = cb.Stats()
+	assert.Equal(t, CircuitOpen, stats.State)
+	assert.Equal(t, int64(2), stats.Trips)
+	assert.Zero(t, stats.TimeInState)
+}
+
+func TestPolicyCircuitBreakerStats(t *testing.T) {
+	t.Parallel()
+
+	p := NewPolicy[string]("",
+		WithClock(&originClock{now: time.Now()}),
+		WithCircuitBreaker(FailureThreshold(10)),
+	)
+
+	for range 4 {
+		_, _ = p.Do(context.Background(), func(_ context.Context) (string, error) {
+			return "", errors.New("down")
+		})
+	}
+
+	stats, ok := p.CircuitBreakerStats()
+	require.True(t, ok)
+	assert.Equal(t, 4, stats.ConsecutiveFailures)
+	assert.Equal(t, CircuitClosed, stats.State)
+
+	_, ok = NewPolicy[string]("").CircuitBreakerStats()
+	assert.False(t, ok)
+}
+
+// TestPolicyCircuitBreakerRecordsPanicAsFailure verifies that a panicking call
+// is counted as a breaker failure and that the panic still reaches the caller.
+func TestPolicyCircuitBreakerRecordsPanicAsFailure(t *testing.T) {
+	t.Parallel()
+
+	p := NewPolicy[string]("",
+		WithClock(&originClock{now: time.Now()}),
+		WithCircuitBreaker(FailureThreshold(2), RecoveryTimeout(time.Hour)),
+	)
+
+	panicking := func(_ context.Context) (string, error) {
+		panic("boom")
+	}
+
+	assert.PanicsWithValue(t, "boom", func() {
+		_, _ = p.Do(context.Background(), panicking)
+	})
+
+	stats, ok := p.CircuitBreakerStats()
+	require.True(t, ok)
+	assert.Equal(t, 1, stats.ConsecutiveFailures)
+	assert.Equal(t, CircuitClosed, stats.State)
+
+	assert.PanicsWithValue(t, "boom", func() {
+		_, _ = p.Do(context.Background(), panicking)
+	})
+
+	_, err := p.Do(context.Background(), func(_ context.Context) (string, error) {
+		return "up", nil
+	})
+	require.ErrorIs(t, err, ErrCircuitOpen, "two panics must trip the breaker")
+}
+
+// ---------------------------------------------------------------------------
+// CircuitBreakerIgnoreIf: ignored errors count as neither success nor failure
+// ---------------------------------------------------------------------------
+
+func TestPolicyCircuitBreakerIgnoresPermanentErrors(t *testing.T) {
+	t.Parallel()
+
+	p := NewPolicy[string]("",
+		WithClock(&originClock{now: time.Now()}),
+		WithCircuitBreaker(
+			FailureThreshold(3),
+			RecoveryTimeout(time.Hour),
+			CircuitBreakerIgnoreIf(IsPermanent),
+		),
+	)
+
+	fail := func(err error) {
+		_, _ = p.Do(context.Background(), func(_ context.Context) (string, error) {
+			return "", err
+		})
+	}
+
+	for range 5 {
+		fail(Permanent(errors.New("bad request")))
+	}
+
+	stats, ok := p.CircuitBreakerStats()
+	require.True(t, ok)
+	assert.Equal(t, CircuitClosed, stats.State)
+	assert.Zero(t, stats.ConsecutiveFailures)
+
+	// An ignored error between failures does not reset the streak.
+	fail(Transient(errors.New("unavailable")))
+	fail(Transient(errors.New("unavailable")))
+	fail(Permanent(errors.New("bad request")))
+
+	stats, _ = p.CircuitBreakerStats()
+	assert.Equal(t, 2, stats.ConsecutiveFailures)
+
+	fail(Transient(errors.New("unavailable")))
+
+	stats, _ = p.CircuitBreakerStats()
+	assert.Equal(t, CircuitOpen, stats.State)
+}
+
+func TestCircuitBreakerIgnoredProbeReleasesSlot(t *testing.T) {
+	t.Parallel()
+
+	clk := &stubClock{now: time.Now()}
+	cb := NewCircuitBreaker(clk, &Hooks{},
+		FailureThreshold(1),
+		RecoveryTimeout(time.Second),
+		CircuitBreakerIgnoreIf(IsPermanent),
+	)
+
+	cb.RecordFailure()
+	clk.setElapsed(2 * time.Second)
+	require.NoError(t, cb.Allow())
+	require.Equal(t, CircuitHalfOpen, cb.State())
+
+	cb.Record(0, Permanent(errors.New("bad request")))
+	assert.Equal(t, CircuitHalfOpen, cb.State(), "an ignored probe decides nothing")
+
+	require.NoError(t, cb.Allow(), "the ignored probe gave its slot back")
+	cb.Record(0, nil)
+	assert.Equal(t, CircuitClosed, cb.State())
+}
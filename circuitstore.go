@@ -0,0 +1,132 @@
+package r8e
+
+import (
+	"context"
+	"time"
+)
+
+// ---------------------------------------------------------------------------
+// CircuitStore — circuit breaker state shared across processes
+// ---------------------------------------------------------------------------.
+
+// CircuitStore shares circuit breaker state between the replicas of a fleet,
+// so one process learning that a dependency is down opens the breaker of the
+// others instead of each discovering it on its own. Back it with Redis or any
+// store with expiring keys; implementations must be safe for concurrent use
+// and should bound their own calls with a timeout, since the breaker calls
+// them with a background context.
+//
+// Pattern: Shared State — the local breaker stays authoritative and fast; the
+// store is eventual coordination consulted and updated alongside it.
+type CircuitStore interface {
+	// GetState returns the state last published for key; ok is false when
+	// there is none (never published, or expired).
+	GetState(ctx context.Context, key string) (state CircuitState, ok bool, err error)
+	// SetState publishes state for key, expiring it after ttl.
+	SetState(ctx context.Context, key string, state CircuitState, ttl time.Duration) error
+}
+
+// defaultCircuitStoreSync is how often a breaker reads its [CircuitStore] by
+// default.
+const defaultCircuitStoreSync = time.Second
+
+// SharedCircuitState makes the breaker coordinate through store under key
+// (the policy name, with [WithCircuitStore]). Every breaker sharing a key
+// behaves as follows:
+//
+//   - Opening publishes [CircuitOpen] with the recovery timeout as TTL, and
+//     closing publishes [CircuitClosed], so the shared entry tracks the latest
+//     local transition.
+//   - A closed or ramping breaker reads the store at most once per
+//     [CircuitStoreSyncInterval] (1s by default) on Allow. When it finds
+//     [CircuitOpen] it opens too, firing OnCircuitOpen, and recovers through
+//     its own recovery timeout and half-open probes as if it had tripped.
+//
+// The local state machine stays the fast path: the store is only read on the
+// interval and never while holding the breaker's lock. A failing store is
+// ignored, so the breaker degrades to local-only. A nil store disables
+// sharing.
+func SharedCircuitState(store CircuitStore, key string) CircuitBreakerOption {
+	return func(cfg *circuitBreakerConfig) {
+		cfg.store = store
+		cfg.storeKey = key
+	}
+}
+
+// CircuitStoreSyncInterval sets how often a breaker reads its shared
+// [CircuitStore] (see [SharedCircuitState]): a shorter interval spreads an
+// open faster across the fleet at the cost of more store reads. A
+// non-positive d reads it on every Allow. Default 1s.
+func CircuitStoreSyncInterval(d time.Duration) CircuitBreakerOption {
+	return func(cfg *circuitBreakerConfig) {
+		cfg.storeSync = d
+	}
+}
+
+// WithCircuitStore shares the policy's circuit breaker state through store,
+// keyed by the policy name (see [SharedCircuitState]): when one replica's
+// breaker opens, the breakers of the policies sharing the name and store
+// open too. It requires [WithCircuitBreaker]; without one it is the
+// misconfiguration [NewPolicy] panics with [ErrCircuitStoreWithoutBreaker].
+// A nil store is ignored.
+func WithCircuitStore(store CircuitStore) Option {
+	return optionFunc(func(s *policySetup) {
+		if store != nil {
+			s.circuitStore = store
+		}
+	})
+}
+
+// syncFromStoreLocked adopts an open state published by another breaker
+// sharing the store, returning the open hook to fire after unlock (or nil). It
+// is a no-op without a store, while the local breaker is already open or
+// probing, and until the sync interval has elapsed since the last read. The
+// store is read with mu released, so Allow decides on the state as it stands
+// afterwards. Caller must hold mu; it is held again on return.
+func (cb *CircuitBreaker) syncFromStoreLocked() func() {
+	store, key := cb.cfg.store, cb.cfg.storeKey
+	if store == nil || !cb.syncableLocked() ||
+		(!cb.storeSyncedAt.IsZero() && cb.clock.Since(cb.storeSyncedAt) < cb.cfg.storeSync) {
+		return nil
+	}
+
+	cb.storeSyncedAt = cb.clock.Now()
+
+	cb.mu.Unlock()
+	state, ok, err := store.GetState(context.Background(), key)
+	cb.mu.Lock()
+
+	// Re-checked: the breaker may have moved while the store was read.
+	if err != nil || !ok || state != CircuitOpen || !cb.syncableLocked() {
+		return nil
+	}
+
+	cb.recoveryAttempt = 0
+
+	return cb.tripLocked(cb.hooks.emitCircuitOpen)
+}
+
+// syncableLocked reports whether the breaker is in a state an open from the
+// store can move it out of: closed or ramping. Caller must hold mu.
+func (cb *CircuitBreaker) syncableLocked() bool {
+	return cb.state == stateClosed || cb.state == stateRamping
+}
+
+// publishLocked returns emit extended to publish state to the shared store
+// after the lifecycle hook, or emit unchanged without a store. The TTL is
+// taken now, under the lock: the current recovery timeout, so a published
+// open expires when this breaker would start probing. Caller must hold mu.
+func (cb *CircuitBreaker) publishLocked(state CircuitState, emit func()) func() {
+	store, key := cb.cfg.store, cb.cfg.storeKey
+	if store == nil {
+		return emit
+	}
+
+	ttl := cb.currentRecoveryTimeout()
+
+	return func() {
+		emit()
+
+		_ = store.SetState(context.Background(), key, state, ttl) // best effort: the local state stands
+	}
+}
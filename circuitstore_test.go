@@ -0,0 +1,208 @@
+package r8e_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/byte4ever/r8e"
+	"github.com/byte4ever/r8e/internal/clocktest"
+)
+
+// memCircuitStore is an in-memory CircuitStore whose entries expire on a
+// test clock.
+type memCircuitStore struct {
+	clock   r8e.Clock
+	entries map[string]memCircuitEntry
+	getErr  error
+	gets    int
+	mu      sync.Mutex
+}
+
+type memCircuitEntry struct {
+	expires time.Time
+	state   r8e.CircuitState
+}
+
+func newMemCircuitStore(clock r8e.Clock) *memCircuitStore {
+	return &memCircuitStore{clock: clock, entries: make(map[string]memCircuitEntry)}
+}
+
+func (s *memCircuitStore) GetState(
+	_ context.Context,
+	key string,
+) (r8e.CircuitState, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.gets++
+
+	if s.getErr != nil {
+		return "", false, s.getErr
+	}
+
+	e, ok := s.entries[key]
+	if !ok || !s.clock.Now().Before(e.expires) {
+		return "", false, nil
+	}
+
+	return e.state, true, nil
+}
+
+func (s *memCircuitStore) SetState(
+	_ context.Context,
+	key string,
+	state r8e.CircuitState,
+	ttl time.Duration,
+) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.entries[key] = memCircuitEntry{state: state, expires: s.clock.Now().Add(ttl)}
+
+	return nil
+}
+
+func (s *memCircuitStore) state(key string) r8e.CircuitState {
+	state, _, _ := s.GetState(context.Background(), key)
+
+	return state
+}
+
+func TestCircuitStoreOpenSpreadsToSharingBreaker(t *testing.T) {
+	t.Parallel()
+
+	clock := clocktest.New()
+	store := newMemCircuitStore(clock)
+	newBreaker := func(hooks *r8e.Hooks) *r8e.CircuitBreaker {
+		return r8e.NewCircuitBreaker(clock, hooks,
+			r8e.FailureThreshold(1),
+			r8e.RecoveryTimeout(time.Minute),
+			r8e.SharedCircuitState(store, "payments"),
+			r8e.CircuitStoreSyncInterval(0),
+		)
+	}
+
+	var opened int
+
+	first := newBreaker(&r8e.Hooks{})
+	second := newBreaker(&r8e.Hooks{OnCircuitOpen: func() { opened++ }})
+
+	require.NoError(t, second.Allow())
+	second.RecordSuccess()
+
+	require.NoError(t, first.Allow())
+	first.RecordFailure()
+	require.Equal(t, r8e.CircuitOpen, first.State())
+	assert.Equal(t, r8e.CircuitOpen, store.state("payments"), "the open is published")
+
+	require.ErrorIs(t, second.Allow(), r8e.ErrCircuitOpen,
+		"the sharing breaker rejects without having seen a failure")
+	assert.Equal(t, r8e.CircuitOpen, second.State())
+	assert.Equal(t, 1, opened, "adopting the open fires OnCircuitOpen")
+
+	// The adopter recovers on its own timeout and publishes the close.
+	clock.Advance(time.Minute + time.Second)
+	require.NoError(t, second.Allow(), "half-open probe")
+	second.RecordSuccess()
+	require.Equal(t, r8e.CircuitClosed, second.State())
+	assert.Equal(t, r8e.CircuitClosed, store.state("payments"))
+
+	third := newBreaker(&r8e.Hooks{})
+	require.NoError(t, third.Allow(), "a published close is not adopted as open")
+}
+
+func TestCircuitStoreSyncInterval(t *testing.T) {
+	t.Parallel()
+
+	clock := clocktest.New()
+	store := newMemCircuitStore(clock)
+	opener := r8e.NewCircuitBreaker(clock, &r8e.Hooks{},
+		r8e.FailureThreshold(1),
+		r8e.SharedCircuitState(store, "payments"),
+	)
+	follower := r8e.NewCircuitBreaker(clock, &r8e.Hooks{},
+		r8e.SharedCircuitState(store, "payments"),
+		r8e.CircuitStoreSyncInterval(5*time.Second),
+	)
+
+	require.NoError(t, follower.Allow(), "first read: nothing published")
+	follower.RecordSuccess()
+
+	opener.RecordFailure()
+
+	require.NoError(t, follower.Allow(), "the store is not read again yet")
+	follower.RecordSuccess()
+	assert.Equal(t, 1, store.gets)
+
+	clock.Advance(5 * time.Second)
+	require.ErrorIs(t, follower.Allow(), r8e.ErrCircuitOpen)
+	assert.Equal(t, 2, store.gets)
+
+	// Open, the follower stops reading the store until it closes again.
+	_ = follower.Allow()
+	assert.Equal(t, 2, store.gets)
+}
+
+func TestCircuitStoreFailureIsIgnored(t *testing.T) {
+	t.Parallel()
+
+	clock := clocktest.New()
+	store := newMemCircuitStore(clock)
+	store.getErr = errors.New("redis unreachable")
+
+	cb := r8e.NewCircuitBreaker(clock, &r8e.Hooks{},
+		r8e.SharedCircuitState(store, "payments"),
+		r8e.CircuitStoreSyncInterval(0),
+	)
+
+	require.NoError(t, cb.Allow(), "a failing store degrades to local-only")
+	assert.Equal(t, r8e.CircuitClosed, cb.State())
+}
+
+func TestWithCircuitStoreSharesByPolicyName(t *testing.T) {
+	t.Parallel()
+
+	clock := clocktest.New()
+	store := newMemCircuitStore(clock)
+	newPolicy := func() *r8e.Policy[string] {
+		return r8e.NewPolicy[string]("inventory-api",
+			r8e.WithClock(clock),
+			r8e.WithRegistry(r8e.NewRegistry()),
+			r8e.WithCircuitBreaker(r8e.FailureThreshold(1), r8e.CircuitStoreSyncInterval(0)),
+			r8e.WithCircuitStore(store),
+		)
+	}
+
+	replicaA, replicaB := newPolicy(), newPolicy()
+
+	_, err := replicaA.Do(context.Background(), func(context.Context) (string, error) {
+		return "", errors.New("down")
+	})
+	require.Error(t, err)
+	assert.Equal(t, r8e.CircuitOpen, store.state("inventory-api"))
+
+	called := false
+	_, err = replicaB.Do(context.Background(), func(context.Context) (string, error) {
+		called = true
+
+		return "ok", nil
+	})
+	require.ErrorIs(t, err, r8e.ErrCircuitOpen)
+	assert.False(t, called, "replica B fast-fails on replica A's open")
+}
+
+func TestWithCircuitStoreRequiresBreaker(t *testing.T) {
+	t.Parallel()
+
+	require.PanicsWithValue(t, r8e.ErrCircuitStoreWithoutBreaker, func() {
+		r8e.NewPolicy[string]("",
+			r8e.WithCircuitStore(newMemCircuitStore(clocktest.New())),
+		)
+	})
+}
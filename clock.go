@@ -1,6 +1,9 @@
 package r8e
 
-import "time"
+import (
+	"sync/atomic"
+	"time"
+)
 
 type (
 	// Clock abstracts time operations so that resilience patterns can be tested
@@ -39,8 +42,49 @@ type (
 	realTimer struct {
 		inner *time.Timer
 	}
+
+	// timerFuncClock is a [Clock] whose timers come from newTimer while Now and
+	// Since stay with the embedded clock (see WithTimerFunc).
+	timerFuncClock struct {
+		Clock
+		newTimer func(time.Duration) Timer
+	}
 )
 
+// defaultClock is the clock [SetDefaultClock] installed for policies built
+// without [WithClock]; nil means [RealClock]. It holds a *Clock because an
+// atomic.Value would reject a second concrete clock type.
+//
+//nolint:gochecknoglobals // process-wide default, swapped atomically
+var defaultClock atomic.Pointer[Clock]
+
+// SetDefaultClock sets the clock every policy built afterwards without
+// [WithClock] uses, so a test suite can make all its policies deterministic
+// without threading WithClock through each one. An explicit WithClock still
+// wins, and policies already built keep their clock. A nil c restores
+// [RealClock]. It is safe to call concurrently with [NewPolicy], though it is
+// meant to be set once, e.g. from TestMain or an init function.
+func SetDefaultClock(c Clock) {
+	if c == nil {
+		defaultClock.Store(nil)
+
+		return
+	}
+
+	defaultClock.Store(&c)
+}
+
+// currentDefaultClock returns the clock a policy without [WithClock] uses.
+//
+//nolint:ireturn // returns the Clock interface by design
+func currentDefaultClock() Clock {
+	if c := defaultClock.Load(); c != nil {
+		return *c
+	}
+
+	return RealClock{}
+}
+
 // Now returns the current wall-clock time via [time.Now].
 func (RealClock) Now() time.Time { return time.Now() }
 
@@ -55,3 +99,6 @@ func (RealClock) NewTimer(d time.Duration) Timer {
 func (t *realTimer) C() <-chan time.Time        { return t.inner.C }
 func (t *realTimer) Stop() bool                 { return t.inner.Stop() }
 func (t *realTimer) Reset(d time.Duration) bool { return t.inner.Reset(d) }
+
+// NewTimer creates a [Timer] through the overriding timer function.
+func (c timerFuncClock) NewTimer(d time.Duration) Timer { return c.newTimer(d) }
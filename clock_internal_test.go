@@ -0,0 +1,45 @@
+package r8e
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// The SetDefaultClock tests mutate the process-wide default, so they are not
+// parallel: sequential tests finish before any parallel test resumes, and each
+// restores RealClock before returning.
+
+//nolint:paralleltest // mutates the process-wide default clock
+func TestSetDefaultClockUsedWithoutWithClock(t *testing.T) {
+	manual := &stubClock{now: time.Now()}
+
+	SetDefaultClock(manual)
+	t.Cleanup(func() { SetDefaultClock(nil) })
+
+	p := NewPolicy[string]("default-clock")
+
+	assert.Same(t, manual, p.clock)
+}
+
+//nolint:paralleltest // mutates the process-wide default clock
+func TestSetDefaultClockExplicitWithClockWins(t *testing.T) {
+	SetDefaultClock(&stubClock{now: time.Now()})
+	t.Cleanup(func() { SetDefaultClock(nil) })
+
+	explicit := &stubClock{now: time.Now()}
+	p := NewPolicy[string]("explicit-clock", WithClock(explicit))
+
+	assert.Same(t, explicit, p.clock)
+}
+
+//nolint:paralleltest // mutates the process-wide default clock
+func TestSetDefaultClockNilRestoresRealClock(t *testing.T) {
+	SetDefaultClock(&stubClock{now: time.Now()})
+	SetDefaultClock(nil)
+
+	p := NewPolicy[string]("real-clock")
+
+	assert.Equal(t, RealClock{}, p.clock)
+}
@@ -0,0 +1,272 @@
+// Package clocktest provides a manually driven [r8e.Clock] for writing
+// deterministic tests against r8e policies: backoff sleeps, timeouts, breaker
+// recovery and rate-limiter refills all follow the test's [ManualClock.Advance]
+// and [ManualClock.Set] calls rather than the wall clock, so a test of a retry
+// policy runs in microseconds and never flakes.
+//
+// A timer created by the clock fires once the clock has been moved to or past
+// its deadline. Policies create their timers on their own goroutines, so a test
+// that drives a call from another goroutine first waits for the timer with
+// [ManualClock.BlockUntil], then advances:
+//
+//	clock := clocktest.NewManualClock(time.Time{})
+//	policy := r8e.NewPolicy[string]("api",
+//		r8e.WithClock(clock),
+//		r8e.WithRetry(3, r8e.ConstantBackoff(time.Second)),
+//	)
+//
+//	go func() { done <- call(policy) }()
+//
+//	clock.BlockUntil(1)          // the first backoff timer is armed
+//	clock.Advance(time.Second)   // ... and now it fires
+//
+// For purely sequential tests, [ManualClock.SetAutoAdvance] makes every timer
+// jump the clock to its deadline and fire at once.
+//
+// A ManualClock is safe for concurrent use: the rate limiter's CAS loops, hedge
+// goroutines and the test goroutine may all read and drive it at once.
+package clocktest
+
+import (
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/byte4ever/r8e"
+)
+
+type (
+	// ManualClock is an [r8e.Clock] whose time moves only when the test moves
+	// it. Create one with [NewManualClock]; the zero value is not usable.
+	ManualClock struct {
+		now    time.Time
+		cond   *sync.Cond
+		timers []*manualTimer
+		mu     sync.Mutex
+		auto   bool
+	}
+
+	// manualTimer is an [r8e.Timer] fired by its [ManualClock]. deadline and
+	// active are guarded by the clock's mutex.
+	manualTimer struct {
+		deadline time.Time
+		clock    *ManualClock
+		ch       chan time.Time
+		active   bool
+	}
+)
+
+// defaultStart is the instant a ManualClock starts at when given the zero time:
+// fixed, so test output is reproducible.
+var defaultStart = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC) //nolint:gochecknoglobals // read-only anchor
+
+// NewManualClock returns a ManualClock reading start. A zero start anchors it
+// at 2025-01-01T00:00:00Z.
+func NewManualClock(start time.Time) *ManualClock {
+	if start.IsZero() {
+		start = defaultStart
+	}
+
+	c := &ManualClock{now: start}
+	c.cond = sync.NewCond(&c.mu)
+
+	return c
+}
+
+// Now returns the clock's current time.
+func (c *ManualClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+// Since returns the time elapsed between t and the clock's current time.
+func (c *ManualClock) Since(t time.Time) time.Duration {
+	return c.Now().Sub(t)
+}
+
+// NewTimer returns a timer that fires once the clock reaches now+d. A
+// non-positive d fires at once; with auto-advance on, the clock jumps to the
+// deadline and the timer fires at once.
+//
+//nolint:ireturn // satisfies the r8e.Timer interface by design
+func (c *ManualClock) NewTimer(d time.Duration) r8e.Timer {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	t := &manualTimer{clock: c, ch: make(chan time.Time, 1)}
+	c.armLocked(t, d)
+
+	return t
+}
+
+// Advance moves the clock forward by d, firing every timer whose deadline it
+// reaches, earliest first. A negative d is ignored.
+func (c *ManualClock) Advance(d time.Duration) {
+	if d < 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+	c.fireDueLocked()
+}
+
+// Set moves the clock to t, firing every timer whose deadline it reaches,
+// earliest first. Setting it backwards fires nothing; timers keep their
+// deadlines.
+func (c *ManualClock) Set(t time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = t
+	c.fireDueLocked()
+}
+
+// FireAll fires every pending timer without moving the clock, as if all their
+// deadlines had passed.
+func (c *ManualClock) FireAll() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, t := range c.timers {
+		t.fireLocked(c.now)
+	}
+
+	c.timers = nil
+}
+
+// SetAutoAdvance turns auto-advance on or off. While on, every new or reset
+// timer moves the clock to its deadline and fires at once, so sequential code
+// that sleeps on the clock runs without a driver goroutine while still seeing
+// the time it slept pass.
+func (c *ManualClock) SetAutoAdvance(on bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.auto = on
+}
+
+// Pending returns the number of timers armed and not yet fired or stopped.
+func (c *ManualClock) Pending() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.timers)
+}
+
+// BlockUntil blocks until at least n timers are pending — the synchronization
+// point for a test driving a call on another goroutine: once the call has armed
+// its backoff or timeout timer, advancing the clock is sure to reach it.
+func (c *ManualClock) BlockUntil(n int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for len(c.timers) < n {
+		c.cond.Wait()
+	}
+}
+
+// armLocked schedules t to fire d from now, or at once when d is not positive
+// or auto-advance is on. Caller must hold c.mu.
+func (c *ManualClock) armLocked(t *manualTimer, d time.Duration) {
+	t.deadline = c.now.Add(d)
+
+	if c.auto && d > 0 {
+		c.now = t.deadline
+		c.fireDueLocked()
+	}
+
+	if d <= 0 || c.auto {
+		t.fireLocked(c.now)
+
+		return
+	}
+
+	t.active = true
+	c.timers = append(c.timers, t)
+	c.cond.Broadcast()
+}
+
+// fireDueLocked fires the pending timers whose deadline the clock has reached,
+// earliest first. Caller must hold c.mu.
+func (c *ManualClock) fireDueLocked() {
+	slices.SortStableFunc(c.timers, func(a, b *manualTimer) int {
+		return a.deadline.Compare(b.deadline)
+	})
+
+	fired := 0
+	for _, t := range c.timers {
+		if t.deadline.After(c.now) {
+			break
+		}
+
+		t.fireLocked(c.now)
+
+		fired++
+	}
+
+	c.timers = slices.Delete(c.timers, 0, fired)
+}
+
+// removeLocked drops t from the pending timers. Caller must hold c.mu.
+func (c *ManualClock) removeLocked(t *manualTimer) {
+	c.timers = slices.DeleteFunc(c.timers, func(p *manualTimer) bool {
+		return p == t
+	})
+}
+
+// C returns the channel the firing time is delivered on.
+func (t *manualTimer) C() <-chan time.Time { return t.ch }
+
+// Stop cancels the timer and reports whether it was still pending. Like
+// [time.Timer.Stop] since Go 1.23, a value already delivered but not yet
+// received is discarded.
+func (t *manualTimer) Stop() bool {
+	t.clock.mu.Lock()
+	defer t.clock.mu.Unlock()
+
+	was := t.active
+	t.active = false
+	t.clock.removeLocked(t)
+	t.drain()
+
+	return was
+}
+
+// Reset re-arms the timer to fire d from the clock's current time and reports
+// whether it was still pending. A value not yet received is discarded.
+func (t *manualTimer) Reset(d time.Duration) bool {
+	t.clock.mu.Lock()
+	defer t.clock.mu.Unlock()
+
+	was := t.active
+	t.active = false
+	t.clock.removeLocked(t)
+	t.drain()
+	t.clock.armLocked(t, d)
+
+	return was
+}
+
+// fireLocked delivers now on the timer's channel and marks it fired. Caller must
+// hold the clock's mutex.
+func (t *manualTimer) fireLocked(now time.Time) {
+	t.active = false
+
+	select {
+	case t.ch <- now:
+	default:
+	}
+}
+
+// drain discards an undelivered firing time.
+func (t *manualTimer) drain() {
+	select {
+	case <-t.ch:
+	default:
+	}
+}
@@ -0,0 +1,181 @@
+package clocktest_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/byte4ever/r8e/clocktest"
+)
+
+func TestManualClockAdvanceFiresDueTimersInOrder(t *testing.T) {
+	t.Parallel()
+
+	clock := clocktest.NewManualClock(time.Time{})
+	start := clock.Now()
+
+	late := clock.NewTimer(3 * time.Second)
+	early := clock.NewTimer(time.Second)
+
+	clock.Advance(2 * time.Second)
+
+	select {
+	case at := <-early.C():
+		assert.Equal(t, start.Add(2*time.Second), at)
+	default:
+		t.Fatal("the 1s timer did not fire after 2s")
+	}
+
+	select {
+	case <-late.C():
+		t.Fatal("the 3s timer fired after 2s")
+	default:
+	}
+
+	assert.Equal(t, 1, clock.Pending())
+	assert.Equal(t, 2*time.Second, clock.Since(start))
+
+	clock.Advance(time.Second)
+	<-late.C()
+	assert.Zero(t, clock.Pending())
+}
+
+func TestManualClockSet(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2030, 6, 1, 12, 0, 0, 0, time.UTC)
+	clock := clocktest.NewManualClock(start)
+	require.Equal(t, start, clock.Now())
+
+	timer := clock.NewTimer(time.Minute)
+
+	clock.Set(start.Add(-time.Hour))
+	assert.Equal(t, 1, clock.Pending(), "going backwards fires nothing")
+
+	clock.Set(start.Add(time.Minute))
+	<-timer.C()
+	assert.Equal(t, start.Add(time.Minute), clock.Now())
+}
+
+func TestManualClockStopAndReset(t *testing.T) {
+	t.Parallel()
+
+	clock := clocktest.NewManualClock(time.Time{})
+
+	timer := clock.NewTimer(time.Second)
+	assert.True(t, timer.Stop())
+	assert.False(t, timer.Stop(), "already stopped")
+
+	clock.Advance(time.Hour)
+
+	select {
+	case <-timer.C():
+		t.Fatal("a stopped timer fired")
+	default:
+	}
+
+	assert.False(t, timer.Reset(time.Second))
+	assert.True(t, timer.Reset(2*time.Second), "pending again after Reset")
+
+	clock.Advance(time.Second)
+	assert.Equal(t, 1, clock.Pending())
+
+	clock.Advance(time.Second)
+	<-timer.C()
+}
+
+func TestManualClockNonPositiveTimerFiresAtOnce(t *testing.T) {
+	t.Parallel()
+
+	clock := clocktest.NewManualClock(time.Time{})
+
+	<-clock.NewTimer(0).C()
+	<-clock.NewTimer(-time.Second).C()
+	assert.Zero(t, clock.Pending())
+}
+
+func TestManualClockFireAll(t *testing.T) {
+	t.Parallel()
+
+	clock := clocktest.NewManualClock(time.Time{})
+	start := clock.Now()
+
+	a := clock.NewTimer(time.Hour)
+	b := clock.NewTimer(24 * time.Hour)
+
+	clock.FireAll()
+
+	<-a.C()
+	<-b.C()
+	assert.Equal(t, start, clock.Now(), "FireAll does not move the clock")
+}
+
+func TestManualClockAutoAdvance(t *testing.T) {
+	t.Parallel()
+
+	clock := clocktest.NewManualClock(time.Time{})
+	start := clock.Now()
+	clock.SetAutoAdvance(true)
+
+	<-clock.NewTimer(time.Second).C()
+	<-clock.NewTimer(2 * time.Second).C()
+
+	assert.Equal(t, 3*time.Second, clock.Since(start))
+	assert.Zero(t, clock.Pending())
+}
+
+func TestManualClockBlockUntil(t *testing.T) {
+	t.Parallel()
+
+	clock := clocktest.NewManualClock(time.Time{})
+	fired := make(chan struct{})
+
+	go func() {
+		<-clock.NewTimer(time.Second).C()
+		close(fired)
+	}()
+
+	clock.BlockUntil(1)
+	clock.Advance(time.Second)
+
+	select {
+	case <-fired:
+	case <-time.After(time.Second):
+		t.Fatal("the timer armed on another goroutine did not fire")
+	}
+}
+
+func TestManualClockConcurrentUse(t *testing.T) {
+	t.Parallel()
+
+	clock := clocktest.NewManualClock(time.Time{})
+	ctx, cancel := context.WithCancel(t.Context())
+
+	var wg sync.WaitGroup
+
+	for range 8 {
+		wg.Go(func() {
+			for ctx.Err() == nil {
+				timer := clock.NewTimer(time.Millisecond)
+				_ = clock.Now()
+
+				select {
+				case <-timer.C():
+				case <-ctx.Done():
+					timer.Stop()
+				}
+			}
+		})
+	}
+
+	for range 1000 {
+		clock.Advance(time.Millisecond)
+	}
+
+	cancel()
+	wg.Wait()
+}
@@ -0,0 +1,47 @@
+package clocktest_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/byte4ever/r8e"
+	"github.com/byte4ever/r8e/clocktest"
+)
+
+// A retry policy with a one-second backoff tested without sleeping: the test
+// drives the backoff by advancing the clock once each wait is armed.
+func ExampleManualClock() {
+	clock := clocktest.NewManualClock(time.Time{})
+	start := clock.Now()
+
+	policy := r8e.NewPolicy[string]("flaky-api",
+		r8e.WithRegistry(r8e.NewRegistry()),
+		r8e.WithClock(clock),
+		r8e.WithRetry(3, r8e.ConstantBackoff(time.Second)),
+	)
+
+	attempts := 0
+	done := make(chan string)
+
+	go func() {
+		result, _ := policy.Do(context.Background(), func(context.Context) (string, error) {
+			attempts++
+			if attempts < 3 {
+				return "", errors.New("unavailable")
+			}
+
+			return "ok", nil
+		})
+		done <- result
+	}()
+
+	for range 2 {
+		clock.BlockUntil(1) // the backoff timer is armed
+		clock.Advance(time.Second)
+	}
+
+	fmt.Println(<-done, attempts, clock.Since(start))
+	// Output: ok 3 2s
+}
@@ -418,6 +418,64 @@ func TestWithCoalescePolicyCollapsesByKey(t *testing.T) {
 	assert.Equal(t, int64(callers-1), followerHook.Load())
 }
 
+// TestWithCoalesceHundredCallersOneExecution is the stampede scenario: 100
+// goroutines hit one key, one of them gives up mid-flight, and fn still runs
+// exactly once with every remaining caller sharing its result.
+func TestWithCoalesceHundredCallersOneExecution(t *testing.T) {
+	t.Parallel()
+
+	const callers = 100
+
+	policy := NewPolicy[string]("coalesce-stampede",
+		WithTimeout(5*time.Second),
+		WithCoalesce(keyFromCtx),
+	)
+	g := newGate()
+
+	ctx := context.WithValue(context.Background(), testKey{}, "hot")
+
+	results := make(chan string, callers)
+
+	go func() {
+		v, err := policy.Do(ctx, g.fn("shared"))
+		assert.NoError(t, err)
+		results <- v
+	}()
+	<-g.started
+
+	// One impatient follower cancels while the shared call is in flight.
+	impatient, cancel := context.WithCancel(ctx)
+	impatientErr := make(chan error, 1)
+
+	go func() {
+		_, err := policy.Do(impatient, g.fn("unused"))
+		impatientErr <- err
+	}()
+
+	for range callers - 2 {
+		go func() {
+			v, err := policy.Do(ctx, g.fn("unused"))
+			assert.NoError(t, err)
+			results <- v
+		}()
+	}
+
+	require.Eventually(t, func() bool {
+		return policy.Metrics().CoalesceFollowers == callers-1
+	}, waitTimeout, waitTick)
+
+	cancel()
+	require.ErrorIs(t, <-impatientErr, context.Canceled)
+
+	close(g.release)
+
+	for range callers - 1 {
+		assert.Equal(t, "shared", <-results)
+	}
+
+	assert.Equal(t, int64(1), g.calls.Load(), "fn must run exactly once")
+}
+
 // TestWithCoalesceCollapsesBeforeBulkhead proves coalescing sits outside the
 // bulkhead: many concurrent same-key calls take a single bulkhead slot, so a
 // bulkhead of 1 admits them all instead of rejecting the duplicates.
@@ -0,0 +1,168 @@
+package r8e_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/byte4ever/r8e"
+)
+
+// ---------------------------------------------------------------------------
+// Asserting a policy's composition through the public API only
+// ---------------------------------------------------------------------------
+
+func TestPolicyPatternsInExecutionOrder(t *testing.T) {
+	t.Parallel()
+
+	// Options are deliberately passed out of execution order: Patterns reports
+	// the order the chain runs in, not the order the options were given.
+	p := r8e.NewPolicy[string]("",
+		r8e.WithRetry(4, r8e.ConstantBackoff(time.Millisecond)),
+		r8e.WithFallback("cached"),
+		r8e.WithBulkhead(8),
+		r8e.WithCircuitBreaker(r8e.FailureThreshold(3)),
+		r8e.WithTimeout(time.Second),
+	)
+
+	assert.Equal(t,
+		[]string{"fallback", "timeout", "circuit_breaker", "bulkhead", "retry"},
+		p.Patterns(),
+	)
+
+	attempts, ok := p.RetryAttempts()
+	require.True(t, ok)
+	assert.Equal(t, 4, attempts)
+
+	threshold, ok := p.CircuitBreakerThreshold()
+	require.True(t, ok)
+	assert.Equal(t, 3, threshold)
+}
+
+func TestPolicyPatternsCountAttemptsMovesBreakerInsideRetry(t *testing.T) {
+	t.Parallel()
+
+	p := r8e.NewPolicy[string]("",
+		r8e.WithCircuitBreaker(r8e.CountAttempts()),
+		r8e.WithRetry(3, r8e.ConstantBackoff(time.Millisecond)),
+	)
+
+	assert.Equal(t, []string{"retry", "circuit_breaker"}, p.Patterns())
+}
+
+func TestPolicyPatternsEmptyPolicy(t *testing.T) {
+	t.Parallel()
+
+	p := r8e.NewPolicy[string]("")
+
+	assert.Empty(t, p.Patterns())
+
+	_, ok := p.RetryAttempts()
+	assert.False(t, ok)
+
+	_, ok = p.CircuitBreakerThreshold()
+	assert.False(t, ok)
+}
+
+func TestPolicyPatternsReturnsCopy(t *testing.T) {
+	t.Parallel()
+
+	p := r8e.NewPolicy[string]("", r8e.WithTimeout(time.Second))
+
+	got := p.Patterns()
+	got[0] = "mutated"
+
+	assert.Equal(t, []string{"timeout"}, p.Patterns())
+}
+
+func TestPolicyAccessorsReflectReconfigure(t *testing.T) {
+	t.Parallel()
+
+	p := r8e.NewPolicy[string]("",
+		r8e.WithCircuitBreaker(r8e.FailureThreshold(3)),
+	)
+
+	threshold := 7
+	require.NoError(t, p.Reconfigure(r8e.PolicyConfig{
+		CircuitBreaker: &r8e.CircuitBreakerConfig{FailureThreshold: &threshold},
+	}))
+
+	got, ok := p.CircuitBreakerThreshold()
+	require.True(t, ok)
+	assert.Equal(t, 7, got)
+}
+
+// ---------------------------------------------------------------------------
+// Switching patterns off and on at runtime
+// ---------------------------------------------------------------------------
+
+func TestSetPatternEnabledBypassesOpenBreaker(t *testing.T) {
+	t.Parallel()
+
+	p := r8e.NewPolicy[string]("",
+		r8e.WithCircuitBreaker(r8e.FailureThreshold(1), r8e.RecoveryTimeout(time.Hour)),
+	)
+
+	_, err := p.Do(context.Background(), func(context.Context) (string, error) {
+		return "", errors.New("boom")
+	})
+	require.Error(t, err)
+
+	succeed := func(context.Context) (string, error) { return "ok", nil }
+
+	_, err = p.Do(context.Background(), succeed)
+	require.ErrorIs(t, err, r8e.ErrCircuitOpen, "the breaker is open")
+
+	require.True(t, p.SetPatternEnabled("circuit_breaker", false))
+	assert.False(t, p.PatternEnabled("circuit_breaker"))
+
+	for range 3 {
+		got, err := p.Do(context.Background(), succeed)
+		require.NoError(t, err, "calls flow through the disabled breaker")
+		assert.Equal(t, "ok", got)
+	}
+
+	require.True(t, p.SetPatternEnabled("circuit_breaker", true))
+	assert.True(t, p.PatternEnabled("circuit_breaker"))
+
+	_, err = p.Do(context.Background(), succeed)
+	require.ErrorIs(t, err, r8e.ErrCircuitOpen, "re-enabled, it resumes open")
+}
+
+func TestSetPatternEnabledDisablesRetry(t *testing.T) {
+	t.Parallel()
+
+	p := r8e.NewPolicy[string]("",
+		r8e.WithTimeout(time.Second),
+		r8e.WithRetry(3, r8e.ConstantBackoff(time.Millisecond)),
+	)
+	require.True(t, p.SetPatternEnabled("retry", false))
+
+	var calls int
+
+	_, err := p.Do(context.Background(), func(context.Context) (string, error) {
+		calls++
+
+		return "", errors.New("boom")
+	})
+	require.Error(t, err)
+	require.NotErrorIs(t, err, r8e.ErrRetriesExhausted)
+	assert.Equal(t, 1, calls, "the disabled retry makes a single attempt")
+	assert.True(t, p.PatternEnabled("timeout"), "other patterns are untouched")
+	assert.Equal(t, []string{"timeout", "retry"}, p.Patterns(),
+		"a disabled pattern is still part of the composition")
+}
+
+func TestSetPatternEnabledUnknownPattern(t *testing.T) {
+	t.Parallel()
+
+	p := r8e.NewPolicy[string]("", r8e.WithTimeout(time.Second))
+
+	assert.False(t, p.SetPatternEnabled("rate_limiter", false))
+	assert.False(t, p.PatternEnabled("rate_limiter"))
+	assert.True(t, p.PatternEnabled("timeout"))
+}
@@ -44,7 +44,7 @@ type (
 		// [AdaptiveHedge]). Requires Hedge, which becomes the ceiling and warmup
 		// fallback. Optional.
 		AdaptiveHedge *AdaptiveHedgeConfig `json:"adaptive_hedge,omitempty" yaml:"adaptive_hedge,omitempty"`
-		// RateLimit is the maximum requests per second.
+		// RateLimit is the maximum requests per second; 0 disables limiting.
 		// Optional. Example: 100.
 		RateLimit *float64 `json:"rate_limit,omitempty" yaml:"rate_limit,omitempty"`
 		// AIMD configures additive-increase / multiplicative-decrease adaptation
@@ -122,6 +122,18 @@ type (
 		// SlowCallMinCalls is the minimum observed calls before the slow-call
 		// rate is evaluated. Optional. Default 10. Example: 20.
 		SlowCallMinCalls *int `json:"slow_call_min_calls,omitempty" yaml:"slow_call_min_calls,omitempty"`
+		// ErrorThresholdPercentage enables error-rate tripping: the breaker opens
+		// when this percentage (1..100) of the calls in the error-rate window
+		// failed. Optional, off by default. Example: 50.
+		ErrorThresholdPercentage *int `json:"error_threshold_percentage,omitempty" yaml:"error_threshold_percentage,omitempty"` //nolint:lll // struct tag cannot be split across lines
+		// RequestVolumeThreshold is the minimum calls in the error-rate window
+		// before the error percentage is evaluated. Optional. Default 20. Only
+		// meaningful when ErrorThresholdPercentage is set. Example: 50.
+		RequestVolumeThreshold *int `json:"request_volume_threshold,omitempty" yaml:"request_volume_threshold,omitempty"`
+		// ErrorRateWindow is the span of the rolling error-rate window. Optional.
+		// Default "10s". Only meaningful when ErrorThresholdPercentage is set.
+		// Parsed via time.ParseDuration. Example: "30s".
+		ErrorRateWindow *string `json:"error_rate_window,omitempty" yaml:"error_rate_window,omitempty"`
 		// RecoveryBackoffMultiplier enables exponential backoff on the recovery
 		// timeout after consecutive failed half-open probes (opt-in, default 0 =
 		// disabled). A factor > 1 is the typical use case. Example: 2.0.
@@ -143,6 +155,9 @@ type (
 		// ramp, in [0,1]. Optional. Default 0.1. Only meaningful when RampRecovery
 		// is set. Example: 0.05.
 		RampInitialFraction *float64 `json:"ramp_initial_fraction,omitempty" yaml:"ramp_initial_fraction,omitempty"` //nolint:lll // struct tag cannot be split across lines
+		// CountAttempts makes the breaker admit and record every retry attempt
+		// instead of every call. Optional. Default false. Example: true.
+		CountAttempts *bool `json:"count_attempts,omitempty" yaml:"count_attempts,omitempty"`
 	}
 
 	// RetryConfig holds retry configuration values. Embed it
@@ -151,7 +166,8 @@ type (
 	RetryConfig struct {
 		// Backoff is the backoff strategy name.
 		// Required. One of: "constant", "exponential",
-		// "linear", "exponential_jitter".
+		// "linear", "exponential_jitter", "full_jitter",
+		// "equal_jitter", or a name added with [RegisterBackoff].
 		Backoff *string `json:"backoff,omitempty" yaml:"backoff,omitempty"`
 		// BaseDelay is the base delay for backoff calculation.
 		// Required. Parsed via time.ParseDuration. Example: "100ms".
@@ -159,6 +175,17 @@ type (
 		// MaxDelay caps the backoff delay.
 		// Optional. Parsed via time.ParseDuration. Example: "30s".
 		MaxDelay *string `json:"max_delay,omitempty" yaml:"max_delay,omitempty"`
+		// MaxElapsedTime caps the total time spent retrying.
+		// Optional. Parsed via time.ParseDuration. Example: "5s".
+		MaxElapsedTime *string `json:"max_elapsed_time,omitempty" yaml:"max_elapsed_time,omitempty"`
+		// MinTimePerAttempt is the least time before the context deadline an
+		// attempt needs to be worth starting.
+		// Optional. Parsed via time.ParseDuration. Example: "50ms".
+		MinTimePerAttempt *string `json:"min_time_per_attempt,omitempty" yaml:"min_time_per_attempt,omitempty"`
+		// InitialJitter is the upper bound of the random wait before the first
+		// attempt.
+		// Optional. Parsed via time.ParseDuration. Example: "2s".
+		InitialJitter *string `json:"initial_jitter,omitempty" yaml:"initial_jitter,omitempty"`
 		// MaxAttempts is the maximum number of retry attempts.
 		// Required. Example: 3.
 		MaxAttempts *int `json:"max_attempts,omitempty" yaml:"max_attempts,omitempty"`
@@ -319,6 +346,10 @@ type (
 // [PolicyConfig] in your own config struct and want to build a
 // policy without going through [LoadConfig].
 func BuildOptions(pc *PolicyConfig) ([]Option, error) {
+	if err := ValidateConfig(pc); err != nil {
+		return nil, err
+	}
+
 	var opts []Option
 
 	if pc.Timeout != nil {
@@ -957,6 +988,13 @@ func cbOptionsFromConfig(cfg *CircuitBreakerConfig) ([]CircuitBreakerOption, err
 
 	opts = append(opts, slowOpts...)
 
+	errRateOpts, err := errorRateOptionsFromConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	opts = append(opts, errRateOpts...)
+
 	if cfg.RecoveryBackoffMultiplier != nil {
 		opts = append(opts, RecoveryBackoffMultiplier(*cfg.RecoveryBackoffMultiplier))
 	}
@@ -977,6 +1015,36 @@ func cbOptionsFromConfig(cfg *CircuitBreakerConfig) ([]CircuitBreakerOption, err
 
 	opts = append(opts, rampOpts...)
 
+	if cfg.CountAttempts != nil && *cfg.CountAttempts {
+		opts = append(opts, CountAttempts())
+	}
+
+	return opts, nil
+}
+
+// errorRateOptionsFromConfig maps the error-rate fields of a
+// [CircuitBreakerConfig] to circuit-breaker options. ErrorThresholdPercentage
+// enables the trip; the volume and window tuners are inert without it.
+func errorRateOptionsFromConfig(cfg *CircuitBreakerConfig) ([]CircuitBreakerOption, error) {
+	var opts []CircuitBreakerOption
+
+	if cfg.ErrorThresholdPercentage != nil {
+		opts = append(opts, ErrorThresholdPercentage(*cfg.ErrorThresholdPercentage))
+	}
+
+	if cfg.RequestVolumeThreshold != nil {
+		opts = append(opts, RequestVolumeThreshold(*cfg.RequestVolumeThreshold))
+	}
+
+	if cfg.ErrorRateWindow != nil {
+		window, err := time.ParseDuration(*cfg.ErrorRateWindow)
+		if err != nil {
+			return nil, fmt.Errorf("circuit_breaker.error_rate_window: %w", err)
+		}
+
+		opts = append(opts, ErrorRateWindow(window))
+	}
+
 	return opts, nil
 }
 
@@ -1041,7 +1109,18 @@ func slowCallOptionsFromConfig(cfg *CircuitBreakerConfig) ([]CircuitBreakerOptio
 // retryRuntimeFromConfig converts a [RetryConfig] into the runtime retry
 // configuration. Shared by [BuildOptions] and [Policy.Reconfigure].
 func retryRuntimeFromConfig(cfg *RetryConfig) (*retryRuntime, error) {
-	strategy, err := parseBackoffStrategy(cfg.Backoff, cfg.BaseDelay)
+	var maxDelay time.Duration
+
+	if cfg.MaxDelay != nil {
+		parsed, parseErr := time.ParseDuration(*cfg.MaxDelay)
+		if parseErr != nil {
+			return nil, fmt.Errorf("retry.max_delay: %w", parseErr)
+		}
+
+		maxDelay = parsed
+	}
+
+	strategy, err := parseBackoffStrategy(cfg.Backoff, cfg.BaseDelay, maxDelay)
 	if err != nil {
 		return nil, fmt.Errorf("retry: %w", err)
 	}
@@ -1049,12 +1128,34 @@ func retryRuntimeFromConfig(cfg *RetryConfig) (*retryRuntime, error) {
 	var opts []RetryOption
 
 	if cfg.MaxDelay != nil {
-		maxDelay, parseErr := time.ParseDuration(*cfg.MaxDelay)
+		opts = append(opts, MaxDelay(maxDelay))
+	}
+
+	if cfg.MaxElapsedTime != nil {
+		maxElapsed, parseErr := time.ParseDuration(*cfg.MaxElapsedTime)
 		if parseErr != nil {
-			return nil, fmt.Errorf("retry.max_delay: %w", parseErr)
+			return nil, fmt.Errorf("retry.max_elapsed_time: %w", parseErr)
 		}
 
-		opts = append(opts, MaxDelay(maxDelay))
+		opts = append(opts, MaxElapsedTime(maxElapsed))
+	}
+
+	if cfg.MinTimePerAttempt != nil {
+		minPerAttempt, parseErr := time.ParseDuration(*cfg.MinTimePerAttempt)
+		if parseErr != nil {
+			return nil, fmt.Errorf("retry.min_time_per_attempt: %w", parseErr)
+		}
+
+		opts = append(opts, MinTimePerAttempt(minPerAttempt))
+	}
+
+	if cfg.InitialJitter != nil {
+		initialJitter, parseErr := time.ParseDuration(*cfg.InitialJitter)
+		if parseErr != nil {
+			return nil, fmt.Errorf("retry.initial_jitter: %w", parseErr)
+		}
+
+		opts = append(opts, InitialJitter(initialJitter))
 	}
 
 	// max_attempts is required: a nil value would silently collapse the retry to
@@ -1071,8 +1172,9 @@ func retryRuntimeFromConfig(cfg *RetryConfig) (*retryRuntime, error) {
 }
 
 // parseBackoffStrategy maps a backoff name + base delay to a
-// BackoffStrategy. Both fields are required pointers; nil values
-// produce an error.
+// BackoffStrategy, resolving the name through the backoff registry (see
+// [RegisterBackoff]); maxDelay is handed to the factory. Both fields are
+// required pointers; nil values produce an error.
 //
 // Pattern: Factory — selects and constructs the concrete BackoffStrategy
 // implementation from a configuration name, hiding the concrete type behind
@@ -1081,6 +1183,7 @@ func retryRuntimeFromConfig(cfg *RetryConfig) (*retryRuntime, error) {
 //nolint:ireturn // returns interface by design for strategy pattern
 func parseBackoffStrategy(
 	name, baseDelayStr *string,
+	maxDelay time.Duration,
 ) (BackoffStrategy, error) {
 	const errCtx = "parsing backoff strategy"
 
@@ -1100,19 +1203,18 @@ func parseBackoffStrategy(
 		return nil, fmt.Errorf("base_delay: %w", err)
 	}
 
-	switch *name {
-	case "constant":
-		return ConstantBackoff(base), nil
-	case "exponential":
-		return ExponentialBackoff(base), nil
-	case "linear":
-		return LinearBackoff(base), nil
-	case "exponential_jitter":
-		return ExponentialJitterBackoff(base), nil
-	default:
+	factory, ok := backoffs.lookup(*name)
+	if !ok {
 		return nil, fmt.Errorf(
 			"unknown backoff strategy: %q",
 			*name,
 		)
 	}
+
+	strategy := factory(base, maxDelay)
+	if strategy == nil {
+		return nil, fmt.Errorf("backoff strategy %q: factory returned nil", *name)
+	}
+
+	return strategy, nil
 }
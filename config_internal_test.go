@@ -30,7 +30,7 @@ func TestParseBackoffStrategyGuards(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			_, err := parseBackoffStrategy(tt.backoff, tt.baseDelay)
+			_, err := parseBackoffStrategy(tt.backoff, tt.baseDelay, 0)
 			require.Error(t, err)
 			require.ErrorContains(t, err, tt.wantErr)
 		})
@@ -40,11 +40,11 @@ func TestParseBackoffStrategyGuards(t *testing.T) {
 func TestParseBackoffStrategyValid(t *testing.T) {
 	t.Parallel()
 
-	for _, name := range []string{"constant", "exponential", "linear", "exponential_jitter"} {
+	for _, name := range []string{"constant", "exponential", "linear", "exponential_jitter", "full_jitter", "equal_jitter"} {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			strategy, err := parseBackoffStrategy(strPtr(name), strPtr("100ms"))
+			strategy, err := parseBackoffStrategy(strPtr(name), strPtr("100ms"), 0)
 			require.NoError(t, err)
 			require.NotNil(t, strategy)
 		})
@@ -0,0 +1,164 @@
+package r8e
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ---------------------------------------------------------------------------
+// Config validation — semantic range checks on a decoded PolicyConfig
+// ---------------------------------------------------------------------------.
+
+type (
+	// ConfigFieldError reports one semantically invalid value in a
+	// [PolicyConfig]: a value that decodes and parses but that no policy can
+	// honor, such as a retry with no attempts or a negative rate. Path is the
+	// field's dotted JSON path ("retry.max_attempts"); the r8econf loader
+	// prefixes it with the policy ("policies.payment-api.retry.max_attempts").
+	// It matches [ErrInvalidConfig] under errors.Is.
+	ConfigFieldError struct {
+		// Path is the dotted JSON path of the offending field.
+		Path string
+		// Problem states the rule it breaks, e.g. "must be > 0".
+		Problem string
+	}
+
+	// configChecker collects every [ConfigFieldError] of one validation pass,
+	// so a config reports all of its problems at once rather than the first.
+	configChecker struct {
+		errs []error
+	}
+)
+
+// Error returns "<path> <problem>".
+func (e *ConfigFieldError) Error() string {
+	return e.Path + " " + e.Problem
+}
+
+// Is reports whether target is [ErrInvalidConfig].
+func (*ConfigFieldError) Is(target error) bool {
+	return target == ErrInvalidConfig
+}
+
+// ValidateConfig checks the values of pc that parse but make no sense — a
+// retry with max_attempts 0, a negative rate_limit, a circuit breaker with a
+// failure_threshold below 1, a zero timeout, a ratio outside its range — and
+// returns every problem found, joined with errors.Join, each a
+// *[ConfigFieldError] naming the field. It returns nil for a valid config.
+// Absent fields are not checked, and values that fail to parse are left to
+// [BuildOptions], which reports them. [BuildOptions] and [Policy.Reconfigure]
+// both run it first, so a config is rejected the same way cold and hot.
+func ValidateConfig(pc *PolicyConfig) error {
+	var c configChecker
+
+	c.positiveDuration("timeout", pc.Timeout)
+	c.positiveDuration("time_budget", pc.TimeBudget)
+	c.positiveDuration("hedge", pc.Hedge)
+
+	// A zero rate_limit is valid: it disables the limiter (see WithRateLimit).
+	if pc.RateLimit != nil && *pc.RateLimit < 0 {
+		c.fail("rate_limit", "must be >= 0")
+	}
+
+	c.atLeast("bulkhead", pc.Bulkhead, 1)
+	c.atLeast("bulkhead_queue_depth", pc.BulkheadQueueDepth, 1)
+	c.positiveDuration("bulkhead_max_wait", pc.BulkheadMaxWait)
+
+	if cb := pc.CircuitBreaker; cb != nil {
+		c.atLeast("circuit_breaker.failure_threshold", cb.FailureThreshold, 1)
+		c.atLeast("circuit_breaker.half_open_max_attempts", cb.HalfOpenMaxAttempts, 1)
+		c.positiveDuration("circuit_breaker.recovery_timeout", cb.RecoveryTimeout)
+		c.unitInterval("circuit_breaker.slow_call_rate_threshold", cb.SlowCallRateThreshold)
+
+		if p := cb.ErrorThresholdPercentage; p != nil && (*p < 1 || *p > 100) {
+			c.fail("circuit_breaker.error_threshold_percentage", "must be in [1, 100]")
+		}
+
+		c.atLeast("circuit_breaker.request_volume_threshold", cb.RequestVolumeThreshold, 1)
+		c.positiveDuration("circuit_breaker.error_rate_window", cb.ErrorRateWindow)
+		c.unitInterval("circuit_breaker.ramp_initial_fraction", cb.RampInitialFraction)
+	}
+
+	if r := pc.Retry; r != nil {
+		c.atLeast("retry.max_attempts", r.MaxAttempts, 1)
+		c.nonNegativeDuration("retry.base_delay", r.BaseDelay)
+		c.positiveDuration("retry.max_delay", r.MaxDelay)
+		c.positiveDuration("retry.max_elapsed_time", r.MaxElapsedTime)
+		c.nonNegativeDuration("retry.min_time_per_attempt", r.MinTimePerAttempt)
+		c.nonNegativeDuration("retry.initial_jitter", r.InitialJitter)
+	}
+
+	if ac := pc.AdaptiveConcurrency; ac != nil {
+		c.atLeast("adaptive_concurrency.initial_limit", ac.InitialLimit, 1)
+		c.atLeast("adaptive_concurrency.min_limit", ac.MinLimit, 1)
+		c.atLeast("adaptive_concurrency.max_limit", ac.MaxLimit, 1)
+
+		if ac.MinLimit != nil && ac.MaxLimit != nil && *ac.MinLimit > *ac.MaxLimit {
+			c.fail("adaptive_concurrency.min_limit", "must not exceed max_limit")
+		}
+	}
+
+	if rb := pc.RetryBudget; rb != nil {
+		c.atLeast("retry_budget.max_tokens", rb.MaxTokens, 1)
+
+		if rb.TokenRatio != nil && *rb.TokenRatio < 0 {
+			c.fail("retry_budget.token_ratio", "must be >= 0")
+		}
+	}
+
+	if cb := pc.ConcurrencyBudget; cb != nil {
+		if cb.MaxRatio != nil && (*cb.MaxRatio <= 0 || *cb.MaxRatio > 1) {
+			c.fail("concurrency_budget.max_ratio", "must be in (0, 1]")
+		}
+
+		c.atLeast("concurrency_budget.min_concurrency", cb.MinConcurrency, 0)
+	}
+
+	return errors.Join(c.errs...)
+}
+
+// fail records that the field at path breaks the rule stated by problem.
+func (c *configChecker) fail(path, problem string) {
+	c.errs = append(c.errs, &ConfigFieldError{Path: path, Problem: problem})
+}
+
+// atLeast checks that a set integer field is at least lowest.
+func (c *configChecker) atLeast(path string, value *int, lowest int) {
+	if value != nil && *value < lowest {
+		c.fail(path, fmt.Sprintf("must be >= %d", lowest))
+	}
+}
+
+// unitInterval checks that a set fraction lies in [0, 1].
+func (c *configChecker) unitInterval(path string, value *float64) {
+	if value != nil && (*value < 0 || *value > 1) {
+		c.fail(path, "must be in [0, 1]")
+	}
+}
+
+// positiveDuration checks that a set duration that parses is above zero.
+func (c *configChecker) positiveDuration(path string, value *string) {
+	if d, ok := parsedDuration(value); ok && d <= 0 {
+		c.fail(path, "must be > 0")
+	}
+}
+
+// nonNegativeDuration checks that a set duration that parses is not negative.
+func (c *configChecker) nonNegativeDuration(path string, value *string) {
+	if d, ok := parsedDuration(value); ok && d < 0 {
+		c.fail(path, "must be >= 0")
+	}
+}
+
+// parsedDuration parses a set duration field; ok is false when it is unset or
+// malformed (a parse error is BuildOptions' to report).
+func parsedDuration(value *string) (time.Duration, bool) {
+	if value == nil {
+		return 0, false
+	}
+
+	d, err := time.ParseDuration(*value)
+
+	return d, err == nil
+}
@@ -0,0 +1,81 @@
+package r8e
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateConfigCollectsEveryProblem(t *testing.T) {
+	t.Parallel()
+
+	pc := PolicyConfig{
+		Timeout:   strPtr("-1s"),
+		RateLimit: f64Ptr(-1),
+		Retry: &RetryConfig{
+			MaxAttempts: intPtr(0),
+			Backoff:     strPtr("constant"),
+			BaseDelay:   strPtr("10ms"),
+		},
+		CircuitBreaker: &CircuitBreakerConfig{FailureThreshold: intPtr(-1)},
+	}
+
+	err := ValidateConfig(&pc)
+	require.ErrorIs(t, err, ErrInvalidConfig)
+
+	var paths []string
+
+	for _, e := range err.(interface{ Unwrap() []error }).Unwrap() {
+		var fieldErr *ConfigFieldError
+		require.ErrorAs(t, e, &fieldErr)
+
+		paths = append(paths, fieldErr.Path)
+	}
+
+	assert.Equal(t, []string{
+		"timeout",
+		"rate_limit",
+		"circuit_breaker.failure_threshold",
+		"retry.max_attempts",
+	}, paths)
+}
+
+func TestValidateConfigAcceptsValidAndUnparsedValues(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, ValidateConfig(&PolicyConfig{}))
+	require.NoError(t, ValidateConfig(&PolicyConfig{
+		Timeout:   strPtr("2s"),
+		RateLimit: f64Ptr(100),
+		Bulkhead:  intPtr(10),
+		Retry: &RetryConfig{
+			MaxAttempts: intPtr(3),
+			Backoff:     strPtr("constant"),
+			BaseDelay:   strPtr("0s"),
+		},
+	}))
+
+	// A zero rate_limit disables the limiter rather than rejecting every call.
+	require.NoError(t, ValidateConfig(&PolicyConfig{RateLimit: f64Ptr(0)}))
+
+	// A malformed duration is BuildOptions' to report, with its parse error.
+	require.NoError(t, ValidateConfig(&PolicyConfig{Timeout: strPtr("soon")}))
+}
+
+func TestBuildOptionsAndReconfigureRejectOutOfRange(t *testing.T) {
+	t.Parallel()
+
+	bad := PolicyConfig{RateLimit: f64Ptr(-3)}
+
+	_, err := BuildOptions(&bad)
+	require.ErrorIs(t, err, ErrInvalidConfig)
+	assert.EqualError(t, err, "rate_limit must be >= 0")
+
+	p := NewPolicy[string]("", WithRateLimit(10))
+	require.ErrorIs(t, p.Reconfigure(bad), ErrInvalidConfig)
+	assert.InDelta(t, 10.0, p.rateLimiter.CurrentRate(), 1e-9, "left unchanged")
+
+	require.False(t, errors.Is(&ConfigFieldError{}, ErrRateLimited))
+}
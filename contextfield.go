@@ -0,0 +1,74 @@
+package r8e
+
+import (
+	"context"
+	"fmt"
+)
+
+// ---------------------------------------------------------------------------
+// Context fields — correlation IDs carried on policy errors
+// ---------------------------------------------------------------------------.
+
+type (
+	// PolicyError wraps an error returned by [Policy.Do] on a policy built with
+	// [WithContextField], tying it back to the request it failed: the policy
+	// name and the correlation ID read from the call's context. It unwraps to
+	// the original error, so errors.Is against a sentinel ([ErrRetriesExhausted],
+	// [ErrCircuitOpen], ...) and errors.As against a detail type keep working.
+	PolicyError struct {
+		// Err is the error the policy returned.
+		Err error
+		// Policy is the policy's name.
+		Policy string
+		// Field is the name the correlation ID is reported under, as given to
+		// WithContextField (e.g. "request_id").
+		Field string
+		// CorrelationID is the context value formatted with %v; empty when the
+		// call's context carried none.
+		CorrelationID string
+	}
+
+	// contextFieldDesc holds the WithContextField configuration.
+	contextFieldDesc struct {
+		key  any
+		name string
+	}
+)
+
+// Error returns the wrapped error prefixed with the policy and, when present,
+// the correlation ID: `policy "payment-api" [request_id=abc-123]: ...`.
+func (e *PolicyError) Error() string {
+	if e.CorrelationID == "" {
+		return fmt.Sprintf("policy %q: %v", e.Policy, e.Err)
+	}
+
+	return fmt.Sprintf("policy %q [%s=%s]: %v", e.Policy, e.Field, e.CorrelationID, e.Err)
+}
+
+// Unwrap returns the error the policy returned.
+func (e *PolicyError) Unwrap() error { return e.Err }
+
+// WithContextField makes every error returned by [Policy.Do] a *[PolicyError]
+// carrying the value stored in the call's context under key — a request or
+// trace ID — reported as fieldName, so an error that bubbles up can be tied
+// back to its request with errors.As. A call whose context has no such value
+// still gets a PolicyError, with an empty CorrelationID. Successful calls and
+// their results are untouched. A nil key is ignored.
+func WithContextField(key any, fieldName string) Option {
+	return optionFunc(func(s *policySetup) {
+		if key != nil {
+			s.contextField = &contextFieldDesc{key: key, name: fieldName}
+		}
+	})
+}
+
+// wrap returns err as a *PolicyError for policy, reading the correlation ID
+// from ctx.
+func (d *contextFieldDesc) wrap(ctx context.Context, policy string, err error) error {
+	pe := &PolicyError{Err: err, Policy: policy, Field: d.name}
+	if v := ctx.Value(d.key); v != nil {
+		pe.CorrelationID = fmt.Sprint(v)
+	}
+
+	return pe
+}
@@ -0,0 +1,89 @@
+package r8e_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/byte4ever/r8e"
+	"github.com/byte4ever/r8e/internal/clocktest"
+)
+
+type requestIDKey struct{}
+
+func TestWithContextFieldCarriesCorrelationID(t *testing.T) {
+	t.Parallel()
+
+	downstream := errors.New("inventory down")
+	p := r8e.NewPolicy[string]("inventory-api",
+		r8e.WithRegistry(r8e.NewRegistry()),
+		r8e.WithClock(clocktest.New()),
+		r8e.WithRetry(2, r8e.ConstantBackoff(time.Millisecond)),
+		r8e.WithContextField(requestIDKey{}, "request_id"),
+	)
+
+	ctx := context.WithValue(context.Background(), requestIDKey{}, "req-42")
+
+	_, err := p.Do(ctx, func(context.Context) (string, error) {
+		return "", downstream
+	})
+
+	var policyErr *r8e.PolicyError
+	require.ErrorAs(t, err, &policyErr)
+	assert.Equal(t, "inventory-api", policyErr.Policy)
+	assert.Equal(t, "request_id", policyErr.Field)
+	assert.Equal(t, "req-42", policyErr.CorrelationID)
+
+	require.ErrorIs(t, err, r8e.ErrRetriesExhausted, "the sentinel still matches")
+	require.ErrorIs(t, err, downstream)
+
+	var retryErr *r8e.RetryError
+	require.ErrorAs(t, err, &retryErr, "detail types are still reachable")
+	assert.Equal(t, 2, retryErr.Attempts)
+
+	assert.Contains(t, err.Error(), `policy "inventory-api" [request_id=req-42]: `)
+}
+
+func TestWithContextFieldWithoutValue(t *testing.T) {
+	t.Parallel()
+
+	p := r8e.NewPolicy[string]("",
+		r8e.WithCircuitBreaker(r8e.FailureThreshold(1), r8e.RecoveryTimeout(time.Hour)),
+		r8e.WithContextField(requestIDKey{}, "request_id"),
+	)
+
+	_, _ = p.Do(context.Background(), func(context.Context) (string, error) {
+		return "", errors.New("boom")
+	})
+
+	_, err := p.Do(context.Background(), func(context.Context) (string, error) {
+		return "ok", nil
+	})
+
+	var policyErr *r8e.PolicyError
+	require.ErrorAs(t, err, &policyErr)
+	assert.Empty(t, policyErr.CorrelationID)
+	require.ErrorIs(t, err, r8e.ErrCircuitOpen)
+	assert.Equal(t, `policy "": circuit breaker is open`, err.Error())
+}
+
+func TestWithContextFieldLeavesSuccessUntouched(t *testing.T) {
+	t.Parallel()
+
+	p := r8e.NewPolicy[string]("",
+		r8e.WithTimeout(time.Second),
+		r8e.WithContextField(requestIDKey{}, "request_id"),
+	)
+
+	ctx := context.WithValue(context.Background(), requestIDKey{}, 7)
+
+	got, err := p.Do(ctx, func(context.Context) (string, error) {
+		return "ok", nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "ok", got)
+}
@@ -0,0 +1,175 @@
+package r8e
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+)
+
+// ---------------------------------------------------------------------------
+// Debouncer[T] — request coalescing with a result-sharing window
+// ---------------------------------------------------------------------------.
+
+type (
+	// Debouncer collapses calls that share a key into one execution, like a
+	// [Coalescer], and additionally keeps a successful result for a short
+	// window after it completes: a call arriving while the execution is in
+	// flight, or within the window after it succeeded, receives that result
+	// instead of starting a new one. Noisy callers that trigger the same
+	// refresh many times in quick succession thus cost one execution per
+	// window rather than one per burst of overlapping calls.
+	//
+	// Only successes are kept: a failed execution releases its key on
+	// completion, so the next call retries at once rather than being served the
+	// failure for the rest of the window. The window is measured against the
+	// injected [Clock]; expired results are dropped lazily whenever a new
+	// execution starts, oldest first, so the cost is proportional to what
+	// expired rather than to the number of keys.
+	//
+	// The shared work runs under a context detached from its callers, exactly
+	// as with a Coalescer, so the same rules apply: each caller stops waiting on
+	// its own context, and the shared call must be bounded some other way —
+	// inside a Policy, WithDebounce requires a WithTimeout.
+	//
+	// Construct one with NewDebouncer; it is safe for concurrent use.
+	//
+	// Pattern: Debounce — a per-key registry of in-flight and recently
+	// completed executions serves every call within the window from one
+	// execution.
+	Debouncer[T any] struct {
+		clock     Clock
+		hooks     *Hooks
+		entries   map[string]*debounceEntry[T]
+		kept      *list.List // of *debounceEntry[T] kept for the window, oldest first
+		window    time.Duration
+		executing int
+		mu        sync.Mutex
+	}
+
+	// debounceEntry is one execution registered under key. completed and
+	// finishedAt are guarded by the debouncer's mutex; the result lives in call.
+	debounceEntry[T any] struct {
+		call       *coalesceCall[T]
+		finishedAt time.Time
+		key        string
+		completed  bool
+	}
+)
+
+// NewDebouncer creates a debouncer that shares each successful result for
+// window after it completes; a non-positive window shares only in-flight
+// executions, like a [Coalescer]. The clock measures the window. The hooks
+// receive OnCoalesceLeader when a call starts an execution and
+// OnCoalesceFollower when a call is served an in-flight or recent one; pass a
+// non-nil *Hooks (the zero value [Hooks] is fine).
+func NewDebouncer[T any](clock Clock, hooks *Hooks, window time.Duration) *Debouncer[T] {
+	return &Debouncer[T]{
+		clock:   clock,
+		hooks:   hooks,
+		entries: make(map[string]*debounceEntry[T]),
+		kept:    list.New(),
+		window:  max(window, 0),
+	}
+}
+
+// Do executes next once per key for every call arriving while an execution is
+// in flight or within the window after it succeeded, returning the shared
+// result to each caller. As with [Coalescer.Do], the key is opaque, next runs
+// under a context detached from ctx, and ctx only gates this caller's wait.
+//
+//nolint:ireturn // generic type parameter T, not an interface
+func (d *Debouncer[T]) Do(
+	ctx context.Context,
+	key string,
+	next func(context.Context) (T, error),
+) (T, error) {
+	d.mu.Lock()
+
+	if entry, ok := d.entries[key]; ok && d.liveLocked(entry) {
+		d.mu.Unlock()
+		d.hooks.emitCoalesceFollower()
+
+		return entry.call.await(ctx)
+	}
+
+	d.pruneLocked()
+
+	entry := &debounceEntry[T]{call: &coalesceCall[T]{done: make(chan struct{})}, key: key}
+	d.entries[key] = entry
+	d.executing++
+	d.mu.Unlock()
+	d.hooks.emitCoalesceLeader()
+
+	go d.execute(ctx, key, entry, next)
+
+	return entry.call.await(ctx)
+}
+
+// execute runs the shared work for key under a detached context and broadcasts
+// the result to all waiters. A success stays registered for the window; a
+// failure releases the key before completion is signalled, so a later call
+// starts a fresh execution. As in [Coalescer], a panic in next is not recovered.
+func (d *Debouncer[T]) execute(
+	parent context.Context,
+	key string,
+	entry *debounceEntry[T],
+	next func(context.Context) (T, error),
+) {
+	defer func() {
+		d.mu.Lock()
+
+		d.executing--
+
+		if entry.call.err != nil || d.window == 0 {
+			if d.entries[key] == entry {
+				delete(d.entries, key)
+			}
+		} else {
+			entry.completed = true
+			entry.finishedAt = d.clock.Now()
+			d.kept.PushBack(entry)
+		}
+
+		d.mu.Unlock()
+
+		close(entry.call.done)
+	}()
+
+	entry.call.val, entry.call.err = next(context.WithoutCancel(parent))
+}
+
+// liveLocked reports whether entry can still serve a call: it is in flight, or
+// it completed less than the window ago. Caller must hold d.mu.
+func (d *Debouncer[T]) liveLocked(entry *debounceEntry[T]) bool {
+	return !entry.completed || d.clock.Since(entry.finishedAt) < d.window
+}
+
+// pruneLocked drops the completed entries whose window has passed. They are
+// kept in completion order, so the sweep stops at the first one still live;
+// an entry already replaced under its key is only unlinked. Caller must hold
+// d.mu.
+func (d *Debouncer[T]) pruneLocked() {
+	for oldest := d.kept.Front(); oldest != nil; oldest = d.kept.Front() {
+		entry := oldest.Value.(*debounceEntry[T]) //nolint:forcetypeassert // list holds only *debounceEntry
+		if d.liveLocked(entry) {
+			return
+		}
+
+		d.kept.Remove(oldest)
+
+		if d.entries[entry.key] == entry {
+			delete(d.entries, entry.key)
+		}
+	}
+}
+
+// InFlight returns the number of distinct keys currently executing as a
+// point-in-time snapshot; results kept for the window are not counted.
+// Surfaced by Policy.Metrics in the CoalesceInFlight gauge.
+func (d *Debouncer[T]) InFlight() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.executing
+}
@@ -0,0 +1,179 @@
+package r8e
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDebouncerSharesRecentResult(t *testing.T) {
+	t.Parallel()
+
+	var leaders, followers atomic.Int64
+
+	clk := newPolicyClock()
+	d := NewDebouncer[string](clk, &Hooks{
+		OnCoalesceLeader:   func() { leaders.Add(1) },
+		OnCoalesceFollower: func() { followers.Add(1) },
+	}, time.Second)
+
+	var calls atomic.Int64
+
+	fn := func(context.Context) (string, error) {
+		return "v" + string(rune('0'+calls.Add(1))), nil
+	}
+
+	for i := range 5 {
+		got, err := d.Do(t.Context(), "k", fn)
+		require.NoError(t, err)
+		assert.Equal(t, "v1", got, "call %d", i)
+
+		clk.advance(100 * time.Millisecond)
+	}
+
+	assert.Equal(t, int64(1), calls.Load(), "one execution")
+	assert.Equal(t, int64(1), leaders.Load())
+	assert.Equal(t, int64(4), followers.Load(), "four shared results")
+
+	clk.advance(time.Second)
+
+	got, err := d.Do(t.Context(), "k", fn)
+	require.NoError(t, err)
+	assert.Equal(t, "v2", got, "the window has passed")
+}
+
+func TestDebouncerPrunesOnlyExpiredResults(t *testing.T) {
+	t.Parallel()
+
+	clk := newPolicyClock()
+	d := NewDebouncer[string](clk, &Hooks{}, time.Second)
+
+	ok := func(context.Context) (string, error) { return "v", nil }
+
+	for _, key := range []string{"a", "b", "c"} {
+		_, err := d.Do(t.Context(), key, ok)
+		require.NoError(t, err)
+	}
+
+	clk.advance(time.Second)
+
+	_, err := d.Do(t.Context(), "d", ok)
+	require.NoError(t, err)
+
+	// The three expired results are dropped; the sweep stops at "d".
+	d.mu.Lock()
+	assert.Len(t, d.entries, 1)
+	assert.Equal(t, 1, d.kept.Len())
+	d.mu.Unlock()
+
+	// A new execution of "a" leaves "d", still within its window, in place.
+	clk.advance(500 * time.Millisecond)
+
+	_, err = d.Do(t.Context(), "a", ok)
+	require.NoError(t, err)
+
+	d.mu.Lock()
+	assert.Len(t, d.entries, 2, "d is still within its window")
+	d.mu.Unlock()
+	assert.Zero(t, d.InFlight())
+}
+
+func TestDebouncerDoesNotKeepFailures(t *testing.T) {
+	t.Parallel()
+
+	d := NewDebouncer[string](newPolicyClock(), &Hooks{}, time.Minute)
+	boom := errors.New("boom")
+
+	var calls atomic.Int64
+
+	fn := func(context.Context) (string, error) {
+		if calls.Add(1) == 1 {
+			return "", boom
+		}
+
+		return "ok", nil
+	}
+
+	_, err := d.Do(t.Context(), "k", fn)
+	require.ErrorIs(t, err, boom)
+
+	got, err := d.Do(t.Context(), "k", fn)
+	require.NoError(t, err)
+	assert.Equal(t, "ok", got)
+	assert.Equal(t, int64(2), calls.Load())
+	assert.Equal(t, 0, d.InFlight())
+}
+
+func TestDebouncerSharesInFlightExecution(t *testing.T) {
+	t.Parallel()
+
+	d := NewDebouncer[string](newPolicyClock(), &Hooks{}, time.Second)
+	g := newGate()
+
+	results := make(chan string, 5)
+
+	for range 5 {
+		go func() {
+			v, _ := d.Do(context.Background(), "k", g.fn("shared"))
+			results <- v
+		}()
+	}
+
+	<-g.started
+	require.Eventually(t, func() bool { return d.InFlight() == 1 },
+		waitTimeout, waitTick)
+	close(g.release)
+
+	for range 5 {
+		assert.Equal(t, "shared", <-results)
+	}
+
+	assert.Equal(t, int64(1), g.calls.Load())
+}
+
+func TestWithDebouncePolicy(t *testing.T) {
+	t.Parallel()
+
+	clk := newPolicyClock()
+	p := NewPolicy[string]("debounce",
+		WithClock(clk),
+		WithTimeout(time.Second),
+		WithDebounce(500*time.Millisecond, func(context.Context) string { return "refresh" }),
+	)
+
+	assert.Contains(t, p.Patterns(), "debounce")
+	assert.NotContains(t, p.Patterns(), "coalesce")
+
+	var calls atomic.Int64
+
+	for range 5 {
+		got, err := p.Do(t.Context(), func(context.Context) (string, error) {
+			calls.Add(1)
+
+			return "fresh", nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "fresh", got)
+	}
+
+	assert.Equal(t, int64(1), calls.Load())
+
+	m := p.Metrics()
+	assert.Equal(t, int64(1), m.CoalesceLeaders)
+	assert.Equal(t, int64(4), m.CoalesceFollowers)
+}
+
+func TestWithDebounceRequiresTimeout(t *testing.T) {
+	t.Parallel()
+
+	assert.PanicsWithValue(t, ErrCoalesceWithoutTimeout, func() {
+		_ = NewPolicy[string]("debounce",
+			WithDebounce(time.Second, func(context.Context) string { return "k" }),
+		)
+	})
+}
@@ -23,6 +23,7 @@ func TestCoreImportsNoTransport(t *testing.T) {
 	forbidden := []string{
 		"net/http",      // transport
 		"net",           // raw sockets / transport
+		"expvar",        // registers /debug/vars on http.DefaultServeMux
 		"encoding/json", // serialization
 		"os",            // file persistence
 		"database/sql",  // db persistence
@@ -0,0 +1,69 @@
+package r8e
+
+import (
+	"context"
+	"errors"
+)
+
+// ---------------------------------------------------------------------------
+// Error classifier — policy-wide transient/permanent classification
+// ---------------------------------------------------------------------------.
+
+// ErrorClass is the verdict of an error classifier given to
+// [WithErrorClassifier]: whether a failure is worth retrying.
+type ErrorClass int
+
+const (
+	// ErrorClassTransient marks the error as retriable. It is the zero value
+	// and what an unclassified error is treated as anyway.
+	ErrorClassTransient ErrorClass = iota
+	// ErrorClassPermanent marks the error as non-retriable, exactly as if fn
+	// had returned [Permanent](err).
+	ErrorClassPermanent
+)
+
+// WithErrorClassifier centralizes error classification for the policy: every
+// error fn returns that carries no [Transient] or [Permanent] marker of its own
+// is passed to classify, and one classified [ErrorClassPermanent] is marked
+// [Permanent] before any pattern sees it. Retry then stops after that attempt,
+// and every other pattern — the circuit breaker, hedge, fallback and
+// [Policy.Do]'s caller — sees the same [IsPermanent] verdict. An explicit
+// marker always wins, so the classifier only decides for errors the caller left
+// unclassified. Errors the patterns produce themselves ([ErrCircuitOpen],
+// [ErrTimeout], ...) are not classified. A nil classify is ignored.
+//
+//	r8e.WithErrorClassifier(func(err error) r8e.ErrorClass {
+//		if errors.Is(err, context.Canceled) {
+//			return r8e.ErrorClassPermanent
+//		}
+//
+//		return r8e.ErrorClassTransient
+//	})
+func WithErrorClassifier(classify func(error) ErrorClass) Option {
+	return optionFunc(func(s *policySetup) {
+		if classify != nil {
+			s.errorClassifier = classify
+		}
+	})
+}
+
+// classifyErrors wraps fn so that its unmarked errors are classified by
+// classify and marked [Permanent] when it says so.
+func classifyErrors[T any](
+	fn func(context.Context) (T, error),
+	classify func(error) ErrorClass,
+) func(context.Context) (T, error) {
+	return func(ctx context.Context) (T, error) {
+		result, err := fn(ctx)
+		if err == nil {
+			return result, nil
+		}
+
+		var ce classifiedError
+		if !errors.As(err, &ce) && classify(err) == ErrorClassPermanent {
+			return result, Permanent(err)
+		}
+
+		return result, err
+	}
+}
@@ -0,0 +1,112 @@
+package r8e_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/byte4ever/r8e"
+	"github.com/byte4ever/r8e/internal/clocktest"
+)
+
+var errInvalidOrder = errors.New("invalid order")
+
+// permanentInvalidOrder classifies errInvalidOrder as permanent and everything
+// else as transient.
+func permanentInvalidOrder(err error) r8e.ErrorClass {
+	if errors.Is(err, errInvalidOrder) {
+		return r8e.ErrorClassPermanent
+	}
+
+	return r8e.ErrorClassTransient
+}
+
+func TestWithErrorClassifierStopsRetryOnPermanent(t *testing.T) {
+	t.Parallel()
+
+	p := r8e.NewPolicy[string]("orders",
+		r8e.WithRegistry(r8e.NewRegistry()),
+		r8e.WithClock(clocktest.New()),
+		r8e.WithRetry(5, r8e.ConstantBackoff(time.Millisecond)),
+		r8e.WithErrorClassifier(permanentInvalidOrder),
+	)
+
+	var calls int
+
+	_, err := p.Do(t.Context(), func(context.Context) (string, error) {
+		calls++
+
+		return "", errInvalidOrder
+	})
+
+	require.ErrorIs(t, err, errInvalidOrder)
+	assert.True(t, r8e.IsPermanent(err))
+	assert.Equal(t, 1, calls, "a permanent error is not retried")
+
+	calls = 0
+
+	_, err = p.Do(t.Context(), func(context.Context) (string, error) {
+		calls++
+
+		return "", errors.New("connection reset")
+	})
+
+	require.ErrorIs(t, err, r8e.ErrRetriesExhausted)
+	assert.Equal(t, 5, calls, "a transient error is retried")
+}
+
+func TestWithErrorClassifierExplicitMarkerWins(t *testing.T) {
+	t.Parallel()
+
+	var classified int
+
+	p := r8e.NewPolicy[string]("orders",
+		r8e.WithRegistry(r8e.NewRegistry()),
+		r8e.WithClock(clocktest.New()),
+		r8e.WithRetry(3, r8e.ConstantBackoff(time.Millisecond)),
+		r8e.WithErrorClassifier(func(error) r8e.ErrorClass {
+			classified++
+
+			return r8e.ErrorClassPermanent
+		}),
+	)
+
+	var calls int
+
+	_, err := p.Do(t.Context(), func(context.Context) (string, error) {
+		calls++
+
+		return "", r8e.Transient(errInvalidOrder)
+	})
+
+	require.ErrorIs(t, err, r8e.ErrRetriesExhausted)
+	assert.Equal(t, 3, calls)
+	assert.Zero(t, classified, "marked errors are not passed to the classifier")
+
+	_, err = p.Do(t.Context(), func(context.Context) (string, error) {
+		return "ok", nil
+	})
+
+	require.NoError(t, err)
+	assert.Zero(t, classified, "successes are not classified")
+}
+
+func TestWithErrorClassifierNilIgnored(t *testing.T) {
+	t.Parallel()
+
+	p := r8e.NewPolicy[string]("orders",
+		r8e.WithRegistry(r8e.NewRegistry()),
+		r8e.WithErrorClassifier(nil),
+	)
+
+	_, err := p.Do(t.Context(), func(context.Context) (string, error) {
+		return "", errInvalidOrder
+	})
+
+	require.ErrorIs(t, err, errInvalidOrder)
+	assert.False(t, r8e.IsPermanent(err))
+}
@@ -17,6 +17,13 @@ type (
 		err error
 	}
 
+	// classifiedError is implemented by both classification markers, so a
+	// single errors.As walk finds the outermost classification in a chain.
+	classifiedError interface {
+		error
+		permanent() bool
+	}
+
 	// resilienceError is the concrete type backing all sentinel errors.
 	resilienceError string
 )
@@ -60,6 +67,10 @@ var (
 	// [WithSLO]). It is distinct from [ErrThrottled] so callers can tell a
 	// budget-protection shed apart from a backend-health shed.
 	ErrSLOShed error = resilienceError("shed to protect the SLO error budget")
+	// ErrLoadShed is returned when the load shedder rejects a call locally
+	// because the backend's recent latency or the calls in flight exceed their
+	// threshold (see [WithLoadShedder]).
+	ErrLoadShed error = resilienceError("load shed")
 	// ErrTimeout is returned when an operation exceeds its deadline.
 	ErrTimeout error = resilienceError("timeout")
 	// ErrTimeBudgetExceeded is returned (wrapping the last downstream error) when
@@ -75,39 +86,84 @@ var (
 	ErrRetryBudgetWithoutRetry error = resilienceError(
 		"retry budget requires a retry pattern",
 	)
-	// ErrCoalesceNilKeyFunc indicates [WithCoalesce] was given a nil key
-	// function; coalescing has no way to group calls without one. It is the
-	// value [NewPolicy] panics with for that misconfiguration.
+	// ErrCoalesceNilKeyFunc indicates [WithCoalesce] or [WithDebounce] was
+	// given a nil key function; coalescing has no way to group calls without
+	// one. It is the value [NewPolicy] panics with for that misconfiguration.
 	ErrCoalesceNilKeyFunc error = resilienceError(
 		"coalesce requires a non-nil key function",
 	)
-	// ErrCoalesceWithoutTimeout indicates [WithCoalesce] was configured on a
-	// policy with no [WithTimeout]. The coalesced call runs under a context
-	// detached from its callers, so without a timeout to bound it a leader whose
-	// fn never returns would park a goroutine and wedge its key indefinitely. It
-	// is the value [NewPolicy] panics with for that misconfiguration.
+	// ErrCoalesceWithoutTimeout indicates [WithCoalesce] or [WithDebounce] was
+	// configured on a policy with no [WithTimeout]. The coalesced call runs
+	// under a context detached from its callers, so without a timeout to bound
+	// it a leader whose fn never returns would park a goroutine and wedge its
+	// key indefinitely. It is the value [NewPolicy] panics with for that
+	// misconfiguration.
 	ErrCoalesceWithoutTimeout error = resilienceError(
 		"coalesce requires a timeout to bound the detached shared call",
 	)
-	// ErrCacheNilKeyFunc indicates [WithCache] was given a nil key function;
-	// the cache has no way to derive a key per call without one. It is the value
-	// [NewPolicy] panics with for that misconfiguration.
+	// ErrCacheNilKeyFunc indicates [WithCache] or [WithStaleCacheKeyed] was
+	// given a nil key function; the cache has no way to derive a key per call
+	// without one. It is the value [NewPolicy] panics with for that
+	// misconfiguration.
 	ErrCacheNilKeyFunc error = resilienceError(
 		"cache requires a non-nil key function",
 	)
-	// ErrCacheNilCache indicates [WithCache] was given a nil [Cache]; there is
-	// nothing to read from or write to. It is the value [NewPolicy] panics with
-	// for that misconfiguration.
+	// ErrCacheNilCache indicates [WithCache] or [WithStaleCacheKeyed] was given
+	// a nil [Cache]; there is nothing to read from or write to. It is the value
+	// [NewPolicy] panics with for that misconfiguration.
 	ErrCacheNilCache error = resilienceError(
 		"cache requires a non-nil cache",
 	)
-	// ErrCacheNonPositiveTTL indicates [WithCache] was given a non-positive fresh
-	// TTL; a zero or negative TTL would make every entry stale on arrival, so the
-	// cache could never serve a hit. It is the value [NewPolicy] panics with for
-	// that misconfiguration.
+	// ErrCacheNonPositiveTTL indicates [WithCache] or [WithStaleCacheKeyed] was
+	// given a non-positive TTL; a zero or negative TTL would make every entry
+	// stale on arrival, so the cache could never serve it. It is the value
+	// [NewPolicy] panics with for that misconfiguration.
 	ErrCacheNonPositiveTTL error = resilienceError(
 		"cache requires a positive TTL",
 	)
+	// ErrFallbackTypeMismatch indicates [WithFallback], [WithFallbackFunc], or
+	// [WithFallbackChain] was given a value or function typed for a different
+	// result than the policy's T, so the fallback could never be returned. It
+	// is wrapped, with both types named, in the error [NewPolicy] panics with,
+	// rather than the fallback being dropped and the policy silently losing
+	// it.
+	ErrFallbackTypeMismatch error = resilienceError(
+		"fallback type does not match policy result type",
+	)
+	// ErrHedgeSelectorTypeMismatch indicates [HedgeSelect] (or
+	// [HedgeParams].Selector) was given a selector typed for a different
+	// result than the policy's T, so it could never be applied. It is wrapped,
+	// with both types named, in the error [NewPolicy] and [DoHedge] panic
+	// with.
+	ErrHedgeSelectorTypeMismatch error = resilienceError(
+		"hedge selector type does not match result type",
+	)
+	// ErrSoftTimeoutNotBelowHard indicates [WithTimeoutSoftHard] was given a
+	// soft timeout that is not positive or not below the hard one, so the
+	// warning could never fire before the call is cancelled. It is the value
+	// [NewPolicy] panics with for that misconfiguration.
+	ErrSoftTimeoutNotBelowHard error = resilienceError(
+		"soft timeout must be positive and below the hard timeout",
+	)
+	// ErrIdempotencyNilKeyFunc indicates [WithIdempotency] was given a nil key
+	// function; the guard has no way to tell which operation a call performs
+	// without one. It is the value [NewPolicy] panics with for that
+	// misconfiguration.
+	ErrIdempotencyNilKeyFunc error = resilienceError(
+		"idempotency requires a non-nil key function",
+	)
+	// ErrIdempotencyNilStore indicates [WithIdempotency] was given a nil
+	// [IdempotencyStore]; there is nowhere to record results. It is the value
+	// [NewPolicy] panics with for that misconfiguration.
+	ErrIdempotencyNilStore error = resilienceError(
+		"idempotency requires a non-nil store",
+	)
+	// ErrIdempotencyNonPositiveTTL indicates [WithIdempotency] was given a
+	// non-positive TTL, which would record nothing worth reading back. It is the
+	// value [NewPolicy] panics with for that misconfiguration.
+	ErrIdempotencyNonPositiveTTL error = resilienceError(
+		"idempotency requires a positive TTL",
+	)
 	// ErrRefreshAheadWithoutTimeout indicates [WithCache] was configured with a
 	// firing [RefreshAhead] (a refresh threshold inside the fresh ttl) but the
 	// policy has no [WithTimeout]. The refresh-ahead reload runs in a detached
@@ -234,8 +290,10 @@ var (
 		"slo target is required",
 	)
 	// ErrPanic is matched by errors.Is when a panic was recovered by [WithRecover].
-	// To inspect the original panic value and goroutine stack trace, use errors.As
-	// to obtain the underlying *[PanicError].
+	// It is also the failure the circuit breaker and rate limiter record for a
+	// call that panicked through them without WithRecover. To inspect the
+	// original panic value and goroutine stack trace, use errors.As to obtain
+	// the underlying *[PanicError].
 	ErrPanic error = resilienceError("panic recovered")
 	// ErrConcurrencyBudgetExceeded is returned (wrapping the last downstream
 	// error) when a retry is suppressed because the concurrency budget is at its
@@ -257,56 +315,141 @@ var (
 	ErrConcurrencyBudgetWithoutConsumer error = resilienceError(
 		"concurrency budget requires a retry or hedge pattern to gate",
 	)
+	// ErrInvalidConfig is matched by errors.Is on every *[ConfigFieldError]
+	// that [ValidateConfig] reports — a config value that parses but that no
+	// policy can honor.
+	ErrInvalidConfig error = resilienceError("invalid config")
+	// ErrServingStale is returned (wrapping the downstream error) alongside the
+	// cached value when a [StaleCache] built with [StaleCacheReturnError]
+	// serves stale data because its call failed. Both are usable: the value is
+	// the last good one and errors.Is(err, ErrServingStale) tells the caller it
+	// is stale.
+	ErrServingStale error = resilienceError("serving stale data")
+	// ErrCircuitStoreWithoutBreaker indicates [WithCircuitStore] was configured
+	// on a policy with no [WithCircuitBreaker]; there is no breaker state to
+	// share. It is the value [NewPolicy] panics with for that misconfiguration.
+	ErrCircuitStoreWithoutBreaker error = resilienceError(
+		"circuit store requires a circuit breaker",
+	)
 )
 
 func (e *transientError) Error() string { return "transient: " + e.err.Error() }
 func (e *transientError) Unwrap() error { return e.err }
+func (*transientError) permanent() bool { return false }
 
 func (e *permanentError) Error() string { return "permanent: " + e.err.Error() }
 func (e *permanentError) Unwrap() error { return e.err }
+func (*permanentError) permanent() bool { return true }
 
 func (e resilienceError) Error() string { return string(e) }
 
 // Transient wraps err to mark it as a transient (retriable) error.
 // Returns nil if err is nil.
+//
+// Classification is idempotent: an err that is already transient is returned
+// unchanged, and a directly-wrapped permanent err is re-marked rather than
+// nested, so layers that each classify never stack markers.
 func Transient(err error) error {
-	if err == nil {
+	switch classified := err.(type) { //nolint:errorlint // only the top marker is replaced
+	case nil:
 		return nil
+	case *transientError:
+		return classified
+	case *permanentError:
+		return &transientError{err: classified.err}
+	default:
+		return &transientError{err: err}
 	}
-
-	return &transientError{err: err}
 }
 
 // Permanent wraps err to mark it as a permanent (non-retriable) error.
 // Returns nil if err is nil.
+//
+// Like [Transient] it is idempotent and re-marks rather than nests a
+// directly-wrapped classification.
 func Permanent(err error) error {
-	if err == nil {
+	switch classified := err.(type) { //nolint:errorlint // only the top marker is replaced
+	case nil:
 		return nil
+	case *permanentError:
+		return classified
+	case *transientError:
+		return &permanentError{err: classified.err}
+	default:
+		return &permanentError{err: err}
 	}
-
-	return &permanentError{err: err}
 }
 
 // IsTransient reports whether err is transient. Unclassified (unwrapped)
-// errors are treated as transient. Returns false for nil.
+// errors are treated as transient. Returns false for nil. When the chain
+// carries several classifications, the outermost one wins.
 func IsTransient(err error) bool {
 	if err == nil {
 		return false
 	}
-	// Explicitly permanent errors are not transient.
-	var pe *permanentError
 
-	return !errors.As(err, &pe)
+	var ce classifiedError
+	if errors.As(err, &ce) {
+		return !ce.permanent()
+	}
+
+	return true
 }
 
 // IsPermanent reports whether err was explicitly marked as permanent.
-// Returns false for nil and for unclassified errors.
+// Returns false for nil and for unclassified errors. When the chain carries
+// several classifications, the outermost one wins.
 func IsPermanent(err error) bool {
 	if err == nil {
 		return false
 	}
 
-	var pe *permanentError
+	var ce classifiedError
+
+	return errors.As(err, &ce) && ce.permanent()
+}
+
+// rootCause unwraps err down to the error that started the failure. A
+// single-error wrapper is followed through Unwrap. A multi-error — r8e's own
+// wrappers list their sentinel first and the cause after it, as
+// [RetryError] and "%w: %w" errors do — is followed through its first element
+// that is not an r8e sentinel, so e.g. a retries-exhausted error leads to the
+// last attempt's error. The result is err itself when nothing is wrapped.
+func rootCause(err error) error {
+	for {
+		switch wrapped := err.(type) { //nolint:errorlint // walking the chain one link at a time
+		case interface{ Unwrap() error }:
+			next := wrapped.Unwrap()
+			if next == nil {
+				return err
+			}
+
+			err = next
+		case interface{ Unwrap() []error }:
+			next := causeOf(wrapped.Unwrap())
+			if next == nil {
+				return err
+			}
+
+			err = next
+		default:
+			return err
+		}
+	}
+}
+
+// causeOf returns the first of errs that is not an r8e sentinel, or the first
+// of errs when all are (nil when errs is empty).
+func causeOf(errs []error) error {
+	for _, e := range errs {
+		if _, sentinel := e.(resilienceError); !sentinel && e != nil { //nolint:errorlint // exact sentinel type
+			return e
+		}
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
 
-	return errors.As(err, &pe)
+	return errs[0]
 }
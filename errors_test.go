@@ -179,6 +179,68 @@ func TestIsPermanentDetectsWrappedPermanent(t *testing.T) {
 	require.True(t, r8e.IsPermanent(wrapped))
 }
 
+// ---------------------------------------------------------------------------
+// Re-classification: idempotent, never nested, outer wins
+// ---------------------------------------------------------------------------
+
+func TestTransientIsIdempotent(t *testing.T) {
+	t.Parallel()
+
+	cause := errors.New("timeout")
+	once := r8e.Transient(cause)
+	twice := r8e.Transient(r8e.Transient(cause))
+
+	assert.Equal(t, once.Error(), twice.Error())
+	assert.Equal(t, cause, errors.Unwrap(twice), "markers must not nest")
+	assert.True(t, r8e.IsTransient(twice))
+	assert.False(t, r8e.IsPermanent(twice))
+}
+
+func TestPermanentIsIdempotent(t *testing.T) {
+	t.Parallel()
+
+	cause := errors.New("bad input")
+	twice := r8e.Permanent(r8e.Permanent(cause))
+
+	assert.Equal(t, "permanent: bad input", twice.Error())
+	assert.Equal(t, cause, errors.Unwrap(twice))
+	assert.True(t, r8e.IsPermanent(twice))
+}
+
+func TestReclassificationOuterWins(t *testing.T) {
+	t.Parallel()
+
+	cause := errors.New("boom")
+
+	perm := r8e.Permanent(r8e.Transient(cause))
+	assert.Equal(t, "permanent: boom", perm.Error())
+	assert.Equal(t, cause, errors.Unwrap(perm))
+	assert.True(t, r8e.IsPermanent(perm))
+	assert.False(t, r8e.IsTransient(perm))
+
+	trans := r8e.Transient(r8e.Permanent(cause))
+	assert.Equal(t, "transient: boom", trans.Error())
+	assert.True(t, r8e.IsTransient(trans))
+	assert.False(t, r8e.IsPermanent(trans))
+}
+
+func TestReclassificationOuterWinsThroughWrapping(t *testing.T) {
+	t.Parallel()
+
+	// A marker buried under fmt.Errorf is kept, but the outermost one decides.
+	inner := fmt.Errorf("layer: %w", r8e.Transient(errors.New("boom")))
+	err := r8e.Permanent(inner)
+
+	assert.True(t, r8e.IsPermanent(err))
+	assert.False(t, r8e.IsTransient(err))
+
+	inner = fmt.Errorf("layer: %w", r8e.Permanent(errors.New("boom")))
+	err = r8e.Transient(inner)
+
+	assert.True(t, r8e.IsTransient(err))
+	assert.False(t, r8e.IsPermanent(err))
+}
+
 // ---------------------------------------------------------------------------
 // Sentinel errors
 // ---------------------------------------------------------------------------
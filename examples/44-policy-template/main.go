@@ -0,0 +1,70 @@
+// Example 44-policy-template: Demonstrates PolicyTemplate, which resolves a set
+// of options once and then stamps out many independent policies from it — one
+// per tenant, shard, or downstream host.
+//
+// The problem it solves: a multi-tenant gateway wants the same resilience
+// settings for every tenant, but not the same *state*. Sharing one policy means
+// one noisy tenant trips the breaker for everyone; calling NewPolicy per tenant
+// gives isolation but re-applies and re-validates every option each time. A
+// template does the option work once and hands each tenant its own breaker,
+// rate limiter, bulkhead, and metrics.
+//
+//nolint:forbidigo // This is an example program.
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/byte4ever/r8e"
+)
+
+func main() {
+	ctx := context.Background()
+
+	// Resolve the shared configuration once. Misconfigurations panic here, at
+	// template construction, rather than on the first tenant to show up.
+	tmpl := r8e.NewPolicyTemplate[string](
+		r8e.WithTimeout(time.Second),
+		r8e.WithCircuitBreaker(
+			r8e.FailureThreshold(2),
+			r8e.RecoveryTimeout(time.Minute),
+		),
+		r8e.WithBulkhead(10),
+	)
+
+	// Each tenant gets its own named policy — and therefore its own breaker.
+	// Named instances auto-register for health reporting like NewPolicy.
+	acme := tmpl.New("tenant-acme")
+	globex := tmpl.New("tenant-globex")
+
+	// Acme's backend is down: two failures trip *Acme's* breaker.
+	fmt.Println("=== tenant-acme: backend failing ===")
+
+	for i := range 3 {
+		_, err := acme.Do(ctx, func(_ context.Context) (string, error) {
+			return "", errors.New("acme backend down")
+		})
+		fmt.Printf("  call %d: %v\n", i+1, err)
+	}
+
+	// Globex shares the configuration but not the state: its breaker is still
+	// closed, so its calls flow normally despite Acme's outage.
+	fmt.Println("\n=== tenant-globex: unaffected ===")
+
+	res, err := globex.Do(ctx, func(_ context.Context) (string, error) {
+		return "globex ok", nil
+	})
+	fmt.Printf("  result: %q, err: %v\n", res, err)
+
+	// --- Observability ---
+	// Health is per instance too: only Acme reports an open circuit.
+	fmt.Println("\n=== Health ===")
+
+	for _, p := range []*r8e.Policy[string]{acme, globex} {
+		hs := p.HealthStatus()
+		fmt.Printf("  %-14s state=%s healthy=%v\n", hs.Name, hs.State, hs.Healthy)
+	}
+}
@@ -0,0 +1,71 @@
+// Example 45-slog-logging: Demonstrates WithLogger, which writes every
+// resilience event of a policy to a log/slog logger, and WithLogLevels, which
+// re-levels individual events without re-implementing the logging hooks.
+//
+// The problem it solves: logging resilience events by hand means one Hooks
+// closure per event, each deciding its own level and attributes, copied into
+// every service. WithLogger does that once with consistent records (message =
+// event type, a "policy" attribute, the event's arguments) and sensible levels.
+// When a default does not fit — a rate limiter that is *expected* to reject, say
+// — WithLogLevels changes just that event's level.
+//
+//nolint:forbidigo // This is an example program.
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/byte4ever/r8e"
+)
+
+func main() {
+	ctx := context.Background()
+
+	// A text handler on stdout without timestamps, so the output is stable.
+	// Debug is enabled to show that even per-call bookkeeping is available.
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{
+		Level: slog.LevelDebug,
+		ReplaceAttr: func(_ []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+
+			return a
+		},
+	}))
+
+	policy := r8e.NewPolicy[string]("orders-api",
+		r8e.WithRetry(3, r8e.ConstantBackoff(10*time.Millisecond)),
+		r8e.WithRateLimit(1),
+		r8e.WithFallback("cached-orders"),
+		r8e.WithLogger(logger),
+		// This client shares a deliberately tight rate limit: rejections are
+		// routine here, so log them at Info instead of the default Warn.
+		r8e.WithLogLevels(map[r8e.EventType]slog.Level{
+			r8e.EventRateLimited: slog.LevelInfo,
+		}),
+	)
+
+	// First call: the backend fails every attempt. Each retry logs at Warn
+	// with its attempt number and error, then the fallback logs at Warn.
+	fmt.Println("=== Call 1: backend down ===")
+
+	res, _ := policy.Do(ctx, func(_ context.Context) (string, error) {
+		return "", errors.New("connection refused")
+	})
+	fmt.Printf("result: %s\n", res)
+
+	// Second call: the single token is spent, so the rate limiter rejects it —
+	// logged at Info thanks to the override — and the fallback answers.
+	fmt.Println("\n=== Call 2: rate limited ===")
+
+	res, _ = policy.Do(ctx, func(_ context.Context) (string, error) {
+		return "fresh-orders", nil
+	})
+	fmt.Printf("result: %s\n", res)
+}
@@ -0,0 +1,70 @@
+// Example 46-leaky-bucket: Demonstrates RateLimitLeaky, which switches the rate
+// limiter from a token bucket to a leaky bucket that paces calls evenly.
+//
+// The problem it solves: a token bucket at 5/s lets an idle client fire five
+// calls at once, then one every 200ms. That burst is fine for most backends,
+// but a fragile partner API that falls over under any spike needs strict
+// pacing instead: one call every 200ms, never two together, however long the
+// client was idle. RateLimitLeaky enforces exactly that spacing — rejecting a
+// call that arrives early, or, with RateLimitBlocking, holding it until its
+// slot.
+//
+//nolint:forbidigo // This is an example program.
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/byte4ever/r8e"
+)
+
+func main() {
+	ctx := context.Background()
+	call := func(_ context.Context) (string, error) { return "ok", nil }
+
+	// Same rate, two shapes. The token bucket starts full and absorbs a burst;
+	// the leaky bucket admits one call and makes the next wait its turn.
+	bucket := r8e.NewPolicy[string]("partner-bucket", r8e.WithRateLimit(5))
+	leaky := r8e.NewPolicy[string]("partner-leaky",
+		r8e.WithRateLimit(5, r8e.RateLimitLeaky()),
+	)
+
+	fmt.Println("=== 5 back-to-back calls, reject mode ===")
+	fmt.Printf("token bucket admitted: %d/5\n", admitted(ctx, bucket, call))
+	fmt.Printf("leaky bucket admitted: %d/5\n", admitted(ctx, leaky, call))
+
+	// In blocking mode nothing is rejected: each call waits for its slot, so
+	// the partner sees one request every 1/rate = 200ms.
+	paced := r8e.NewPolicy[string]("partner-paced",
+		r8e.WithRateLimit(5, r8e.RateLimitLeaky(), r8e.RateLimitBlocking()),
+	)
+
+	fmt.Println("\n=== 4 calls, blocking leaky bucket ===")
+
+	start := time.Now()
+
+	for i := range 4 {
+		_, _ = paced.Do(ctx, call)
+		fmt.Printf("call %d at +%v\n", i+1, time.Since(start).Round(100*time.Millisecond))
+	}
+}
+
+// admitted fires five calls at once and counts those the limiter let through.
+func admitted(
+	ctx context.Context,
+	policy *r8e.Policy[string],
+	call func(context.Context) (string, error),
+) int {
+	n := 0
+
+	for range 5 {
+		if _, err := policy.Do(ctx, call); !errors.Is(err, r8e.ErrRateLimited) {
+			n++
+		}
+	}
+
+	return n
+}
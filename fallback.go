@@ -18,6 +18,7 @@ func DoFallback[T any](
 	result, err := fn(ctx)
 	if err != nil {
 		hooks.emitFallbackUsed(err)
+		markTrace(ctx, traceDegraded|traceFallback)
 		return fallbackVal, nil
 	}
 
@@ -37,6 +38,7 @@ func DoFallbackFunc[T any](
 	result, err := fn(ctx)
 	if err != nil {
 		hooks.emitFallbackUsed(err)
+		markTrace(ctx, traceDegraded|traceFallback)
 
 		//nolint:wrapcheck // fallback function's error returned as-is
 		return fallbackFn(
@@ -46,3 +48,40 @@ func DoFallbackFunc[T any](
 
 	return result, nil
 }
+
+// DoFallbackChain executes fn. On error, tries each provider in order, passing
+// it the call's error, and returns the first result a provider produces with a
+// nil error. When every provider fails, the last provider's error is returned;
+// with no providers, fn's error is.
+//
+// OnFallbackUsed fires once per provider tried, with the error it is asked to
+// replace: fn's error for the first provider, the previous provider's error for
+// each one after it.
+//
+//nolint:ireturn // generic type parameter T, not an interface
+func DoFallbackChain[T any](
+	ctx context.Context,
+	fn func(context.Context) (T, error),
+	providers []func(context.Context, error) (T, error),
+	hooks *Hooks,
+) (T, error) {
+	result, err := fn(ctx)
+	if err == nil {
+		return result, nil
+	}
+
+	callErr := err
+
+	for _, provider := range providers {
+		hooks.emitFallbackUsed(err)
+
+		result, err = provider(ctx, callErr)
+		if err == nil {
+			markTrace(ctx, traceDegraded|traceFallback)
+
+			return result, nil
+		}
+	}
+
+	return result, err //nolint:wrapcheck // last provider's error returned as-is
+}
@@ -3,7 +3,9 @@ package r8e_test
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -300,6 +302,88 @@ func TestDoFallbackFuncNilHooksDoNotPanic(t *testing.T) {
 	// If we reach here without panicking, the test passes.
 }
 
+// ---------------------------------------------------------------------------
+// OnFallbackUsedDetailed receives the final error and its root cause
+// ---------------------------------------------------------------------------
+
+var errInventoryDown = errors.New("inventory down")
+
+func TestOnFallbackUsedDetailedAfterRetriesExhausted(t *testing.T) {
+	t.Parallel()
+
+	var (
+		plainErr       error
+		finalErr, root error
+	)
+
+	policy := r8e.NewPolicy[string]("fallback-detailed",
+		r8e.WithRegistry(r8e.NewRegistry()),
+		r8e.WithRetry(3, r8e.ConstantBackoff(time.Millisecond)),
+		r8e.WithFallback("default"),
+		r8e.WithHooks(&r8e.Hooks{
+			OnFallbackUsed: func(err error) { plainErr = err },
+			OnFallbackUsedDetailed: func(final, rootErr error) {
+				finalErr, root = final, rootErr
+			},
+		}),
+	)
+
+	result, err := policy.Do(context.Background(), func(_ context.Context) (string, error) {
+		return "", r8e.Transient(errInventoryDown)
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "default", result)
+
+	// The existing hook still fires with the final error.
+	require.ErrorIs(t, plainErr, r8e.ErrRetriesExhausted)
+
+	require.ErrorIs(t, finalErr, r8e.ErrRetriesExhausted)
+	assert.Same(t, errInventoryDown, root, "root is the downstream sentinel, not a wrapper")
+}
+
+func TestOnFallbackUsedDetailedRootCause(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		err  error
+		want error
+	}{
+		"unwrapped error is its own root": {
+			err:  errInventoryDown,
+			want: errInventoryDown,
+		},
+		"classification is unwrapped": {
+			err:  r8e.Permanent(errInventoryDown),
+			want: errInventoryDown,
+		},
+		"sentinel-first wrap leads to the cause": {
+			err:  fmt.Errorf("%w: %w", r8e.ErrTimeBudgetExceeded, r8e.Transient(errInventoryDown)),
+			want: errInventoryDown,
+		},
+		"bare r8e sentinel": {
+			err:  r8e.ErrCircuitOpen,
+			want: r8e.ErrCircuitOpen,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var root error
+
+			_, _ = r8e.DoFallback[string](
+				context.Background(),
+				func(_ context.Context) (string, error) { return "", tt.err },
+				"default",
+				&r8e.Hooks{OnFallbackUsedDetailed: func(_, rootErr error) { root = rootErr }},
+			)
+
+			assert.Equal(t, tt.want, root)
+		})
+	}
+}
+
 // ---------------------------------------------------------------------------
 // Benchmark
 // ---------------------------------------------------------------------------
@@ -337,3 +421,117 @@ func BenchmarkDoFallbackFunc(b *testing.B) {
 		)
 	}
 }
+
+// ---------------------------------------------------------------------------
+// DoFallbackChain: providers tried in order
+// ---------------------------------------------------------------------------
+
+var (
+	errPrimaryDown   = errors.New("primary down")
+	errCacheMiss     = errors.New("cache miss")
+	errSecondaryDown = errors.New("secondary down")
+)
+
+func TestDoFallbackChainSecondProviderRecovers(t *testing.T) {
+	t.Parallel()
+
+	var (
+		used  []error
+		given []error
+	)
+
+	provider := func(val string, err error) func(context.Context, error) (string, error) {
+		return func(_ context.Context, callErr error) (string, error) {
+			given = append(given, callErr)
+
+			return val, err
+		}
+	}
+
+	result, err := r8e.DoFallbackChain[string](
+		context.Background(),
+		func(_ context.Context) (string, error) { return "", errPrimaryDown },
+		[]func(context.Context, error) (string, error){
+			provider("", errCacheMiss),
+			provider("secondary", nil),
+			provider("default", nil),
+		},
+		&r8e.Hooks{OnFallbackUsed: func(err error) { used = append(used, err) }},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "secondary", result)
+
+	assert.Equal(t, []error{errPrimaryDown, errCacheMiss}, used,
+		"one OnFallbackUsed per provider tried, with the error it replaces")
+	assert.Equal(t, []error{errPrimaryDown, errPrimaryDown}, given,
+		"every provider is handed the call's error; the third is never tried")
+}
+
+func TestDoFallbackChainAllProvidersFail(t *testing.T) {
+	t.Parallel()
+
+	_, err := r8e.DoFallbackChain[string](
+		context.Background(),
+		func(_ context.Context) (string, error) { return "", errPrimaryDown },
+		[]func(context.Context, error) (string, error){
+			func(context.Context, error) (string, error) { return "", errCacheMiss },
+			func(context.Context, error) (string, error) { return "", errSecondaryDown },
+		},
+		nil,
+	)
+	require.ErrorIs(t, err, errSecondaryDown, "the last provider's error propagates")
+}
+
+func TestDoFallbackChainSuccessSkipsProviders(t *testing.T) {
+	t.Parallel()
+
+	var used bool
+
+	result, err := r8e.DoFallbackChain[string](
+		context.Background(),
+		func(_ context.Context) (string, error) { return "ok", nil },
+		[]func(context.Context, error) (string, error){
+			func(context.Context, error) (string, error) {
+				used = true
+
+				return "fallback", nil
+			},
+		},
+		&r8e.Hooks{OnFallbackUsed: func(error) { used = true }},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "ok", result)
+	assert.False(t, used)
+}
+
+func TestPolicyWithFallbackChain(t *testing.T) {
+	t.Parallel()
+
+	policy := r8e.NewPolicy[string]("fallback-chain",
+		r8e.WithRegistry(r8e.NewRegistry()),
+		r8e.WithFallbackChain(
+			func(context.Context, error) (string, error) { return "", errCacheMiss },
+			func(_ context.Context, err error) (string, error) {
+				return "default after " + err.Error(), nil
+			},
+		),
+	)
+	assert.Equal(t, []string{"fallback_chain"}, policy.Patterns())
+
+	result, err := policy.Do(context.Background(), func(_ context.Context) (string, error) {
+		return "", errPrimaryDown
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "default after primary down", result)
+	assert.Equal(t, int64(2), policy.Metrics().FallbacksUsed)
+}
+
+func TestPolicyWithFallbackChainTypeMismatchPanics(t *testing.T) {
+	t.Parallel()
+
+	assert.Panics(t, func() {
+		r8e.NewPolicy[string]("",
+			r8e.WithFallbackChain(func(context.Context, error) (int, error) { return 0, nil }),
+		)
+	})
+}
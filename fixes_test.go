@@ -23,6 +23,8 @@ func TestBuildOptionsAllFields(t *testing.T) {
 	backoff := "exponential"
 	baseDelay := "100ms"
 	maxDelay := "30s"
+	maxElapsed := "1m"
+	minPerAttempt := "10ms"
 	maxAttempts := 3
 	rate := 100.0
 	bulkhead := 10
@@ -37,10 +39,12 @@ func TestBuildOptionsAllFields(t *testing.T) {
 			HalfOpenMaxAttempts: &halfOpen,
 		},
 		Retry: &RetryConfig{
-			Backoff:     &backoff,
-			BaseDelay:   &baseDelay,
-			MaxDelay:    &maxDelay,
-			MaxAttempts: &maxAttempts,
+			Backoff:           &backoff,
+			BaseDelay:         &baseDelay,
+			MaxDelay:          &maxDelay,
+			MaxElapsedTime:    &maxElapsed,
+			MinTimePerAttempt: &minPerAttempt,
+			MaxAttempts:       &maxAttempts,
 		},
 		RateLimit: &rate,
 		Bulkhead:  &bulkhead,
@@ -93,6 +97,16 @@ func TestBuildOptionsErrorPaths(t *testing.T) {
 			&PolicyConfig{Retry: &RetryConfig{Backoff: &backoff, BaseDelay: &good, MaxDelay: &bad}},
 			"retry.max_delay",
 		},
+		{
+			"bad retry max_elapsed_time",
+			&PolicyConfig{Retry: &RetryConfig{Backoff: &backoff, BaseDelay: &good, MaxElapsedTime: &bad}},
+			"retry.max_elapsed_time",
+		},
+		{
+			"bad retry min_time_per_attempt",
+			&PolicyConfig{Retry: &RetryConfig{Backoff: &backoff, BaseDelay: &good, MinTimePerAttempt: &bad}},
+			"retry.min_time_per_attempt",
+		},
 		{
 			"bad hedge",
 			&PolicyConfig{Hedge: &bad},
@@ -286,16 +300,59 @@ func TestWithFallbackTypeMismatchPanics(t *testing.T) {
 	defer func() {
 		r := recover()
 		require.NotNil(t, r, "NewPolicy did not panic on fallback type mismatch")
-		if msg, ok := r.(string); ok {
-			assert.Contains(t, msg, "WithFallback",
-				"panic message = %q, want it to mention WithFallback", msg)
-		}
+
+		err, ok := r.(error)
+		require.True(t, ok, "panic value %v is not an error", r)
+		assert.Contains(t, err.Error(), "WithFallback",
+			"panic message = %q, want it to mention WithFallback", err)
 	}()
 
 	// int fallback on a string policy.
 	_ = NewPolicy[string]("mismatch", WithFallback(42))
 }
 
+func TestFallbackTypeMismatchIsTyped(t *testing.T) {
+	tests := []struct {
+		name   string
+		option Option
+		want   string
+	}{
+		{
+			name:   "value",
+			option: WithFallback(42),
+			want:   "WithFallback value has type int, policy result type is string",
+		},
+		{
+			name:   "func",
+			option: WithFallbackFunc(func(error) (int, error) { return 0, nil }),
+			want:   "WithFallbackFunc has type func(error) (int, error), policy result type is string",
+		},
+		{
+			name:   "chain",
+			option: WithFallbackChain(func(context.Context, error) (int, error) { return 0, nil }),
+			want: "WithFallbackChain providers have type []func(context.Context, error) (int, error), " +
+				"policy result type is string",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var recovered any
+
+			func() {
+				defer func() { recovered = recover() }()
+
+				_ = NewPolicy[string]("", tt.option)
+			}()
+
+			err, ok := recovered.(error)
+			require.True(t, ok, "panic value %v is not an error", recovered)
+			require.ErrorIs(t, err, ErrFallbackTypeMismatch)
+			assert.Contains(t, err.Error(), tt.want)
+		})
+	}
+}
+
 func TestWithFallbackFuncTypeMismatchPanics(t *testing.T) {
 	require.Panics(t, func() {
 		// func returning int on a string policy.
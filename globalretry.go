@@ -0,0 +1,58 @@
+package r8e
+
+import "sync/atomic"
+
+// Pattern: Global Safety Valve — one process-wide cap on concurrent retry
+// attempts, consulted by every policy, so a correlated outage across many
+// dependencies cannot turn the whole process into a retry storm even when each
+// policy stays within its own budgets.
+
+// globalRetryLimit counts the retry attempts (second and later attempts) in
+// flight across every policy and, when limit is positive, caps them. The count
+// is kept even without a limit so [GlobalRetriesInFlight] is always meaningful
+// and a limit set mid-flight sees the retries already running.
+type globalRetryLimit struct {
+	limit    atomic.Int64 // ≤ 0: unlimited
+	inFlight atomic.Int64
+}
+
+//nolint:gochecknoglobals // process-wide retry cap, by design
+var globalRetries globalRetryLimit
+
+// SetGlobalRetryLimit caps the number of retry attempts in flight at once
+// across all policies in the process, as a safety valve beyond the per-policy
+// [WithRetryBudget] and [WithConcurrencyBudget]. A retry that would exceed the
+// cap is skipped: the call stops retrying and returns the last attempt's error,
+// as when the retry budget suppresses a retry. First attempts are never
+// counted nor gated. A value of n ≤ 0 removes the cap (the default). It is
+// safe to call at any time; retries already in flight are not interrupted.
+func SetGlobalRetryLimit(n int) {
+	globalRetries.limit.Store(int64(max(n, 0)))
+}
+
+// GlobalRetriesInFlight returns the number of retry attempts currently running
+// across all policies in the process, whether or not a [SetGlobalRetryLimit]
+// cap is set.
+func GlobalRetriesInFlight() int {
+	return int(globalRetries.inFlight.Load())
+}
+
+// tryAcquire claims a slot for one retry attempt, reporting whether the cap
+// allowed it. A granted slot must be returned with release.
+func (g *globalRetryLimit) tryAcquire() bool {
+	for {
+		current := g.inFlight.Load()
+		if limit := g.limit.Load(); limit > 0 && current >= limit {
+			return false
+		}
+
+		if g.inFlight.CompareAndSwap(current, current+1) {
+			return true
+		}
+	}
+}
+
+// release returns a slot claimed by tryAcquire.
+func (g *globalRetryLimit) release() {
+	g.inFlight.Add(-1)
+}
@@ -0,0 +1,100 @@
+package r8e
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Not parallel: the limit is process-wide, and parallel tests only start once
+// the sequential ones have finished.
+func TestSetGlobalRetryLimit(t *testing.T) {
+	const limit = 2
+
+	SetGlobalRetryLimit(limit)
+	t.Cleanup(func() { SetGlobalRetryLimit(0) })
+
+	errDown := errors.New("down")
+
+	var (
+		peak    atomic.Int64
+		entered = make(chan struct{}, 6)
+		release = make(chan struct{})
+	)
+
+	// The first attempt fails at once; a retry records the global count and
+	// holds its slot until released.
+	call := func() func(context.Context) (string, error) {
+		attempts := 0
+
+		return func(context.Context) (string, error) {
+			attempts++
+			if attempts == 1 {
+				return "", errDown
+			}
+
+			inFlight := int64(GlobalRetriesInFlight())
+			for {
+				seen := peak.Load()
+				if inFlight <= seen || peak.CompareAndSwap(seen, inFlight) {
+					break
+				}
+			}
+
+			entered <- struct{}{}
+			<-release
+
+			return "", errDown
+		}
+	}
+
+	policies := make([]*Policy[string], 3)
+	for i := range policies {
+		policies[i] = NewPolicy[string]("",
+			WithClock(newImmediateTestClock()),
+			WithRetry(2, ConstantBackoff(time.Millisecond)),
+		)
+	}
+
+	done := make(chan error, 6)
+
+	for i := range 6 {
+		go func() {
+			_, err := policies[i%len(policies)].Do(context.Background(), call())
+			done <- err
+		}()
+	}
+
+	// Four calls find the cap reached: their retry is skipped and they return
+	// the first attempt's error while the two retries are still held.
+	for range 6 - limit {
+		err := <-done
+		require.ErrorIs(t, err, errDown)
+		require.NotErrorIs(t, err, ErrRetriesExhausted)
+	}
+
+	for range limit {
+		<-entered
+	}
+
+	assert.Equal(t, limit, GlobalRetriesInFlight())
+
+	close(release)
+
+	for range limit {
+		require.ErrorIs(t, <-done, ErrRetriesExhausted)
+	}
+
+	assert.LessOrEqual(t, peak.Load(), int64(limit))
+	assert.Zero(t, GlobalRetriesInFlight())
+
+	// With the retries finished, the next call retries again.
+	_, err := policies[0].Do(context.Background(), call())
+	require.ErrorIs(t, err, ErrRetriesExhausted)
+	assert.Zero(t, GlobalRetriesInFlight())
+}
@@ -0,0 +1,17 @@
+// Package grpcx provides a resilient gRPC client interceptor for the r8e
+// library.
+//
+// Interceptor runs every unary call through an r8e resilience policy and a
+// user-provided status code classifier that maps gRPC codes to transient or
+// permanent errors, mirroring what httpx does for HTTP status codes. Install
+// it with grpc.WithUnaryInterceptor(ic.Unary()).
+//
+// Each attempt decodes into its own reply message, so a failed attempt never
+// leaves partial data in the caller's reply and concurrent hedged attempts
+// never share one. Replies that are not proto messages (custom codecs) are
+// decoded in place, so do not hedge such calls. Methods that are not
+// idempotent can be kept from retrying with [Idempotent].
+//
+// The package lives in its own module so the r8e core stays free of the gRPC
+// dependency.
+package grpcx
@@ -0,0 +1,113 @@
+// Example 01-unary: run unary gRPC calls through an r8e policy with a client
+// interceptor. An in-memory health server fails twice with UNAVAILABLE, then
+// answers; the interceptor classifies UNAVAILABLE as transient, so retry rides
+// out the outage and the caller only sees the final SERVING reply. A second
+// server fails with INVALID_ARGUMENT, which is permanent: the call fails at
+// once, with its gRPC status intact.
+//
+//nolint:forbidigo // This is an example program.
+package main
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/byte4ever/r8e"
+	"github.com/byte4ever/r8e/grpcx"
+)
+
+// flakyHealth fails the first len(script) Check calls with the scripted codes.
+type flakyHealth struct {
+	healthpb.UnimplementedHealthServer
+
+	script []codes.Code
+	calls  atomic.Int64
+}
+
+func (s *flakyHealth) Check(
+	context.Context,
+	*healthpb.HealthCheckRequest,
+) (*healthpb.HealthCheckResponse, error) {
+	n := int(s.calls.Add(1))
+	if n <= len(s.script) {
+		fmt.Printf("  server: attempt %d -> %s\n", n, s.script[n-1])
+
+		return nil, status.Error(s.script[n-1], "not now")
+	}
+
+	fmt.Printf("  server: attempt %d -> OK\n", n)
+
+	return &healthpb.HealthCheckResponse{Status: healthpb.HealthCheckResponse_SERVING}, nil
+}
+
+// connect serves srv in memory and dials it with the r8e interceptor. A real
+// client passes the same grpc.WithUnaryInterceptor option to grpc.NewClient.
+func connect(srv healthpb.HealthServer) (healthpb.HealthClient, func()) {
+	lis := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	healthpb.RegisterHealthServer(server, srv)
+
+	go func() { _ = server.Serve(lis) }()
+
+	// Transient codes are retried with backoff; the breaker and timeout guard
+	// against a dependency that stays down or hangs.
+	interceptor := grpcx.NewUnaryClientInterceptor("health-client",
+		grpcx.DefaultClassifier,
+		r8e.WithTimeout(2*time.Second),
+		r8e.WithRetry(3, r8e.ConstantBackoff(50*time.Millisecond)),
+		r8e.WithCircuitBreaker(),
+	)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(interceptor),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	return healthpb.NewHealthClient(conn), func() {
+		_ = conn.Close()
+
+		server.Stop()
+	}
+}
+
+func main() {
+	ctx := context.Background()
+
+	fmt.Println("=== transient failures: retried ===")
+
+	client, closeFn := connect(&flakyHealth{
+		script: []codes.Code{codes.Unavailable, codes.Unavailable},
+	})
+
+	resp, err := client.Check(ctx, &healthpb.HealthCheckRequest{})
+	fmt.Printf("result: %v, err: %v\n", resp.GetStatus(), err)
+
+	closeFn()
+
+	fmt.Println()
+	fmt.Println("=== permanent failure: not retried ===")
+
+	client, closeFn = connect(&flakyHealth{
+		script: []codes.Code{codes.InvalidArgument},
+	})
+	defer closeFn()
+
+	_, err = client.Check(ctx, &healthpb.HealthCheckRequest{})
+	fmt.Printf("code: %s\n", status.Code(err))
+}
@@ -0,0 +1,210 @@
+package grpcx
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/byte4ever/r8e"
+)
+
+type (
+	// ErrorClass tells the resilience layer how to treat a gRPC status code.
+	ErrorClass int
+
+	// Classifier maps a gRPC status code to an ErrorClass.
+	//
+	// Pattern: Strategy — caller injects classification logic without
+	// modifying the adapter.
+	Classifier func(code codes.Code) ErrorClass
+
+	// Interceptor wraps unary gRPC calls with an r8e resilience policy and
+	// gRPC status code classification.
+	//
+	// Pattern: Adapter — bridges grpc-go's interceptor chain and r8e's
+	// resilience policy by translating status codes into r8e error
+	// classification.
+	Interceptor struct {
+		policy     *r8e.Policy[any]
+		classifier Classifier
+		// idempotent reports whether a method may be retried; nil treats
+		// every method as idempotent (see Idempotent).
+		idempotent func(fullMethod string) bool
+	}
+
+	// InterceptorOption configures adapter-level behaviour of an
+	// [Interceptor] that is not part of its r8e policy. Apply options with
+	// [Interceptor.With].
+	InterceptorOption func(*Interceptor)
+)
+
+const (
+	// Success means the call succeeded (codes.OK).
+	Success ErrorClass = iota
+	// Transient means the error is retriable (e.g. Unavailable).
+	Transient
+	// Permanent means the error is non-retriable (e.g. InvalidArgument).
+	Permanent
+)
+
+// DefaultClassifier treats OK as success; Unavailable, ResourceExhausted, and
+// Aborted as transient — the codes gRPC's own retry guidance marks as safe to
+// retry; and every other code as permanent. DeadlineExceeded is permanent
+// because the server may already have acted on the call; classify it as
+// transient yourself for methods known to be idempotent.
+func DefaultClassifier(code codes.Code) ErrorClass {
+	switch code {
+	case codes.OK:
+		return Success
+	case codes.Unavailable, codes.ResourceExhausted, codes.Aborted:
+		return Transient
+	default:
+		return Permanent
+	}
+}
+
+// New creates an Interceptor that executes unary calls through the given r8e
+// policy options. The classifier determines how gRPC status codes map to
+// transient or permanent errors for retry decisions; a nil classifier uses
+// [DefaultClassifier].
+func New(name string, cl Classifier, opts ...r8e.Option) *Interceptor {
+	if cl == nil {
+		cl = DefaultClassifier
+	}
+
+	return &Interceptor{
+		policy:     r8e.NewPolicy[any](name, opts...),
+		classifier: cl,
+	}
+}
+
+// NewUnaryClientInterceptor is shorthand for New(name, cl, opts...).Unary().
+func NewUnaryClientInterceptor(
+	name string,
+	cl Classifier,
+	opts ...r8e.Option,
+) grpc.UnaryClientInterceptor {
+	return New(name, cl, opts...).Unary()
+}
+
+// Idempotent restricts retries to the methods for which fn returns true; fn
+// receives the full method name, e.g. "/pkg.Service/Method". A failure of any
+// other method is returned as-is — marked [r8e.Permanent] so no retry repeats
+// a call the server may have acted on. Without this option every method is
+// treated as idempotent.
+func Idempotent(fn func(fullMethod string) bool) InterceptorOption {
+	return func(ic *Interceptor) {
+		ic.idempotent = fn
+	}
+}
+
+// With returns a copy of ic with opts applied. The copy shares ic's policy, so
+// both keep one circuit breaker, rate limiter, and so on.
+func (ic *Interceptor) With(opts ...InterceptorOption) *Interceptor {
+	clone := *ic
+	for _, opt := range opts {
+		opt(&clone)
+	}
+
+	return &clone
+}
+
+// Policy returns the r8e policy the interceptor runs calls through, e.g. to
+// read its metrics or health.
+func (ic *Interceptor) Policy() *r8e.Policy[any] {
+	return ic.policy
+}
+
+// Unary returns the interceptor as a grpc.UnaryClientInterceptor. The call's
+// error is the policy's: a classified gRPC status error (wrapped as
+// [r8e.Transient] or [r8e.Permanent], so status.Code still reports its code),
+// or an r8e error such as [r8e.ErrCircuitOpen], [r8e.ErrTimeout], or
+// [r8e.ErrRetriesExhausted], which wraps the last attempt's status.
+func (ic *Interceptor) Unary() grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply any,
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		retryable := ic.idempotent == nil || ic.idempotent(method)
+
+		result, err := ic.policy.Do(ctx, func(ctx context.Context) (any, error) {
+			out := freshReply(reply)
+			err := ic.classify(invoker(ctx, method, req, out, cc, opts...))
+
+			if err != nil && !retryable {
+				return nil, r8e.Permanent(err)
+			}
+
+			return out, err
+		})
+		if err != nil {
+			return err //nolint:wrapcheck // policy returns caller's error as-is
+		}
+
+		copyReply(reply, result)
+
+		return nil
+	}
+}
+
+// classify wraps a non-nil invoker error according to its status code. An
+// error that carries no gRPC status (a client-side failure) is left as-is, so
+// the policy treats it as transient like any unclassified error.
+func (ic *Interceptor) classify(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+
+	switch ic.classifier(st.Code()) {
+	case Success:
+		return nil
+	case Transient:
+		return r8e.Transient(err)
+	case Permanent:
+		return r8e.Permanent(err)
+	default:
+		// An out-of-range ErrorClass from a custom classifier is passed
+		// through unchanged rather than silently retried.
+		return err
+	}
+}
+
+// freshReply returns an empty message of reply's type for one attempt to
+// decode into. A reply that is not a proto message (a custom codec) cannot be
+// cloned generically and is decoded into directly.
+func freshReply(reply any) any {
+	msg, ok := reply.(proto.Message)
+	if !ok {
+		return reply
+	}
+
+	return msg.ProtoReflect().New().Interface()
+}
+
+// copyReply moves the winning attempt's message into the caller's reply.
+func copyReply(reply, result any) {
+	msg, ok := reply.(proto.Message)
+	if !ok {
+		return
+	}
+
+	src, ok := result.(proto.Message)
+	if !ok || src == msg {
+		return
+	}
+
+	proto.Reset(msg)
+	proto.Merge(msg, src)
+}
@@ -0,0 +1,243 @@
+package grpcx_test
+
+import (
+	"context"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/byte4ever/r8e"
+	"github.com/byte4ever/r8e/grpcx"
+)
+
+const checkMethod = "/grpc.health.v1.Health/Check"
+
+// ---------------------------------------------------------------------------
+// Test server
+// ---------------------------------------------------------------------------
+
+// scriptedHealth answers Check with the scripted codes in order, then with
+// SERVING once the script runs out.
+type scriptedHealth struct {
+	healthpb.UnimplementedHealthServer
+
+	script []codes.Code
+	calls  atomic.Int64
+}
+
+func (s *scriptedHealth) Check(
+	context.Context,
+	*healthpb.HealthCheckRequest,
+) (*healthpb.HealthCheckResponse, error) {
+	n := int(s.calls.Add(1)) - 1
+	if n < len(s.script) {
+		return nil, status.Error(s.script[n], "scripted failure")
+	}
+
+	return &healthpb.HealthCheckResponse{
+		Status: healthpb.HealthCheckResponse_SERVING,
+	}, nil
+}
+
+// dial serves srv over an in-memory listener and returns a connection whose
+// unary calls run through ic.
+func dial(t *testing.T, srv healthpb.HealthServer, ic grpc.UnaryClientInterceptor) *grpc.ClientConn {
+	t.Helper()
+
+	lis := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	healthpb.RegisterHealthServer(server, srv)
+
+	go func() { _ = server.Serve(lis) }()
+
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(ic),
+	)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return conn
+}
+
+func check(conn *grpc.ClientConn) (*healthpb.HealthCheckResponse, error) {
+	return healthpb.NewHealthClient(conn).Check(
+		context.Background(), &healthpb.HealthCheckRequest{},
+	)
+}
+
+// ---------------------------------------------------------------------------
+// Tests
+// ---------------------------------------------------------------------------
+
+func TestInterceptorRetriesUnavailableUntilOK(t *testing.T) {
+	t.Parallel()
+
+	srv := &scriptedHealth{script: []codes.Code{codes.Unavailable, codes.Unavailable}}
+	conn := dial(t, srv, grpcx.NewUnaryClientInterceptor("health", nil,
+		r8e.WithRetry(3, r8e.ConstantBackoff(time.Millisecond)),
+	))
+
+	resp, err := check(conn)
+	require.NoError(t, err)
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, resp.GetStatus())
+	assert.Equal(t, int64(3), srv.calls.Load())
+}
+
+func TestInterceptorDoesNotRetryPermanentCodes(t *testing.T) {
+	t.Parallel()
+
+	srv := &scriptedHealth{script: []codes.Code{codes.InvalidArgument}}
+	conn := dial(t, srv, grpcx.NewUnaryClientInterceptor("health", nil,
+		r8e.WithRetry(3, r8e.ConstantBackoff(time.Millisecond)),
+	))
+
+	_, err := check(conn)
+	require.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, int64(1), srv.calls.Load())
+}
+
+func TestInterceptorExhaustedRetriesKeepLastStatus(t *testing.T) {
+	t.Parallel()
+
+	srv := &scriptedHealth{script: []codes.Code{
+		codes.Unavailable, codes.Unavailable, codes.Unavailable,
+	}}
+	conn := dial(t, srv, grpcx.NewUnaryClientInterceptor("health", nil,
+		r8e.WithRetry(3, r8e.ConstantBackoff(time.Millisecond)),
+	))
+
+	_, err := check(conn)
+	require.ErrorIs(t, err, r8e.ErrRetriesExhausted)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+func TestInterceptorNonIdempotentMethodIsNotRetried(t *testing.T) {
+	t.Parallel()
+
+	srv := &scriptedHealth{script: []codes.Code{codes.Unavailable}}
+	ic := grpcx.New("health", nil,
+		r8e.WithRetry(3, r8e.ConstantBackoff(time.Millisecond)),
+	).With(grpcx.Idempotent(func(method string) bool { return method != checkMethod }))
+	conn := dial(t, srv, ic.Unary())
+
+	_, err := check(conn)
+	require.Error(t, err)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Equal(t, int64(1), srv.calls.Load())
+}
+
+func TestInterceptorSurfacesCircuitOpen(t *testing.T) {
+	t.Parallel()
+
+	srv := &scriptedHealth{script: []codes.Code{codes.Unavailable, codes.Unavailable}}
+	conn := dial(t, srv, grpcx.NewUnaryClientInterceptor("health", nil,
+		r8e.WithCircuitBreaker(r8e.FailureThreshold(1), r8e.RecoveryTimeout(time.Hour)),
+	))
+
+	_, err := check(conn)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+
+	_, err = check(conn)
+	require.ErrorIs(t, err, r8e.ErrCircuitOpen)
+	assert.Equal(t, int64(1), srv.calls.Load(), "the open circuit short-circuits the call")
+}
+
+// slowHealth blocks Check until the call is cancelled.
+type slowHealth struct {
+	healthpb.UnimplementedHealthServer
+}
+
+func (slowHealth) Check(
+	ctx context.Context,
+	_ *healthpb.HealthCheckRequest,
+) (*healthpb.HealthCheckResponse, error) {
+	<-ctx.Done()
+
+	return nil, status.FromContextError(ctx.Err()).Err()
+}
+
+func TestInterceptorSurfacesTimeout(t *testing.T) {
+	t.Parallel()
+
+	conn := dial(t, slowHealth{}, grpcx.NewUnaryClientInterceptor("health", nil,
+		r8e.WithTimeout(20*time.Millisecond),
+	))
+
+	_, err := check(conn)
+	require.ErrorIs(t, err, r8e.ErrTimeout)
+}
+
+func TestInterceptorRepliesOnlyWithWinningAttempt(t *testing.T) {
+	t.Parallel()
+
+	srv := &scriptedHealth{script: []codes.Code{codes.InvalidArgument}}
+	conn := dial(t, srv, grpcx.NewUnaryClientInterceptor("health", nil))
+
+	// A failed call leaves the caller's reply as it was.
+	reply := &healthpb.HealthCheckResponse{Status: healthpb.HealthCheckResponse_NOT_SERVING}
+	err := conn.Invoke(context.Background(), checkMethod, &healthpb.HealthCheckRequest{}, reply)
+	require.Error(t, err)
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, reply.GetStatus())
+
+	// A successful one replaces it with the server's answer.
+	err = conn.Invoke(context.Background(), checkMethod, &healthpb.HealthCheckRequest{}, reply)
+	require.NoError(t, err)
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, reply.GetStatus())
+}
+
+func TestDefaultClassifier(t *testing.T) {
+	t.Parallel()
+
+	cases := map[codes.Code]grpcx.ErrorClass{
+		codes.OK:                grpcx.Success,
+		codes.Unavailable:       grpcx.Transient,
+		codes.ResourceExhausted: grpcx.Transient,
+		codes.Aborted:           grpcx.Transient,
+		codes.DeadlineExceeded:  grpcx.Permanent,
+		codes.InvalidArgument:   grpcx.Permanent,
+		codes.NotFound:          grpcx.Permanent,
+		codes.Internal:          grpcx.Permanent,
+	}
+
+	for code, want := range cases {
+		assert.Equalf(t, want, grpcx.DefaultClassifier(code), "code %s", code)
+	}
+}
+
+func TestCustomClassifierOverridesDefault(t *testing.T) {
+	t.Parallel()
+
+	srv := &scriptedHealth{script: []codes.Code{codes.Internal}}
+	conn := dial(t, srv, grpcx.NewUnaryClientInterceptor("health",
+		func(code codes.Code) grpcx.ErrorClass {
+			if code == codes.Internal {
+				return grpcx.Transient
+			}
+
+			return grpcx.DefaultClassifier(code)
+		},
+		r8e.WithRetry(2, r8e.ConstantBackoff(time.Millisecond)),
+	))
+
+	_, err := check(conn)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), srv.calls.Load())
+}
@@ -1,6 +1,10 @@
 package r8e
 
-import "slices"
+import (
+	"fmt"
+	"slices"
+	"strconv"
+)
 
 // ---------------------------------------------------------------------------
 // HealthReporter interface
@@ -45,6 +49,11 @@ type (
 		// AffectsReadiness reports whether this policy gates Kubernetes
 		// readiness (see WithReadinessImpact). False by default.
 		AffectsReadiness bool `json:"affects_readiness"`
+		// Maintenance reports that the policy is in a planned maintenance
+		// window (see Policy.SetMaintenance): Criticality is forced to
+		// CriticalityNone and Healthy to true, while State and Conditions still
+		// describe what the patterns actually observe.
+		Maintenance bool `json:"maintenance,omitempty"`
 	}
 )
 
@@ -85,6 +94,10 @@ const (
 	// (degraded); the error budget is burning fast enough that it is rejecting a
 	// fraction of calls to preserve the budget for critical traffic.
 	ConditionSLOBurning Condition = "slo_burning"
+	// ConditionLoadShedding means the load shedder is shedding load (degraded);
+	// latency or the calls in flight exceed their threshold, so it is rejecting
+	// a fraction of calls to let the backend recover.
+	ConditionLoadShedding Condition = "load_shedding"
 	// ConditionConcurrencyBudgetExhausted means the concurrency budget is at its
 	// ceiling (degraded); retries/hedges are being shed but first attempts flow.
 	ConditionConcurrencyBudgetExhausted Condition = "concurrency_budget_exhausted"
@@ -109,6 +122,7 @@ var conditionSeverity = []struct {
 	{ConditionConcurrencyLimited, CriticalityDegraded},
 	{ConditionThrottling, CriticalityDegraded},
 	{ConditionSLOBurning, CriticalityDegraded},
+	{ConditionLoadShedding, CriticalityDegraded},
 	{ConditionRetryBudgetExhausted, CriticalityDegraded},
 	{ConditionConcurrencyBudgetExhausted, CriticalityDegraded},
 	{ConditionDependencyDegraded, CriticalityDegraded},
@@ -127,6 +141,44 @@ func (c Criticality) String() string {
 	}
 }
 
+// MarshalJSON encodes the criticality as its [Criticality.String] form, so a
+// readiness or health body reads "critical" rather than 2. The names are plain
+// ASCII, so quoting them is the whole encoding and the package keeps clear of
+// encoding/json (see doc.go).
+func (c Criticality) MarshalJSON() ([]byte, error) {
+	return strconv.AppendQuote(nil, c.String()), nil
+}
+
+// UnmarshalJSON decodes a criticality from its string form ("none",
+// "degraded", "critical") or, for bodies written before it was encoded as a
+// string, from its integer value.
+func (c *Criticality) UnmarshalJSON(data []byte) error {
+	name, err := strconv.Unquote(string(data))
+	if err != nil {
+		level, atoiErr := strconv.Atoi(string(data))
+		if atoiErr != nil {
+			return fmt.Errorf("criticality must be a string or an integer: %s", data)
+		}
+
+		*c = Criticality(level)
+
+		return nil
+	}
+
+	switch name {
+	case "none":
+		*c = CriticalityNone
+	case "degraded":
+		*c = CriticalityDegraded
+	case "critical":
+		*c = CriticalityCritical
+	default:
+		return fmt.Errorf("unknown criticality: %q", name)
+	}
+
+	return nil
+}
+
 // ---------------------------------------------------------------------------
 // HealthStatus on Policy[T]
 // ---------------------------------------------------------------------------.
@@ -147,6 +199,11 @@ func (p *Policy[T]) HealthStatus() PolicyStatus {
 		}
 	}
 
+	maintenance := p.maintenance.Load()
+	if maintenance {
+		worst = CriticalityNone
+	}
+
 	return PolicyStatus{
 		Name:             p.name,
 		State:            summarizeState(conditions),
@@ -155,9 +212,33 @@ func (p *Policy[T]) HealthStatus() PolicyStatus {
 		Criticality:      worst,
 		Healthy:          worst < CriticalityCritical,
 		AffectsReadiness: p.affectsReadiness,
+		Maintenance:      maintenance,
 	}
 }
 
+// SetMaintenance enables or disables maintenance mode. During a planned
+// maintenance window of a dependency, an opening breaker should not flip the
+// whole service to not-ready and page everyone: while maintenance is on,
+// HealthStatus reports CriticalityNone and Healthy (so neither readiness nor
+// dependants are affected) and sets Maintenance. The patterns themselves are
+// untouched — the breaker still opens and rejects, limits still apply — and
+// State and Conditions keep describing what is actually observed. Safe for
+// concurrent use; clearing it restores normal reporting immediately, and
+// either way the registry's readiness subscribers are notified (see
+// [Registry.Subscribe]).
+func (p *Policy[T]) SetMaintenance(on bool) {
+	p.maintenance.Store(on)
+
+	if p.registry != nil {
+		p.registry.notifyReadiness()
+	}
+}
+
+// InMaintenance reports whether maintenance mode is on (see SetMaintenance).
+func (p *Policy[T]) InMaintenance() bool {
+	return p.maintenance.Load()
+}
+
 // collectConditions inspects every stateful pattern and returns the active
 // degradations together with the resolved health of each declared dependency.
 func (p *Policy[T]) collectConditions() ([]Condition, []PolicyStatus) {
@@ -170,19 +251,20 @@ func (p *Policy[T]) collectConditions() ([]Condition, []PolicyStatus) {
 		}
 	}
 
-	// Rate limiter — degraded (not unhealthy on its own).
-	if p.rateLimiter != nil && p.rateLimiter.Saturated() {
+	// Rate limiter — degraded (not unhealthy on its own). With
+	// WithPartitionKey, any saturated partition counts.
+	if p.rateLimiters != nil && p.rateLimiters.anyPart((*RateLimiter).Saturated) {
 		conditions = append(conditions, ConditionRateLimited)
 	}
 
 	// Bulkhead — degraded (not unhealthy on its own).
-	if p.bulkhead != nil && p.bulkhead.Full() {
+	if p.bulkheads != nil && p.bulkheads.anyPart((*Bulkhead).Full) {
 		conditions = append(conditions, ConditionBulkheadFull)
 	}
 
 	// Bulkhead controlled-delay queue — degraded while the wait queue is
 	// persistently backed up and shedding (see [BulkheadCoDel]).
-	if p.bulkhead != nil && p.bulkhead.Overloaded() {
+	if p.bulkheads != nil && p.bulkheads.anyPart((*Bulkhead).Overloaded) {
 		conditions = append(conditions, ConditionBulkheadOverloaded)
 	}
 
@@ -201,6 +283,11 @@ func (p *Policy[T]) collectConditions() ([]Condition, []PolicyStatus) {
 		conditions = append(conditions, ConditionSLOBurning)
 	}
 
+	// Load shedder — degraded while latency or in-flight sheds calls.
+	if p.loadShedder != nil && p.loadShedder.Shedding() {
+		conditions = append(conditions, ConditionLoadShedding)
+	}
+
 	// Retry budget — degraded; retries are throttled but first attempts flow.
 	if p.retryBudget != nil && p.retryBudget.Exhausted() {
 		conditions = append(conditions, ConditionRetryBudgetExhausted)
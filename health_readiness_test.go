@@ -67,6 +67,44 @@ func TestReadinessImpactGates(t *testing.T) {
 	require.False(t, reg.CheckReadiness().Ready)
 }
 
+func TestReadinessReasonsNameTheCriticalPolicy(t *testing.T) {
+	t.Parallel()
+
+	reg := NewRegistry()
+	_ = NewPolicy[string]("healthy-gate",
+		WithClock(&stubClock{now: time.Now()}),
+		WithRegistry(reg),
+		WithReadinessImpact(),
+		WithCircuitBreaker(FailureThreshold(1), RecoveryTimeout(time.Hour)),
+	)
+	critical := NewPolicy[string]("critical-gate",
+		WithClock(&stubClock{now: time.Now()}),
+		WithRegistry(reg),
+		WithReadinessImpact(),
+		WithCircuitBreaker(FailureThreshold(1), RecoveryTimeout(time.Hour)),
+	)
+
+	status := reg.CheckReadiness()
+	require.True(t, status.Ready)
+	assert.Empty(t, status.Reasons)
+
+	openCircuit(t, critical)
+
+	status = reg.CheckReadiness()
+	require.False(t, status.Ready)
+	assert.Equal(t, []string{"critical-gate: circuit_open"}, status.Reasons)
+
+	// An un-gated critical policy is reported in Policies but is no reason.
+	ungated := NewPolicy[string]("critical-no-gate",
+		WithClock(&stubClock{now: time.Now()}),
+		WithRegistry(reg),
+		WithCircuitBreaker(FailureThreshold(1), RecoveryTimeout(time.Hour)),
+	)
+	openCircuit(t, ungated)
+
+	assert.Equal(t, []string{"critical-gate: circuit_open"}, reg.CheckReadiness().Reasons)
+}
+
 func TestRegistryHealthAggregation(t *testing.T) {
 	t.Parallel()
 
@@ -269,6 +307,7 @@ func TestConditionSeverityComplete(t *testing.T) {
 		ConditionConcurrencyLimited,
 		ConditionThrottling,
 		ConditionSLOBurning,
+		ConditionLoadShedding,
 		ConditionRetryBudgetExhausted,
 		ConditionConcurrencyBudgetExhausted,
 		ConditionDependencyDegraded,
@@ -334,3 +373,134 @@ func TestRegistryHealthHalfOpenIsHealthy(t *testing.T) {
 	require.Equal(t, ConditionCircuitHalfOpen, p.HealthStatus().State)
 	assert.Equal(t, HealthHealthy, reg.Health().Status)
 }
+
+// ---------------------------------------------------------------------------
+// Maintenance mode
+// ---------------------------------------------------------------------------
+
+// TestMaintenanceKeepsReadiness pins that a readiness-gating policy with an
+// open breaker stays ready while in maintenance — yet still rejects calls —
+// and gates readiness again once maintenance is cleared.
+func TestMaintenanceKeepsReadiness(t *testing.T) {
+	t.Parallel()
+
+	reg := NewRegistry()
+	p := NewPolicy[string]("maint",
+		WithClock(&stubClock{now: time.Now()}),
+		WithRegistry(reg),
+		WithReadinessImpact(),
+		WithCircuitBreaker(FailureThreshold(1), RecoveryTimeout(time.Hour)),
+	)
+
+	openCircuit(t, p)
+	require.False(t, reg.CheckReadiness().Ready)
+
+	p.SetMaintenance(true)
+	require.True(t, p.InMaintenance())
+
+	status := reg.CheckReadiness()
+	require.True(t, status.Ready, "maintenance must keep the pod in rotation")
+	require.Len(t, status.Policies, 1)
+
+	ps := status.Policies[0]
+	assert.True(t, ps.Maintenance)
+	assert.True(t, ps.Healthy)
+	assert.Equal(t, CriticalityNone, ps.Criticality)
+	assert.Equal(t, ConditionCircuitOpen, ps.State, "State still reports the breaker")
+	assert.Equal(t, HealthHealthy, reg.Health().Status)
+
+	// The breaker keeps enforcing during maintenance.
+	_, err := p.Do(context.Background(), func(_ context.Context) (string, error) {
+		return "ok", nil
+	})
+	require.ErrorIs(t, err, ErrCircuitOpen)
+
+	p.SetMaintenance(false)
+	require.False(t, p.InMaintenance())
+
+	status = reg.CheckReadiness()
+	require.False(t, status.Ready)
+	assert.False(t, status.Policies[0].Maintenance)
+	assert.Equal(t, CriticalityCritical, status.Policies[0].Criticality)
+}
+
+// TestMaintenanceMasksDependency pins that a dependency in maintenance does not
+// degrade the policies that declared it.
+func TestMaintenanceMasksDependency(t *testing.T) {
+	t.Parallel()
+
+	reg := NewRegistry()
+	dep := NewPolicy[string]("maint-dep",
+		WithClock(&stubClock{now: time.Now()}),
+		WithRegistry(reg),
+		WithCircuitBreaker(FailureThreshold(1), RecoveryTimeout(time.Hour)),
+	)
+	parent := NewPolicy[string]("maint-parent",
+		WithRegistry(reg),
+		DependsOn(dep),
+	)
+
+	openCircuit(t, dep)
+	require.Contains(t, parent.HealthStatus().Conditions, ConditionDependencyDegraded)
+
+	dep.SetMaintenance(true)
+	assert.NotContains(t, parent.HealthStatus().Conditions, ConditionDependencyDegraded)
+}
+
+func TestCheckReadinessFiltered(t *testing.T) {
+	t.Parallel()
+
+	reg := NewRegistry()
+	_ = NewPolicy[string]("healthy",
+		WithRegistry(reg),
+		WithReadinessImpact(),
+		WithCircuitBreaker(),
+	)
+	degraded := NewPolicy[string]("degraded",
+		WithClock(&stubClock{now: time.Now()}),
+		WithRegistry(reg),
+		WithReadinessImpact(),
+		WithRateLimit(1),
+	)
+	_, _ = degraded.Do(context.Background(), func(_ context.Context) (string, error) {
+		return "ok", nil
+	}) // consumes the only token → saturated
+
+	names := func(status ReadinessStatus) []string {
+		out := make([]string, 0, len(status.Policies))
+		for _, ps := range status.Policies {
+			out = append(out, ps.Name)
+		}
+
+		return out
+	}
+
+	status := reg.CheckReadinessFiltered(CriticalityDegraded)
+	assert.Equal(t, []string{"degraded"}, names(status))
+	assert.True(t, status.Ready, "a degraded-only policy must not flip readiness")
+	assert.Empty(t, status.Reasons)
+
+	assert.Empty(t, reg.CheckReadinessFiltered(CriticalityCritical).Policies)
+
+	crit := NewPolicy[string]("critical",
+		WithClock(&stubClock{now: time.Now()}),
+		WithRegistry(reg),
+		WithReadinessImpact(),
+		WithCircuitBreaker(FailureThreshold(1), RecoveryTimeout(time.Hour)),
+	)
+	openCircuit(t, crit)
+
+	status = reg.CheckReadinessFiltered(CriticalityDegraded)
+	assert.Equal(t, []string{"degraded", "critical"}, names(status))
+	assert.False(t, status.Ready)
+	assert.Equal(t, []string{"critical: circuit_open"}, status.Reasons)
+
+	status = reg.CheckReadinessFiltered(CriticalityCritical)
+	assert.Equal(t, []string{"critical"}, names(status))
+	assert.False(t, status.Ready)
+
+	// The unfiltered check lists everything and reaches the same verdict.
+	status = reg.CheckReadinessFiltered(CriticalityNone)
+	assert.Equal(t, []string{"healthy", "degraded", "critical"}, names(status))
+	assert.Equal(t, reg.CheckReadiness(), status)
+}
@@ -2,6 +2,7 @@ package r8e
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"testing"
 	"time"
@@ -37,6 +38,58 @@ func TestCriticalityString(t *testing.T) {
 	}
 }
 
+// ---------------------------------------------------------------------------
+// Criticality JSON encoding
+// ---------------------------------------------------------------------------
+
+func TestCriticalityJSONRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	status := ReadinessStatus{
+		Policies: []PolicyStatus{{
+			Name:        "payments",
+			State:       "circuit_open",
+			Criticality: CriticalityCritical,
+		}},
+		Reasons: []string{"payments: circuit_open"},
+	}
+
+	body, err := json.Marshal(status)
+	require.NoError(t, err)
+	assert.Contains(t, string(body), `"criticality":"critical"`)
+
+	var decoded ReadinessStatus
+	require.NoError(t, json.Unmarshal(body, &decoded))
+	assert.Equal(t, status, decoded)
+}
+
+func TestCriticalityUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		in   string
+		want Criticality
+	}{
+		{`"none"`, CriticalityNone},
+		{`"degraded"`, CriticalityDegraded},
+		{`"critical"`, CriticalityCritical},
+		// Bodies encoded before criticality was a string still parse.
+		{`0`, CriticalityNone},
+		{`1`, CriticalityDegraded},
+		{`2`, CriticalityCritical},
+	}
+
+	for _, tt := range tests {
+		var c Criticality
+		require.NoError(t, json.Unmarshal([]byte(tt.in), &c), tt.in)
+		assert.Equal(t, tt.want, c, tt.in)
+	}
+
+	var c Criticality
+	require.ErrorContains(t, json.Unmarshal([]byte(`"fatal"`), &c), `unknown criticality: "fatal"`)
+	require.Error(t, json.Unmarshal([]byte(`true`), &c))
+}
+
 // ---------------------------------------------------------------------------
 // TestHealthyPolicyNoPatterns — Policy with no patterns reports healthy
 // ---------------------------------------------------------------------------
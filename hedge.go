@@ -2,19 +2,23 @@ package r8e
 
 import (
 	"context"
+	"fmt"
 	"sync/atomic"
 	"time"
 )
 
 // Pattern: Hedged Request — after a delay, fire a second concurrent attempt.
 // The first response wins; the other is cancelled. This reduces tail latency
-// by racing redundant requests.
+// by racing redundant requests. With a selector, a first success instead waits
+// a bounded time for the other attempt and the selector picks between them.
 
 type (
-	// hedgeResult holds the outcome of a hedged call attempt.
+	// hedgeResult holds the outcome of a hedged call attempt and how long it
+	// took from its own start.
 	hedgeResult[T any] struct {
 		val       T
 		err       error
+		latency   time.Duration
 		isPrimary bool
 	}
 
@@ -25,6 +29,10 @@ type (
 	HedgeParams struct {
 		Clock Clock
 		Hooks *Hooks
+		// ShouldHedge, when non-nil, is consulted once the delay has elapsed
+		// with the primary's elapsed time; returning false skips the hedge and
+		// just waits for the primary (see [WithHedgeIf]).
+		ShouldHedge func(elapsed time.Duration) bool
 		// RecordPrimary, when non-nil, is called with the primary attempt's elapsed
 		// time and error once it completes (used by the adaptive hedge delay to feed
 		// its percentile window). It records the PRIMARY only — never the hedge — so
@@ -32,8 +40,19 @@ type (
 		// primary's error is passed through so the recorder can drop non-successes
 		// (a hedge that wins cancels the primary, whose error then filters it out).
 		RecordPrimary func(elapsed time.Duration, err error)
-		Budget        *ConcurrencyBudget
-		Delay         time.Duration
+		// Selector, when non-nil, must be a func(a, b T) T for the T of the
+		// DoHedge call — a mismatch panics with an error wrapping
+		// [ErrHedgeSelectorTypeMismatch]. Once the hedge has fired, the first
+		// success no longer wins outright: DoHedge waits up to SelectWait for
+		// the other attempt and, if it succeeds too, returns
+		// Selector(primary, hedge). See [HedgeSelect].
+		Selector any
+		// SelectWait bounds how long a first success waits for the other
+		// attempt when Selector is set; a non-positive value waits the hedge
+		// Delay.
+		SelectWait time.Duration
+		Budget     *ConcurrencyBudget
+		Delay      time.Duration
 	}
 
 	// HedgeOption configures the hedge pattern built by [WithHedge].
@@ -47,7 +66,10 @@ type (
 	// builds the hedge middleware. adaptive is non-nil once [AdaptiveHedge] was
 	// passed.
 	hedgeConfig struct {
-		adaptive *adaptiveHedgeConfig
+		adaptive   *adaptiveHedgeConfig
+		predicate  func(elapsed time.Duration) bool
+		selector   any
+		selectWait time.Duration
 	}
 
 	// AdaptiveHedgeOption configures percentile-driven adaptive hedge delay (see
@@ -94,6 +116,11 @@ type (
 )
 
 const (
+	// hedgeLegPrimary and hedgeLegHedge number the legs of a hedged call as
+	// reported by [Hooks.OnHedgeResult].
+	hedgeLegPrimary = 0
+	hedgeLegHedge   = 1
+
 	// defaultAdaptiveHedgePercentile is the latency percentile the adaptive hedge
 	// fires at by default — p95, the classic "hedge the slowest 5%" threshold from
 	// Google's tail-at-scale hedging, which keeps the added redundant load small.
@@ -109,9 +136,56 @@ const (
 	defaultAdaptiveHedgeMinSamples = 20
 )
 
+// HedgeSelect makes a hedge pick the better of two successful results instead
+// of the first one back: once the hedge has fired, the first success waits up to
+// maxWait for the other attempt, and if both succeed the call returns
+// selector(primary, hedge) — say, whichever payload is fresher. If the other
+// attempt fails, or is still running when maxWait elapses (it is then
+// cancelled), the first success is returned as usual. A non-positive maxWait
+// waits the hedge delay. Without a hedge firing — the primary answered within
+// the delay — there is nothing to choose from and the primary's result is
+// returned.
+//
+// OnHedgeWon keeps meaning "the hedge answered before the primary": it fires
+// when the hedge's success arrives first, whichever result the selector then
+// prefers, and never when the primary's does.
+//
+// The selector's type must match the policy's result type T; a mismatch panics
+// in [NewPolicy] with an error wrapping [ErrHedgeSelectorTypeMismatch].
+func HedgeSelect[T any](selector func(primary, hedge T) T, maxWait time.Duration) HedgeOption {
+	return func(cfg *hedgeConfig) {
+		cfg.selector = selector
+		cfg.selectWait = maxWait
+	}
+}
+
+// hedgeSelector returns params.Selector as a func(a, b T) T, nil when unset. It
+// panics with [ErrHedgeSelectorTypeMismatch] when the selector is typed for
+// another result.
+func hedgeSelector[T any](params *HedgeParams) func(a, b T) T {
+	if params.Selector == nil {
+		return nil
+	}
+
+	selector, ok := params.Selector.(func(a, b T) T)
+	if !ok {
+		var zero T
+
+		panic(fmt.Errorf(
+			"%w: hedge selector has type %T, result type is %T",
+			ErrHedgeSelectorTypeMismatch, params.Selector, zero,
+		))
+	}
+
+	return selector
+}
+
 // DoHedge executes fn and, if it hasn't completed after delay, fires a second
-// concurrent attempt. The first response wins; the other is cancelled. A nil
-// params.Clock defaults to [RealClock]; a nil params.Hooks is a no-op.
+// concurrent attempt. The first response wins; the other is cancelled — unless
+// params.Selector is set, in which case a first success waits a bounded time
+// for the other attempt and the selector picks between them (see
+// [HedgeParams]). A nil params.Clock defaults to [RealClock]; a nil
+// params.Hooks is a no-op.
 //
 //nolint:ireturn // generic type parameter T, not an interface
 func DoHedge[T any](
@@ -125,6 +199,8 @@ func DoHedge[T any](
 		params.Clock = RealClock{}
 	}
 
+	selector := hedgeSelector[T](&params)
+
 	// If the parent context is already done, return its error immediately.
 	if ctx.Err() != nil {
 		return zero, ctx.Err() //nolint:wrapcheck // preserving context error identity
@@ -141,6 +217,7 @@ func DoHedge[T any](
 
 	go func() {
 		val, err := fn(primaryCtx)
+		latency := params.Clock.Since(primaryStart)
 
 		// Record the primary's latency BEFORE sending the result. The channel
 		// send/receive then establishes happens-before, so a caller that receives
@@ -149,10 +226,12 @@ func DoHedge[T any](
 		// cancelled (hedge lost the race) or failed primary carries a non-nil err
 		// the recorder drops, so only genuine primary completions feed the window.
 		if params.RecordPrimary != nil {
-			params.RecordPrimary(params.Clock.Since(primaryStart), err)
+			params.RecordPrimary(latency, err)
 		}
 
-		results <- hedgeResult[T]{val: val, err: err, isPrimary: true}
+		params.Hooks.emitHedgeResult(hedgeLegPrimary, err)
+
+		results <- hedgeResult[T]{val: val, err: err, latency: latency, isPrimary: true}
 	}()
 
 	// Start a timer for the hedge delay.
@@ -171,9 +250,16 @@ func DoHedge[T any](
 		return result.val, nil
 
 	case <-timer.C():
-		// Delay elapsed; primary is still running. Skip the hedge if the total
-		// time budget is spent — a second request cannot help within it — and
-		// just wait for the primary.
+		// Delay elapsed; primary is still running. Skip the hedge when the
+		// caller's predicate declines it — the primary is slow for a reason a
+		// second request would not fix — and just wait for the primary.
+		if params.ShouldHedge != nil && !params.ShouldHedge(params.Clock.Since(primaryStart)) {
+			//nolint:wrapcheck // primary/context error returned as-is
+			return waitForPrimary(ctx, results)
+		}
+
+		// Skip it too if the total time budget is spent — a second request
+		// cannot help within it.
 		if remaining, ok := timeBudgetRemaining(ctx, params.Clock); ok && remaining <= 0 {
 			//nolint:wrapcheck // primary/context error returned as-is
 			return waitForPrimary(ctx, results)
@@ -193,17 +279,28 @@ func DoHedge[T any](
 
 		// Fire hedge.
 		params.Hooks.emitHedgeTriggered()
+		markTrace(ctx, traceHedged)
 
 		hedgeCtx, hedgeCancel := context.WithCancel(ctx)
 		defer hedgeCancel()
 
+		hedgeStart := params.Clock.Now()
+
 		go func() {
 			defer params.Budget.release()
 
 			v, err := fn(hedgeCtx)
-			results <- hedgeResult[T]{val: v, err: err, isPrimary: false}
+			latency := params.Clock.Since(hedgeStart)
+			params.Hooks.emitHedgeResult(hedgeLegHedge, err)
+
+			results <- hedgeResult[T]{val: v, err: err, latency: latency, isPrimary: false}
 		}()
 
+		if selector != nil {
+			//nolint:wrapcheck // internal delegation
+			return waitForBest(ctx, results, primaryCancel, hedgeCancel, params, selector)
+		}
+
 		// Now wait for first completion from either goroutine.
 		//nolint:wrapcheck // internal delegation
 		return waitForResults(
@@ -259,12 +356,7 @@ func waitForResults[T any](
 	case result := <-results:
 		if result.err == nil {
 			// Success: cancel the loser.
-			if result.isPrimary {
-				hedgeCancel()
-			} else {
-				primaryCancel()
-				hooks.emitHedgeWon()
-			}
+			claimHedgeWin(ctx, result, primaryCancel, hedgeCancel, hooks)
 
 			return result.val, nil
 		}
@@ -274,12 +366,7 @@ func waitForResults[T any](
 		case r2 := <-results:
 			if r2.err == nil {
 				// Second attempt succeeded.
-				if r2.isPrimary {
-					hedgeCancel()
-				} else {
-					primaryCancel()
-					hooks.emitHedgeWon()
-				}
+				claimHedgeWin(ctx, r2, primaryCancel, hedgeCancel, hooks)
 
 				return r2.val, nil
 			}
@@ -295,6 +382,120 @@ func waitForResults[T any](
 	}
 }
 
+// claimHedgeWin settles a race won by winner: OnHedgeWonDetail reports it, the
+// loser is cancelled and, when the hedge won, OnHedgeWon fires and the call is
+// marked degraded.
+func claimHedgeWin[T any](
+	ctx context.Context,
+	winner hedgeResult[T],
+	primaryCancel, hedgeCancel context.CancelFunc,
+	hooks *Hooks,
+) {
+	hooks.emitHedgeWonDetail(winner.leg(), winner.latency)
+
+	if winner.isPrimary {
+		hedgeCancel()
+
+		return
+	}
+
+	primaryCancel()
+	hooks.emitHedgeWon()
+	markTrace(ctx, traceDegraded|traceHedgeWon)
+}
+
+// leg returns the number of the attempt r came from, as reported by
+// [Hooks.OnHedgeResult].
+func (r *hedgeResult[T]) leg() int {
+	if r.isPrimary {
+		return hedgeLegPrimary
+	}
+
+	return hedgeLegHedge
+}
+
+// waitForBest is waitForResults for a hedge with a selector. A first failure
+// defers to the other attempt as usual; a first success waits up to the select
+// window for the other, returning selector(primary, hedge) when both succeed
+// and the first success otherwise. OnHedgeWon fires when the hedge's success
+// came first, and OnHedgeWonDetail reports that first success, so both report
+// the same race whether or not the selector then prefers the other result. A
+// ctx cancelled during the window returns the success already in hand.
+//
+//nolint:ireturn,revive // generic type parameter T; argument count justified
+func waitForBest[T any](
+	ctx context.Context,
+	results chan hedgeResult[T],
+	primaryCancel, hedgeCancel context.CancelFunc,
+	params HedgeParams,
+	selector func(a, b T) T,
+) (T, error) {
+	var first hedgeResult[T]
+
+	select {
+	case first = <-results:
+	case <-ctx.Done():
+		var zero T
+
+		return zero, ctx.Err() //nolint:wrapcheck // preserving context error identity
+	}
+
+	if first.err != nil {
+		select {
+		case second := <-results:
+			if second.err != nil {
+				var zero T
+
+				return zero, first.err // both failed: the first error
+			}
+
+			claimHedgeWin(ctx, second, primaryCancel, hedgeCancel, params.Hooks)
+
+			return second.val, nil
+
+		case <-ctx.Done():
+			var zero T
+
+			return zero, ctx.Err() //nolint:wrapcheck // preserving context error identity
+		}
+	}
+
+	wait := params.SelectWait
+	if wait <= 0 {
+		wait = params.Delay
+	}
+
+	timer := params.Clock.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case second := <-results:
+		if second.err != nil {
+			claimHedgeWin(ctx, first, primaryCancel, hedgeCancel, params.Hooks)
+
+			return first.val, nil
+		}
+
+		params.Hooks.emitHedgeWonDetail(first.leg(), first.latency)
+
+		if !first.isPrimary {
+			params.Hooks.emitHedgeWon()
+			markTrace(ctx, traceDegraded|traceHedgeWon)
+
+			return selector(second.val, first.val), nil
+		}
+
+		return selector(first.val, second.val), nil
+
+	case <-timer.C():
+	case <-ctx.Done():
+	}
+
+	claimHedgeWin(ctx, first, primaryCancel, hedgeCancel, params.Hooks)
+
+	return first.val, nil
+}
+
 // ---------------------------------------------------------------------------
 // Adaptive hedge delay — fire the hedge at an observed latency percentile
 // ---------------------------------------------------------------------------.
@@ -3,6 +3,7 @@ package r8e_test
 import (
 	"context"
 	"errors"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"testing/synctest"
@@ -278,6 +279,244 @@ func TestDoHedgePrimaryErrorHedgeSucceeds(t *testing.T) {
 	})
 }
 
+// ---------------------------------------------------------------------------
+// OnHedgeResult reports each leg as it completes
+// ---------------------------------------------------------------------------
+
+// legResult is one OnHedgeResult event.
+type legResult struct {
+	leg int
+	err error
+}
+
+// legRecorder collects OnHedgeResult events, which fire from the legs'
+// goroutines.
+type legRecorder struct {
+	mu     sync.Mutex
+	events []legResult
+}
+
+func (r *legRecorder) record(leg int, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.events = append(r.events, legResult{leg: leg, err: err})
+}
+
+func (r *legRecorder) snapshot() []legResult {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]legResult(nil), r.events...)
+}
+
+func TestDoHedgeResultPrimaryErrorHedgeSucceeds(t *testing.T) {
+	t.Parallel()
+
+	synctest.Test(t, func(t *testing.T) {
+		var (
+			rec       legRecorder
+			callCount atomic.Int32
+		)
+
+		errPrimary := errors.New("primary failed")
+
+		result, err := r8e.DoHedge[string](
+			context.Background(),
+			func(_ context.Context) (string, error) {
+				if callCount.Add(1) == 1 {
+					time.Sleep(40 * time.Millisecond)
+
+					return "", errPrimary
+				}
+
+				time.Sleep(40 * time.Millisecond)
+
+				return "hedge-ok", nil
+			},
+			r8e.HedgeParams{
+				Delay: 20 * time.Millisecond,
+				Hooks: &r8e.Hooks{OnHedgeResult: rec.record},
+				Clock: r8e.RealClock{},
+			},
+		)
+		require.NoError(t, err)
+		require.Equal(t, "hedge-ok", result)
+		assert.Equal(t, []legResult{
+			{leg: 0, err: errPrimary},
+			{leg: 1, err: nil},
+		}, rec.snapshot())
+	})
+}
+
+func TestDoHedgeResultBothLegsFail(t *testing.T) {
+	t.Parallel()
+
+	synctest.Test(t, func(t *testing.T) {
+		var (
+			rec       legRecorder
+			callCount atomic.Int32
+		)
+
+		errPrimary := errors.New("primary error")
+		errHedge := errors.New("hedge error")
+
+		_, err := r8e.DoHedge[string](
+			context.Background(),
+			func(_ context.Context) (string, error) {
+				if callCount.Add(1) == 1 {
+					time.Sleep(40 * time.Millisecond)
+
+					return "", errPrimary
+				}
+
+				return "", errHedge
+			},
+			r8e.HedgeParams{
+				Delay: 20 * time.Millisecond,
+				Hooks: &r8e.Hooks{OnHedgeResult: rec.record},
+				Clock: r8e.RealClock{},
+			},
+		)
+		require.ErrorIs(t, err, errHedge, "the first error received is returned")
+		assert.Equal(t, []legResult{
+			{leg: 1, err: errHedge},
+			{leg: 0, err: errPrimary},
+		}, rec.snapshot())
+	})
+}
+
+func TestDoHedgeResultPrimaryOnlyWithoutHedge(t *testing.T) {
+	t.Parallel()
+
+	synctest.Test(t, func(t *testing.T) {
+		var rec legRecorder
+
+		_, err := r8e.DoHedge[string](
+			context.Background(),
+			func(_ context.Context) (string, error) {
+				return "primary", nil
+			},
+			r8e.HedgeParams{
+				Delay: time.Hour,
+				Hooks: &r8e.Hooks{OnHedgeResult: rec.record},
+				Clock: r8e.RealClock{},
+			},
+		)
+		require.NoError(t, err)
+		assert.Equal(t, []legResult{{leg: 0, err: nil}}, rec.snapshot())
+	})
+}
+
+// ---------------------------------------------------------------------------
+// OnHedgeWonDetail — the winning leg and its own latency
+// ---------------------------------------------------------------------------
+
+// winDetail is one OnHedgeWonDetail call.
+type winDetail struct {
+	leg     int
+	latency time.Duration
+}
+
+// racingLegs returns a function whose first call (the primary) answers after
+// primary and whose second (the hedge) answers after hedge, each giving up
+// when cancelled.
+func racingLegs(primary, hedge time.Duration) func(context.Context) (string, error) {
+	var calls atomic.Int32
+
+	return func(ctx context.Context) (string, error) {
+		leg, d := "primary", primary
+		if calls.Add(1) > 1 {
+			leg, d = "hedge", hedge
+		}
+
+		select {
+		case <-time.After(d):
+			return leg, nil
+		case <-ctx.Done():
+			return "", ctx.Err()
+		}
+	}
+}
+
+func TestDoHedgeWonDetail(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		primary, hedge time.Duration
+		want           string
+		details        []winDetail
+	}{
+		{
+			name:    "hedge wins",
+			primary: 5 * time.Second,
+			hedge:   10 * time.Millisecond,
+			want:    "hedge",
+			details: []winDetail{{leg: 1, latency: 10 * time.Millisecond}},
+		},
+		{
+			name:    "primary wins the race",
+			primary: 40 * time.Millisecond,
+			hedge:   5 * time.Second,
+			want:    "primary",
+			details: []winDetail{{leg: 0, latency: 40 * time.Millisecond}},
+		},
+		{
+			name:    "no hedge fired",
+			primary: 5 * time.Millisecond,
+			hedge:   time.Second,
+			want:    "primary",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			synctest.Test(t, func(t *testing.T) {
+				var details []winDetail
+
+				result, err := r8e.DoHedge[string](
+					context.Background(),
+					racingLegs(tt.primary, tt.hedge),
+					r8e.HedgeParams{
+						Delay: 20 * time.Millisecond,
+						Hooks: &r8e.Hooks{OnHedgeWonDetail: func(leg int, latency time.Duration) {
+							details = append(details, winDetail{leg: leg, latency: latency})
+						}},
+						Clock: r8e.RealClock{},
+					},
+				)
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, result)
+				assert.Equal(t, tt.details, details)
+			})
+		})
+	}
+}
+
+func TestWithHedgeWonDetail(t *testing.T) {
+	t.Parallel()
+
+	synctest.Test(t, func(t *testing.T) {
+		var details []winDetail
+
+		p := r8e.NewPolicy[string]("hedge-detail",
+			r8e.WithHedge(20*time.Millisecond),
+			r8e.WithHooks(&r8e.Hooks{OnHedgeWonDetail: func(leg int, latency time.Duration) {
+				details = append(details, winDetail{leg: leg, latency: latency})
+			}}),
+		)
+
+		result, err := p.Do(context.Background(), racingLegs(5*time.Second, 15*time.Millisecond))
+		require.NoError(t, err)
+		assert.Equal(t, "hedge", result)
+		assert.Equal(t, []winDetail{{leg: 1, latency: 15 * time.Millisecond}}, details)
+		assert.Equal(t, int64(1), p.Metrics().HedgesWon)
+	})
+}
+
 // ---------------------------------------------------------------------------
 // Primary fails fast (before hedge delay) -> returns error, no hedge
 // ---------------------------------------------------------------------------
@@ -612,6 +851,133 @@ func TestDoHedgeNilRecordPrimaryNoPanic(t *testing.T) {
 	})
 }
 
+// ---------------------------------------------------------------------------
+// ShouldHedge / WithHedgeIf
+// ---------------------------------------------------------------------------
+
+func TestDoHedgePredicateDeclines(t *testing.T) {
+	t.Parallel()
+
+	synctest.Test(t, func(t *testing.T) {
+		var (
+			calls     atomic.Int32
+			triggered atomic.Bool
+			elapsed   time.Duration
+		)
+
+		result, err := r8e.DoHedge[string](
+			context.Background(),
+			func(_ context.Context) (string, error) {
+				calls.Add(1)
+				time.Sleep(100 * time.Millisecond) // well past the delay
+
+				return "primary", nil
+			},
+			r8e.HedgeParams{
+				Delay: 20 * time.Millisecond,
+				Hooks: &r8e.Hooks{OnHedgeTriggered: func() { triggered.Store(true) }},
+				Clock: r8e.RealClock{},
+				ShouldHedge: func(d time.Duration) bool {
+					elapsed = d
+
+					return false
+				},
+			},
+		)
+		require.NoError(t, err)
+		assert.Equal(t, "primary", result)
+		assert.Equal(t, int32(1), calls.Load(), "no second call")
+		assert.False(t, triggered.Load(), "OnHedgeTriggered must not fire")
+		assert.Equal(t, 20*time.Millisecond, elapsed, "consulted at the delay tick")
+	})
+}
+
+func TestDoHedgePredicateAllows(t *testing.T) {
+	t.Parallel()
+
+	synctest.Test(t, func(t *testing.T) {
+		var calls atomic.Int32
+
+		result, err := r8e.DoHedge[string](
+			context.Background(),
+			func(ctx context.Context) (string, error) {
+				if calls.Add(1) == 1 {
+					<-ctx.Done()
+
+					return "", ctx.Err()
+				}
+
+				return "hedge", nil
+			},
+			r8e.HedgeParams{
+				Delay:       20 * time.Millisecond,
+				Clock:       r8e.RealClock{},
+				ShouldHedge: func(time.Duration) bool { return true },
+			},
+		)
+		require.NoError(t, err)
+		assert.Equal(t, "hedge", result)
+	})
+}
+
+func TestWithHedgeIfDeclinedTakesNoBudget(t *testing.T) {
+	t.Parallel()
+
+	synctest.Test(t, func(t *testing.T) {
+		var (
+			calls     atomic.Int32
+			triggered atomic.Int32
+			exceeded  atomic.Int32
+		)
+
+		p := r8e.NewPolicy[string]("",
+			r8e.WithHooks(&r8e.Hooks{
+				OnHedgeTriggered:            func() { triggered.Add(1) },
+				OnConcurrencyBudgetExceeded: func() { exceeded.Add(1) },
+			}),
+			r8e.WithHedgeIf(20*time.Millisecond, func(time.Duration) bool { return false }),
+		)
+
+		result, err := p.Do(context.Background(), func(_ context.Context) (string, error) {
+			calls.Add(1)
+			time.Sleep(time.Second)
+
+			return "primary", nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "primary", result)
+		assert.Equal(t, int32(1), calls.Load())
+		assert.Zero(t, triggered.Load())
+		assert.Zero(t, exceeded.Load())
+	})
+}
+
+func TestWithHedgeResetsPredicate(t *testing.T) {
+	t.Parallel()
+
+	synctest.Test(t, func(t *testing.T) {
+		var calls atomic.Int32
+
+		// A later WithHedge replaces the gated hedge entirely.
+		p := r8e.NewPolicy[string]("",
+			r8e.WithHedgeIf(20*time.Millisecond, func(time.Duration) bool { return false }),
+			r8e.WithHedge(20*time.Millisecond),
+		)
+
+		_, err := p.Do(context.Background(), func(ctx context.Context) (string, error) {
+			if calls.Add(1) == 1 {
+				<-ctx.Done()
+
+				return "", ctx.Err()
+			}
+
+			return "hedge", nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), calls.Load())
+	})
+}
+
 // ---------------------------------------------------------------------------
 // Benchmark
 // ---------------------------------------------------------------------------
@@ -634,3 +1000,186 @@ func BenchmarkDoHedge(b *testing.B) {
 		)
 	}
 }
+
+// ---------------------------------------------------------------------------
+// Selector — pick the better of two successes instead of the first
+// ---------------------------------------------------------------------------
+
+// versioned is a hedged result whose freshness the selector compares.
+type versioned struct {
+	source  string
+	version int
+}
+
+// fresher prefers the higher version.
+func fresher(primary, hedge versioned) versioned {
+	if hedge.version > primary.version {
+		return hedge
+	}
+
+	return primary
+}
+
+// TestDoHedgeSelectorPrefersSlowerResult: the hedge answers first with an older
+// version, the primary answers later with a newer one, and the selector returns
+// the primary's. OnHedgeWon still fires — the hedge did answer first.
+func TestDoHedgeSelectorPrefersSlowerResult(t *testing.T) {
+	t.Parallel()
+
+	synctest.Test(t, func(t *testing.T) {
+		var hedgeWon atomic.Int32
+
+		var calls atomic.Int32
+
+		start := time.Now()
+		result, err := r8e.DoHedge[versioned](
+			context.Background(),
+			func(_ context.Context) (versioned, error) {
+				if calls.Add(1) == 1 {
+					time.Sleep(50 * time.Millisecond)
+
+					return versioned{source: "primary", version: 2}, nil
+				}
+
+				time.Sleep(10 * time.Millisecond)
+
+				return versioned{source: "hedge", version: 1}, nil
+			},
+			r8e.HedgeParams{
+				Delay:      20 * time.Millisecond,
+				Hooks:      &r8e.Hooks{OnHedgeWon: func() { hedgeWon.Add(1) }},
+				Clock:      r8e.RealClock{},
+				Selector:   fresher,
+				SelectWait: 100 * time.Millisecond,
+			},
+		)
+
+		require.NoError(t, err)
+		assert.Equal(t, versioned{source: "primary", version: 2}, result)
+		assert.Equal(t, 50*time.Millisecond, time.Since(start), "waited for the primary")
+		assert.Equal(t, int32(1), hedgeWon.Load(), "the hedge answered first")
+	})
+}
+
+// TestDoHedgeSelectorPrimaryFirst: when the primary answers first, the selector
+// still sees both results and OnHedgeWon does not fire even if it picks the
+// hedge's.
+func TestDoHedgeSelectorPrimaryFirst(t *testing.T) {
+	t.Parallel()
+
+	synctest.Test(t, func(t *testing.T) {
+		var hedgeWon atomic.Int32
+
+		var calls atomic.Int32
+
+		result, err := r8e.DoHedge[versioned](
+			context.Background(),
+			func(_ context.Context) (versioned, error) {
+				if calls.Add(1) == 1 {
+					time.Sleep(30 * time.Millisecond)
+
+					return versioned{source: "primary", version: 1}, nil
+				}
+
+				time.Sleep(20 * time.Millisecond)
+
+				return versioned{source: "hedge", version: 3}, nil
+			},
+			r8e.HedgeParams{
+				Delay:    20 * time.Millisecond,
+				Hooks:    &r8e.Hooks{OnHedgeWon: func() { hedgeWon.Add(1) }},
+				Clock:    r8e.RealClock{},
+				Selector: fresher,
+			},
+		)
+
+		require.NoError(t, err)
+		assert.Equal(t, "hedge", result.source, "the selector prefers the fresher hedge")
+		assert.Zero(t, hedgeWon.Load(), "the primary answered first")
+	})
+}
+
+// TestDoHedgeSelectorWaitBounded: a first success does not wait past the select
+// window; the straggler is cancelled and the first success returned.
+func TestDoHedgeSelectorWaitBounded(t *testing.T) {
+	t.Parallel()
+
+	synctest.Test(t, func(t *testing.T) {
+		var primaryCancelled atomic.Bool
+
+		var calls atomic.Int32
+
+		start := time.Now()
+		result, err := r8e.DoHedge[versioned](
+			context.Background(),
+			func(ctx context.Context) (versioned, error) {
+				if calls.Add(1) == 1 {
+					select {
+					case <-time.After(time.Second):
+						return versioned{source: "primary", version: 9}, nil
+					case <-ctx.Done():
+						primaryCancelled.Store(true)
+
+						return versioned{}, ctx.Err()
+					}
+				}
+
+				time.Sleep(10 * time.Millisecond)
+
+				return versioned{source: "hedge", version: 1}, nil
+			},
+			r8e.HedgeParams{
+				Delay:      20 * time.Millisecond,
+				Hooks:      &r8e.Hooks{},
+				Clock:      r8e.RealClock{},
+				Selector:   fresher,
+				SelectWait: 15 * time.Millisecond,
+			},
+		)
+
+		require.NoError(t, err)
+		assert.Equal(t, "hedge", result.source)
+		assert.Equal(t, 45*time.Millisecond, time.Since(start))
+
+		synctest.Wait()
+		assert.True(t, primaryCancelled.Load(), "the straggler is cancelled")
+	})
+}
+
+// TestWithHedgeSelect wires the selector through a policy, and a selector typed
+// for another result panics in NewPolicy.
+func TestWithHedgeSelect(t *testing.T) {
+	t.Parallel()
+
+	synctest.Test(t, func(t *testing.T) {
+		p := r8e.NewPolicy[versioned]("hedge-select",
+			r8e.WithRegistry(r8e.NewRegistry()),
+			r8e.WithHedge(20*time.Millisecond, r8e.HedgeSelect(fresher, time.Second)),
+		)
+
+		var calls atomic.Int32
+
+		result, err := p.Do(context.Background(), func(_ context.Context) (versioned, error) {
+			if calls.Add(1) == 1 {
+				time.Sleep(50 * time.Millisecond)
+
+				return versioned{source: "primary", version: 2}, nil
+			}
+
+			return versioned{source: "hedge", version: 1}, nil
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "primary", result.source)
+	})
+
+	assert.PanicsWithError(t,
+		"hedge selector type does not match result type: hedge selector has type "+
+			"func(r8e_test.versioned, r8e_test.versioned) r8e_test.versioned, result type is string",
+		func() {
+			r8e.NewPolicy[string]("hedge-select-mismatch",
+				r8e.WithRegistry(r8e.NewRegistry()),
+				r8e.WithHedge(time.Millisecond, r8e.HedgeSelect(fresher, 0)),
+			)
+		})
+}
@@ -1,5 +1,7 @@
 package r8e
 
+import "time"
+
 // Hooks holds optional callback functions for resilience pattern lifecycle
 // events. All fields are nil by default; callers set only the hooks they care
 // about. A nil *Hooks is itself valid and behaves as a no-op, so every exported
@@ -7,7 +9,9 @@ package r8e
 // must not be mutated — emit methods read the function fields without
 // synchronisation, which is safe only because the struct is read-only after
 // initialisation (there is no runtime subscription, unlike a true Observer; it
-// is a plain optional-callback set).
+// is a plain optional-callback set). To change a live policy's hooks, pass a
+// new value to [Policy.SetHooks] or [Policy.AddHook], which swap it in
+// atomically.
 type Hooks struct {
 	OnRetry           func(attempt int, err error)
 	OnCircuitOpen     func()
@@ -17,7 +21,14 @@ type Hooks struct {
 	// after recovering through half-open: admission then grows from the initial
 	// fraction to full over the ramp window (see [RampRecovery]) instead of
 	// jumping straight to closed.
-	OnCircuitRamping   func()
+	OnCircuitRamping func()
+
+	// OnCircuitStateChange fires on every circuit breaker transition with the
+	// state left and the state entered, after the discrete hook for the new
+	// state (OnCircuitOpen, OnCircuitClose, ...). One callback is enough to
+	// build a complete transition log.
+	OnCircuitStateChange func(from, to CircuitState)
+
 	OnRateLimited      func()
 	OnBulkheadFull     func()
 	OnBulkheadAcquired func()
@@ -37,6 +48,32 @@ type Hooks struct {
 	OnHedgeWon       func()
 	OnFallbackUsed   func(err error)
 
+	// OnHedgeResult fires as each leg of a hedged call completes, with the leg
+	// (0 for the primary, 1 for the hedge) and its error, nil on success — so
+	// both outcomes can be logged when the two legs fail. It fires from the
+	// legs' own goroutines: a leg the winner cancelled reports its cancellation
+	// error, possibly after the call has returned.
+	OnHedgeResult func(leg int, err error)
+
+	// OnHedgeWonDetail fires once per hedged call whose hedge was fired and
+	// which returned a success, with the leg that answered first (0 for the
+	// primary, 1 for the hedge) and that leg's own latency, measured on the
+	// policy [Clock] from the leg's start. Counting leg 1 against all calls
+	// tells how often hedging helped, and the latencies by how much; unlike
+	// OnHedgeWon it also reports the primary winning the race.
+	OnHedgeWonDetail func(leg int, latency time.Duration)
+
+	// OnSoftTimeout fires when a call is still running past the soft threshold
+	// of a two-phase timeout; the call is left to finish (see
+	// [WithTimeoutSoftHard]).
+	OnSoftTimeout func()
+
+	// OnFallbackUsedDetailed fires alongside OnFallbackUsed with both the
+	// final error the fallback replaced (e.g. a retries-exhausted error) and
+	// its root cause — the error unwrapped down to the failure that started it,
+	// such as the last attempt's downstream error.
+	OnFallbackUsedDetailed func(finalErr, rootErr error)
+
 	// OnRetryBudgetExceeded fires when a retry is suppressed because the retry
 	// budget is exhausted. The underlying downstream error is still returned by
 	// the policy call.
@@ -46,6 +83,13 @@ type Hooks struct {
 	// budget would be exhausted by the next backoff (see [WithTimeBudget]).
 	OnTimeBudgetExceeded func()
 
+	// OnRetriesExhausted fires once when every retry attempt has failed (or
+	// [MaxElapsedTime] ended the sequence), right before retry returns its
+	// [ErrRetriesExhausted] error, with the number of attempts made and the last
+	// attempt's error. It does not fire when retry stops early on a [Permanent]
+	// error or a [RetryIf] rejection, nor on success.
+	OnRetriesExhausted func(attempts int, lastErr error)
+
 	// OnCoalesceLeader fires when a call begins a shared execution for a
 	// coalescing key (it ran the work the followers share).
 	OnCoalesceLeader func()
@@ -64,8 +108,13 @@ type Hooks struct {
 	// cache.
 	OnCacheStored func()
 	// OnStaleServed fires when a downstream execution fails and the read-through
-	// cache serves a stale value instead of the error (see [StaleIfError]).
+	// cache serves a stale value instead of the error (see [StaleIfError]), or
+	// [WithLastGood] serves the last successful result.
 	OnStaleServed func()
+	// OnStaleServedAge fires alongside OnStaleServed with the age of the value
+	// served — how long ago it was stored — to tell how out of date callers'
+	// data has become.
+	OnStaleServedAge func(age time.Duration)
 	// OnCacheRefreshed fires when a refresh-ahead background reload completes
 	// successfully and repopulates the entry (see [RefreshAhead]). A failed reload
 	// is silent. The successful store also fires OnCacheStored.
@@ -86,6 +135,11 @@ type Hooks struct {
 	// preserve the error budget while it is burning too fast (see [WithSLO]).
 	OnSLOShed func()
 
+	// OnLoadShed fires when the load shedder rejects a call locally because
+	// latency or the calls in flight exceed their threshold (see
+	// [WithLoadShedder]).
+	OnLoadShed func()
+
 	// OnRateAdapted fires when the AIMD rate controller moves the rate limiter's
 	// refill rate, with the new rate in tokens per second (see [AIMD]). A new
 	// rate below the previous one signals server pushback (a multiplicative
@@ -112,6 +166,22 @@ type Hooks struct {
 	// strategy kind ("fault", "latency", "outcome", or "behavior"). See
 	// [WithChaos].
 	OnChaosInjected func(kind string)
+
+	// OnOutcome fires at the end of every [Policy.Do] call with its
+	// classification: [OutcomeSuccess] for a clean first-try success,
+	// [OutcomeDegraded] when a retry, hedge, fallback, or stale cache value
+	// rescued it, and [OutcomeFailed] when it returned an error. A coalesced
+	// follower shares the leader's result and reports success.
+	OnOutcome func(outcome Outcome)
+
+	// OnAttemptStart fires each time the user function is invoked, with the
+	// attempt number within the Do call (1 for the first try, counting every
+	// retry and hedged copy). OnAttemptEnd fires when that invocation returns,
+	// with the same number, how long it ran on the policy's [Clock], and its
+	// error. Either one set makes the policy wrap the user function (see the
+	// "attempts" entry of [Policy.Patterns]).
+	OnAttemptStart func(attempt int)
+	OnAttemptEnd   func(attempt int, d time.Duration, err error)
 }
 
 // Each emit method guards both a nil receiver and a nil field, so a nil *Hooks
@@ -147,6 +217,12 @@ func (h *Hooks) emitCircuitHalfOpen() {
 	}
 }
 
+func (h *Hooks) emitCircuitStateChange(from, to CircuitState) {
+	if h != nil && h.OnCircuitStateChange != nil {
+		h.OnCircuitStateChange(from, to)
+	}
+}
+
 func (h *Hooks) emitRateLimited() {
 	if h != nil && h.OnRateLimited != nil {
 		h.OnRateLimited()
@@ -195,6 +271,12 @@ func (h *Hooks) emitTimeout() {
 	}
 }
 
+func (h *Hooks) emitSoftTimeout() {
+	if h != nil && h.OnSoftTimeout != nil {
+		h.OnSoftTimeout()
+	}
+}
+
 func (h *Hooks) emitHedgeTriggered() {
 	if h != nil && h.OnHedgeTriggered != nil {
 		h.OnHedgeTriggered()
@@ -207,10 +289,26 @@ func (h *Hooks) emitHedgeWon() {
 	}
 }
 
+func (h *Hooks) emitHedgeResult(leg int, err error) {
+	if h != nil && h.OnHedgeResult != nil {
+		h.OnHedgeResult(leg, err)
+	}
+}
+
+func (h *Hooks) emitHedgeWonDetail(leg int, latency time.Duration) {
+	if h != nil && h.OnHedgeWonDetail != nil {
+		h.OnHedgeWonDetail(leg, latency)
+	}
+}
+
 func (h *Hooks) emitFallbackUsed(err error) {
 	if h != nil && h.OnFallbackUsed != nil {
 		h.OnFallbackUsed(err)
 	}
+
+	if h != nil && h.OnFallbackUsedDetailed != nil {
+		h.OnFallbackUsedDetailed(err, rootCause(err))
+	}
 }
 
 func (h *Hooks) emitRetryBudgetExceeded() {
@@ -225,6 +323,12 @@ func (h *Hooks) emitTimeBudgetExceeded() {
 	}
 }
 
+func (h *Hooks) emitRetriesExhausted(attempts int, lastErr error) {
+	if h != nil && h.OnRetriesExhausted != nil {
+		h.OnRetriesExhausted(attempts, lastErr)
+	}
+}
+
 func (h *Hooks) emitCoalesceLeader() {
 	if h != nil && h.OnCoalesceLeader != nil {
 		h.OnCoalesceLeader()
@@ -255,10 +359,14 @@ func (h *Hooks) emitCacheStored() {
 	}
 }
 
-func (h *Hooks) emitStaleServed() {
+func (h *Hooks) emitStaleServed(age time.Duration) {
 	if h != nil && h.OnStaleServed != nil {
 		h.OnStaleServed()
 	}
+
+	if h != nil && h.OnStaleServedAge != nil {
+		h.OnStaleServedAge(age)
+	}
 }
 
 func (h *Hooks) emitCacheRefreshed() {
@@ -291,6 +399,12 @@ func (h *Hooks) emitSLOShed() {
 	}
 }
 
+func (h *Hooks) emitLoadShed() {
+	if h != nil && h.OnLoadShed != nil {
+		h.OnLoadShed()
+	}
+}
+
 func (h *Hooks) emitRateAdapted(rate float64) {
 	if h != nil && h.OnRateAdapted != nil {
 		h.OnRateAdapted(rate)
@@ -320,3 +434,15 @@ func (h *Hooks) emitChaosInjected(kind string) {
 		h.OnChaosInjected(kind)
 	}
 }
+
+func (h *Hooks) emitAttemptStart(attempt int) {
+	if h != nil && h.OnAttemptStart != nil {
+		h.OnAttemptStart(attempt)
+	}
+}
+
+func (h *Hooks) emitAttemptEnd(attempt int, d time.Duration, err error) {
+	if h != nil && h.OnAttemptEnd != nil {
+		h.OnAttemptEnd(attempt, d, err)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"sync"
 	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -159,16 +160,21 @@ func TestNilHooksDoNotPanic(t *testing.T) {
 		h.emitFallbackUsed(errors.New("err"))
 		h.emitRetryBudgetExceeded()
 		h.emitTimeBudgetExceeded()
+		h.emitRetriesExhausted(3, errors.New("err"))
 		h.emitCoalesceLeader()
 		h.emitCoalesceFollower()
 		h.emitCacheHit()
 		h.emitCacheMiss()
 		h.emitCacheStored()
-		h.emitStaleServed()
+		h.emitStaleServed(time.Second)
 		h.emitConcurrencyRejected()
 		h.emitConcurrencyLimitChanged(7)
 		h.emitThrottled()
+		h.emitLoadShed()
+		h.emitSoftTimeout()
 		h.emitSlowCallRateExceeded()
+		h.emitAttemptStart(1)
+		h.emitAttemptEnd(1, time.Second, errors.New("err"))
 	}
 
 	require.NotPanics(t, func() {
@@ -6,6 +6,7 @@
 // HTTP response codes to transient or permanent errors.
 //
 // Each retry attempt replays the request body via req.GetBody, so a
-// retried request carrying a body (POST/PUT) resends it correctly; a
-// body without GetBody cannot be replayed.
+// retried request carrying a body (POST/PUT) resends it correctly. A
+// body without GetBody is buffered up to WithMaxReplayBytes; one that
+// still cannot be rewound is sent once and its failure is not retried.
 package httpx
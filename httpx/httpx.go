@@ -1,10 +1,15 @@
 package httpx
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"io"
+	"maps"
 	"net/http"
 	"strconv"
+	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/byte4ever/r8e"
@@ -21,6 +26,16 @@ type (
 	// logic without modifying the adapter.
 	Classifier func(statusCode int) ErrorClass
 
+	// BodyClassifier maps a whole HTTP response — status, headers and body —
+	// to an ErrorClass, for APIs that report errors in the payload of a 200.
+	// It may read resp.Body freely: the [Client] hands it a buffered copy and
+	// restores the body for the caller afterwards (see
+	// [WithMaxClassifyBytes]). The error, when non-nil, describes the failure
+	// and is carried by the [StatusError]; it is ignored for Success.
+	//
+	// Pattern: Strategy — like [Classifier], with the response in full.
+	BodyClassifier func(resp *http.Response) (ErrorClass, error)
+
 	// StatusError is returned when the Classifier marks a
 	// status code as Transient or Permanent. The original
 	// response remains accessible for header inspection.
@@ -28,7 +43,10 @@ type (
 	// during retries; only the permanent error path
 	// preserves an unread body.
 	StatusError struct {
-		Response   *http.Response
+		Response *http.Response
+		// Err is the failure a [BodyClassifier] read from the response, or
+		// nil. errors.Is and errors.As reach it through Unwrap.
+		Err        error
 		StatusCode int
 	}
 
@@ -42,9 +60,41 @@ type (
 		httpClient *http.Client
 		policy     *r8e.Policy[*http.Response]
 		classifier Classifier
+		// bodyClassifier, when set, replaces classifier (see
+		// NewClientBodyClassifier).
+		bodyClassifier BodyClassifier
+		// maxClassifyBytes bounds how much of a response body is buffered for
+		// bodyClassifier (see WithMaxClassifyBytes).
+		maxClassifyBytes int64
+		// maxReplayBytes bounds how much of a non-rewindable request body
+		// (no GetBody) Do buffers so retries can resend it (see
+		// WithMaxReplayBytes). Zero disables buffering.
+		maxReplayBytes int64
+		// retryMethods, when non-nil, is the set of methods whose failures may
+		// be retried (see RetryIdempotentOnly); nil retries every method.
+		retryMethods map[string]bool
 	}
+
+	// ClientOption configures adapter-level behaviour of a [Client] that is
+	// not part of its r8e policy. Apply options with [Client.With].
+	ClientOption func(*Client)
 )
 
+// ErrBodyNotReplayable is returned for a second attempt of a request whose
+// body can be sent only once: it has no GetBody and was not buffered (see
+// [WithMaxReplayBytes]). Do marks the first attempt's failure as permanent so
+// retry returns that original error instead; this error only surfaces from
+// patterns that start a concurrent attempt, such as hedging.
+var ErrBodyNotReplayable = errors.New("httpx: request body cannot be replayed")
+
+// idempotencyKeyHeader marks a request the server deduplicates, which makes it
+// safe to retry whatever its method (see [RetryIdempotentOnly]).
+const idempotencyKeyHeader = "Idempotency-Key"
+
+// defaultMaxClassifyBytes is how much of a response body a [BodyClassifier]
+// sees unless [WithMaxClassifyBytes] says otherwise.
+const defaultMaxClassifyBytes = 64 << 10
+
 const (
 	// Success means the request succeeded (e.g. 2xx).
 	Success ErrorClass = iota
@@ -66,7 +116,18 @@ var _ r8e.RetryAfterProvider = (*StatusError)(nil)
 // Error returns a human-readable description of the status
 // error.
 func (e *StatusError) Error() string {
-	return "http status " + strconv.Itoa(e.StatusCode)
+	msg := "http status " + strconv.Itoa(e.StatusCode)
+	if e.Err != nil {
+		msg += ": " + e.Err.Error()
+	}
+
+	return msg
+}
+
+// Unwrap returns the failure a [BodyClassifier] read from the response, or
+// nil.
+func (e *StatusError) Unwrap() error {
+	return e.Err
 }
 
 // RetryAfter reports the delay requested by the response's
@@ -128,6 +189,122 @@ func NewClient(
 	}
 }
 
+// NewClientBodyClassifier creates a Client like [NewClient] whose responses
+// are classified by a [BodyClassifier] instead of by status code alone, for
+// APIs that return 200 with an error payload such as {"error": ...}. The
+// classifier reads a buffered copy of at most [WithMaxClassifyBytes] of the
+// body (64 KiB by default), and the caller still gets the whole body, unread,
+// in the returned response. A Transient or Permanent class yields a
+// [StatusError] carrying the status code and the classifier's error.
+func NewClientBodyClassifier(
+	name string,
+	hc *http.Client,
+	cl BodyClassifier,
+	opts ...r8e.Option,
+) *Client {
+	return &Client{
+		httpClient:       hc,
+		policy:           r8e.NewPolicy[*http.Response](name, opts...),
+		bodyClassifier:   cl,
+		maxClassifyBytes: defaultMaxClassifyBytes,
+	}
+}
+
+// WithMaxClassifyBytes bounds how much of a response body is buffered for a
+// [BodyClassifier]: the classifier reads at most the first n bytes, and the
+// rest stays unread on the connection until the caller reads it. n <= 0
+// resets the default of 64 KiB. It has no effect on a status [Classifier].
+func WithMaxClassifyBytes(n int64) ClientOption {
+	return func(c *Client) {
+		if n <= 0 {
+			n = defaultMaxClassifyBytes
+		}
+
+		c.maxClassifyBytes = n
+	}
+}
+
+// WithMaxReplayBytes lets Do buffer up to n bytes of a request body that
+// has no GetBody (e.g. built from an io.Pipe or a file), so retries resend
+// the original payload. A body longer than n is streamed once, unbuffered,
+// and its failure is not retried. n <= 0 disables buffering (the default).
+func WithMaxReplayBytes(n int64) ClientOption {
+	return func(c *Client) {
+		c.maxReplayBytes = max(n, 0)
+	}
+}
+
+// RetryIdempotentOnly restricts retries to requests that are safe to send
+// twice: the idempotent methods GET, HEAD, PUT, DELETE and OPTIONS, any method
+// added with [RetryMethods], and any request carrying an Idempotency-Key
+// header. Another request — a POST or PATCH — is sent once: its failure is
+// returned as [r8e.Permanent], so the policy's retry stops at once instead of
+// resending a write the server may already have applied. By default every
+// method is retried.
+func RetryIdempotentOnly() ClientOption {
+	return func(c *Client) {
+		c.retryMethods = withRetryMethods(c.retryMethods)
+	}
+}
+
+// RetryMethods opts methods (e.g. http.MethodPost for an endpoint known to be
+// idempotent) into retries on top of the idempotent ones. It implies
+// [RetryIdempotentOnly]. Methods are matched case-insensitively.
+func RetryMethods(methods ...string) ClientOption {
+	return func(c *Client) {
+		c.retryMethods = withRetryMethods(c.retryMethods, methods...)
+	}
+}
+
+// withRetryMethods returns a copy of set — the idempotent methods when set is
+// nil — with methods added. It copies so a [Client.With] clone never changes
+// the set of the client it was cloned from.
+func withRetryMethods(set map[string]bool, methods ...string) map[string]bool {
+	if set == nil {
+		set = map[string]bool{
+			http.MethodGet:     true,
+			http.MethodHead:    true,
+			http.MethodPut:     true,
+			http.MethodDelete:  true,
+			http.MethodOptions: true,
+		}
+	} else {
+		set = maps.Clone(set)
+	}
+
+	for _, method := range methods {
+		set[strings.ToUpper(method)] = true
+	}
+
+	return set
+}
+
+// retryable reports whether a failed attempt of req may be retried under the
+// client's retry-method restriction.
+func (c *Client) retryable(req *http.Request) bool {
+	if c.retryMethods == nil || req.Header.Get(idempotencyKeyHeader) != "" {
+		return true
+	}
+
+	method := req.Method
+	if method == "" {
+		method = http.MethodGet
+	}
+
+	return c.retryMethods[strings.ToUpper(method)]
+}
+
+// With returns a copy of c with opts applied. The copy shares c's policy, so
+// both keep one circuit breaker, rate limiter, and so on.
+func (c *Client) With(opts ...ClientOption) *Client {
+	clone := *c
+	for _, opt := range opts {
+		opt(&clone)
+	}
+
+	return &clone
+}
+
 // Do executes the HTTP request through the resilience
 // policy. Like http.Client.Do, it may return both a
 // non-nil response and a non-nil error. When the
@@ -137,60 +314,193 @@ func NewClient(
 // Each attempt rewinds the request body via req.GetBody, so a retried
 // request with a body (POST/PUT) replays correctly. Requests built with
 // http.NewRequest/NewRequestWithContext from a bytes/strings reader get
-// GetBody automatically; a body without GetBody cannot be replayed and
-// later attempts would send it empty.
+// GetBody automatically. A body without GetBody is buffered up to
+// [WithMaxReplayBytes]; one that still cannot be rewound is sent once and a
+// failed attempt is returned as-is (marked [r8e.Permanent]) rather than
+// retried with an empty body. Under [RetryIdempotentOnly], so is the failure
+// of a request whose method is not safe to resend.
 func (c *Client) Do(
 	ctx context.Context,
 	req *http.Request,
 ) (*http.Response, error) {
+	return c.do(ctx, req, nil)
+}
+
+// do is [Client.Do] with a hook: accept, when non-nil, runs on every response
+// classified Success within its attempt, and an error from it fails that
+// attempt as [r8e.Permanent].
+func (c *Client) do(
+	ctx context.Context,
+	req *http.Request,
+	accept func(*http.Response) error,
+) (*http.Response, error) {
+	getBody, oneShot, err := c.replayableBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var sent atomic.Bool
+
+	retryable := c.retryable(req)
+
 	//nolint:wrapcheck // policy returns caller's error as-is
 	return c.policy.Do(
 		ctx,
 		func(ctx context.Context) (*http.Response, error) {
 			attempt := req.Clone(ctx)
-			if req.GetBody != nil {
-				body, gbErr := req.GetBody()
+
+			switch {
+			case getBody != nil:
+				body, gbErr := getBody()
 				if gbErr != nil {
 					return nil, gbErr
 				}
 
 				attempt.Body = body
-			}
+			case oneShot != nil:
+				if sent.Swap(true) {
+					return nil, r8e.Permanent(ErrBodyNotReplayable)
+				}
 
-			resp, err := c.httpClient.Do(attempt)
-			if err != nil {
-				return nil, err
+				attempt.Body = oneShot
 			}
 
-			switch c.classifier(resp.StatusCode) {
-			case Success:
-				return resp, nil
-			case Transient:
-				// Drain and close body so the underlying
-				// TCP connection can be reused on retry.
-				//nolint:errcheck // best-effort drain
-				_, _ = io.Copy(io.Discard, resp.Body)
-				_ = resp.Body.Close()
-
-				return resp, r8e.Transient(
-					&StatusError{
-						Response:   resp,
-						StatusCode: resp.StatusCode,
-					},
-				)
-			case Permanent:
-				return resp, r8e.Permanent(
-					&StatusError{
-						Response:   resp,
-						StatusCode: resp.StatusCode,
-					},
-				)
-			default:
-				// An out-of-range ErrorClass from a custom
-				// classifier is passed through unchanged rather
-				// than silently retried.
-				return resp, nil
+			resp, err := c.attempt(attempt, accept)
+			if err != nil && (oneShot != nil || !retryable) {
+				// The body is gone, or the method is not safe to resend:
+				// retrying would send it empty, or apply it twice.
+				return resp, r8e.Permanent(err)
 			}
+
+			return resp, err
 		},
 	)
 }
+
+// replayableBody returns how each attempt obtains req's body. getBody is set
+// when the body can be rewound — req.GetBody, or a buffer of a body no longer
+// than maxReplayBytes. Otherwise oneShot is the single-use body (possibly a
+// buffered prefix followed by the unread rest), or nil when there is no body.
+func (c *Client) replayableBody(
+	req *http.Request,
+) (getBody func() (io.ReadCloser, error), oneShot io.ReadCloser, err error) {
+	if req.GetBody != nil {
+		return req.GetBody, nil, nil
+	}
+
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil, nil
+	}
+
+	if c.maxReplayBytes <= 0 {
+		return nil, req.Body, nil
+	}
+
+	buf, err := io.ReadAll(io.LimitReader(req.Body, c.maxReplayBytes+1))
+	if err != nil {
+		_ = req.Body.Close()
+
+		return nil, nil, err
+	}
+
+	if int64(len(buf)) > c.maxReplayBytes {
+		return nil, struct {
+			io.Reader
+			io.Closer
+		}{io.MultiReader(bytes.NewReader(buf), req.Body), req.Body}, nil
+	}
+
+	_ = req.Body.Close()
+
+	return func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(buf)), nil
+	}, nil, nil
+}
+
+// attempt sends one request and classifies its response, passing a success to
+// accept when set.
+func (c *Client) attempt(
+	req *http.Request,
+	accept func(*http.Response) error,
+) (*http.Response, error) {
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	class, cause, err := c.classify(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	switch class {
+	case Success:
+		if accept != nil {
+			if err := accept(resp); err != nil {
+				return resp, r8e.Permanent(err)
+			}
+		}
+
+		return resp, nil
+	case Transient:
+		// Drain and close body so the underlying
+		// TCP connection can be reused on retry.
+		//nolint:errcheck // best-effort drain
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+
+		return resp, r8e.Transient(
+			&StatusError{
+				Response:   resp,
+				Err:        cause,
+				StatusCode: resp.StatusCode,
+			},
+		)
+	case Permanent:
+		return resp, r8e.Permanent(
+			&StatusError{
+				Response:   resp,
+				Err:        cause,
+				StatusCode: resp.StatusCode,
+			},
+		)
+	default:
+		// An out-of-range ErrorClass from a custom
+		// classifier is passed through unchanged rather
+		// than silently retried.
+		return resp, nil
+	}
+}
+
+// classify returns resp's class and, from a BodyClassifier, the failure it
+// read. For a BodyClassifier the body is buffered up to maxClassifyBytes and
+// restored afterwards; err is set only when reading it fails.
+func (c *Client) classify(resp *http.Response) (class ErrorClass, cause, err error) {
+	if c.bodyClassifier == nil {
+		return c.classifier(resp.StatusCode), nil, nil
+	}
+
+	body := resp.Body
+
+	buf, err := io.ReadAll(io.LimitReader(body, c.maxClassifyBytes))
+	if err != nil {
+		_ = body.Close()
+
+		return Success, nil, err
+	}
+
+	resp.Body = io.NopCloser(bytes.NewReader(buf))
+	class, cause = c.bodyClassifier(resp)
+
+	// Restore the body: the buffered prefix, then whatever was not read.
+	resp.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(buf), body), body}
+
+	if class == Success {
+		cause = nil
+	}
+
+	return class, cause, nil
+}
@@ -2,12 +2,14 @@ package httpx_test
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -297,6 +299,124 @@ func TestDoRetryReplaysRequestBody(t *testing.T) {
 	}
 }
 
+// bodyRecorder is a test server that records every request body and answers
+// 503 to the first failures calls, then 200.
+type bodyRecorder struct {
+	mu       sync.Mutex
+	bodies   []string
+	failures int
+}
+
+func (b *bodyRecorder) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+
+	b.mu.Lock()
+	b.bodies = append(b.bodies, string(body))
+	n := len(b.bodies)
+	b.mu.Unlock()
+
+	if n <= b.failures {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func (b *bodyRecorder) received() []string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return append([]string(nil), b.bodies...)
+}
+
+// newOneShotRequest builds a POST whose body has no GetBody, as a request
+// streaming from a pipe or file would.
+func newOneShotRequest(t *testing.T, url, payload string) *http.Request {
+	t.Helper()
+
+	req, err := http.NewRequestWithContext(
+		context.Background(),
+		http.MethodPost,
+		url,
+		io.NopCloser(strings.NewReader(payload)),
+	)
+	require.NoError(t, err)
+	require.Nil(t, req.GetBody)
+
+	return req
+}
+
+// TestDoMaxReplayBytesReplaysBufferedBody verifies a body without GetBody is
+// buffered and resent in full on each retry when it fits WithMaxReplayBytes.
+func TestDoMaxReplayBytesReplaysBufferedBody(t *testing.T) {
+	t.Parallel()
+
+	const payload = `{"order":42,"items":["a","b"]}`
+
+	rec := &bodyRecorder{failures: 2}
+	srv := httptest.NewServer(rec)
+	defer srv.Close()
+
+	cl := httpx.NewClient(
+		"do-replay-buffered",
+		srv.Client(),
+		testClassifier,
+		r8e.WithRetry(5, r8e.ConstantBackoff(time.Millisecond)),
+	).With(httpx.WithMaxReplayBytes(1 << 10))
+
+	resp, err := cl.Do(
+		context.Background(),
+		newOneShotRequest(t, srv.URL, payload),
+	)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, []string{payload, payload, payload}, rec.received())
+}
+
+// TestDoOneShotBodyIsNotRetried verifies a non-rewindable body is sent once
+// and its failure returned as-is instead of retrying with an empty body.
+func TestDoOneShotBodyIsNotRetried(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]int64{
+		"no buffering":         0,
+		"body exceeds the cap": 4,
+	}
+
+	for name, limit := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			const payload = "streamed-once"
+
+			rec := &bodyRecorder{failures: 2}
+			srv := httptest.NewServer(rec)
+			defer srv.Close()
+
+			cl := httpx.NewClient(
+				"do-oneshot-"+name,
+				srv.Client(),
+				testClassifier,
+				r8e.WithRetry(5, r8e.ConstantBackoff(time.Millisecond)),
+			).With(httpx.WithMaxReplayBytes(limit))
+
+			_, err := cl.Do(
+				context.Background(),
+				newOneShotRequest(t, srv.URL, payload),
+			)
+			require.Error(t, err)
+
+			var se *httpx.StatusError
+			require.ErrorAs(t, err, &se)
+			assert.Equal(t, http.StatusServiceUnavailable, se.StatusCode)
+			assert.NotErrorIs(t, err, r8e.ErrRetriesExhausted)
+			assert.Equal(t, []string{payload}, rec.received(), "the full body is sent exactly once")
+		})
+	}
+}
+
 // TestDoGetBodyErrorPropagates verifies a GetBody failure surfaces as an error
 // rather than silently sending an empty body on the retried request.
 func TestDoGetBodyErrorPropagates(t *testing.T) {
@@ -457,6 +577,104 @@ func TestDoTransientRetriesExhausted(t *testing.T) {
 	_ = resp
 }
 
+// TestDoRetryIdempotentOnly verifies that with RetryIdempotentOnly a request
+// unsafe to resend is attempted once, while a safe one is retried.
+func TestDoRetryIdempotentOnly(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		method   string
+		header   string
+		opts     []httpx.ClientOption
+		attempts int32
+	}{
+		"GET is retried":   {method: http.MethodGet, attempts: 3},
+		"PUT is retried":   {method: http.MethodPut, attempts: 3},
+		"POST fails fast":  {method: http.MethodPost, attempts: 1},
+		"PATCH fails fast": {method: http.MethodPatch, attempts: 1},
+		"POST with Idempotency-Key is retried": {
+			method: http.MethodPost, header: "order-42", attempts: 3,
+		},
+		"POST opted in is retried": {
+			method:   http.MethodPost,
+			opts:     []httpx.ClientOption{httpx.RetryMethods("post")},
+			attempts: 3,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls atomic.Int32
+
+			srv := httptest.NewServer(http.HandlerFunc(
+				func(w http.ResponseWriter, _ *http.Request) {
+					calls.Add(1)
+					w.WriteHeader(http.StatusServiceUnavailable)
+				},
+			))
+			defer srv.Close()
+
+			cl := httpx.NewClient(
+				"do-idempotent-only",
+				srv.Client(),
+				testClassifier,
+				r8e.WithRetry(3, r8e.ConstantBackoff(time.Millisecond)),
+			).With(append([]httpx.ClientOption{httpx.RetryIdempotentOnly()}, tt.opts...)...)
+
+			req, err := http.NewRequestWithContext(
+				context.Background(), tt.method, srv.URL, strings.NewReader("x"),
+			)
+			require.NoError(t, err)
+
+			if tt.header != "" {
+				req.Header.Set("Idempotency-Key", tt.header)
+			}
+
+			_, err = cl.Do(context.Background(), req)
+
+			var statusErr *httpx.StatusError
+			require.ErrorAs(t, err, &statusErr)
+			assert.Equal(t, http.StatusServiceUnavailable, statusErr.StatusCode)
+			assert.Equal(t, tt.attempts, calls.Load())
+		})
+	}
+}
+
+// TestRetryMethodsDoesNotLeakIntoOriginal verifies a With clone's retry
+// methods leave the client it was cloned from untouched.
+func TestRetryMethodsDoesNotLeakIntoOriginal(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+
+	srv := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, _ *http.Request) {
+			calls.Add(1)
+			w.WriteHeader(http.StatusServiceUnavailable)
+		},
+	))
+	defer srv.Close()
+
+	base := httpx.NewClient(
+		"do-retry-methods-clone",
+		srv.Client(),
+		testClassifier,
+		r8e.WithRetry(3, r8e.ConstantBackoff(time.Millisecond)),
+	).With(httpx.RetryIdempotentOnly())
+	_ = base.With(httpx.RetryMethods(http.MethodPost))
+
+	req, err := http.NewRequestWithContext(
+		context.Background(), http.MethodPost, srv.URL, nil,
+	)
+	require.NoError(t, err)
+
+	_, err = base.Do(context.Background(), req)
+	require.Error(t, err)
+	assert.Equal(t, int32(1), calls.Load())
+}
+
 func TestStatusErrorMessage(t *testing.T) {
 	t.Parallel()
 
@@ -552,3 +770,142 @@ func FuzzStatusErrorRetryAfter(f *testing.F) {
 		}
 	})
 }
+
+// errorPayload is the body of an API that reports failures inside a 200.
+type errorPayload struct {
+	Error string `json:"error"`
+}
+
+// payloadClassifier marks a response whose JSON body carries an "error" field
+// as Transient.
+func payloadClassifier(resp *http.Response) (httpx.ErrorClass, error) {
+	var payload errorPayload
+	if err := json.NewDecoder(resp.Body).Decode(&payload); err != nil {
+		return httpx.Permanent, err
+	}
+
+	if payload.Error != "" {
+		return httpx.Transient, errors.New(payload.Error)
+	}
+
+	return httpx.Success, nil
+}
+
+func TestDoBodyClassifierRetriesErrorPayload(t *testing.T) {
+	t.Parallel()
+
+	var calls int
+
+	srv := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, _ *http.Request) {
+				calls++
+				if calls <= 2 {
+					_, _ = io.WriteString(w, `{"error":"backend busy"}`)
+
+					return
+				}
+
+				_, _ = io.WriteString(w, `{"data":"ok"}`)
+			},
+		),
+	)
+	defer srv.Close()
+
+	cl := httpx.NewClientBodyClassifier(
+		"do-body-classifier",
+		srv.Client(),
+		payloadClassifier,
+		r8e.WithRetry(5, r8e.ConstantBackoff(time.Millisecond)),
+	)
+
+	req, err := http.NewRequestWithContext(
+		context.Background(), http.MethodGet, srv.URL, nil,
+	)
+	require.NoError(t, err)
+
+	resp, err := cl.Do(context.Background(), req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, 3, calls, "two error payloads retried")
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"data":"ok"}`, string(body), "the caller still reads the body")
+}
+
+func TestDoBodyClassifierStatusError(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = io.WriteString(w, `{"error":"quota exceeded"}`)
+			},
+		),
+	)
+	defer srv.Close()
+
+	cl := httpx.NewClientBodyClassifier(
+		"do-body-classifier-error",
+		srv.Client(),
+		payloadClassifier,
+	)
+
+	req, err := http.NewRequestWithContext(
+		context.Background(), http.MethodGet, srv.URL, nil,
+	)
+	require.NoError(t, err)
+
+	_, err = cl.Do(context.Background(), req)
+
+	var se *httpx.StatusError
+	require.ErrorAs(t, err, &se)
+	assert.Equal(t, http.StatusOK, se.StatusCode)
+	require.EqualError(t, se.Err, "quota exceeded")
+	assert.Equal(t, "http status 200: quota exceeded", se.Error())
+}
+
+func TestDoBodyClassifierMaxClassifyBytes(t *testing.T) {
+	t.Parallel()
+
+	payload := strings.Repeat("x", 100)
+
+	srv := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = io.WriteString(w, payload)
+			},
+		),
+	)
+	defer srv.Close()
+
+	var seen int
+
+	cl := httpx.NewClientBodyClassifier(
+		"do-body-classifier-limit",
+		srv.Client(),
+		func(resp *http.Response) (httpx.ErrorClass, error) {
+			b, err := io.ReadAll(resp.Body)
+			seen = len(b)
+
+			return httpx.Success, err
+		},
+	).With(httpx.WithMaxClassifyBytes(10))
+
+	req, err := http.NewRequestWithContext(
+		context.Background(), http.MethodGet, srv.URL, nil,
+	)
+	require.NoError(t, err)
+
+	resp, err := cl.Do(context.Background(), req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, 10, seen, "the classifier sees at most the limit")
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, payload, string(body), "the caller gets the whole body")
+}
@@ -0,0 +1,103 @@
+package httpx
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// ErrNotJSON is returned by [DoJSON] when a successful response declares a
+// Content-Type other than JSON.
+var ErrNotJSON = errors.New("httpx: response is not JSON")
+
+// DoJSON executes req through c like [Client.Do] and decodes the body of a
+// successful response into a T, removing the decode-classify-retry
+// boilerplate of calling a JSON API. Responses the client's classifier marks
+// Transient are retried by its policy as usual; the returned error is then the
+// same [StatusError] Client.Do would return.
+//
+// Decoding happens within the attempt, and its failures are permanent — a
+// malformed body is not retried:
+//
+//   - a Content-Type other than application/json or a +json type fails with
+//     [ErrNotJSON]; a response without a Content-Type is decoded anyway;
+//   - a body that does not decode into T fails with the decoder's error;
+//   - an empty body (a 204, or no bytes at all) yields the zero T and no error.
+//
+// DoJSON reads and closes the body of every response it decodes.
+func DoJSON[T any](ctx context.Context, c *Client, req *http.Request) (T, error) {
+	// One entry per decoded response: hedged attempts may both succeed, and
+	// the policy picks the response whose value is returned.
+	var decoded sync.Map
+
+	resp, err := c.do(ctx, req, func(resp *http.Response) error {
+		v, err := decodeJSON[T](resp)
+		if err != nil {
+			return err
+		}
+
+		decoded.Store(resp, v)
+
+		return nil
+	})
+	if err != nil {
+		var zero T
+
+		return zero, err
+	}
+
+	v, ok := decoded.Load(resp)
+	if !ok {
+		// An out-of-range class from a custom classifier passes the response
+		// through without marking it a success (see Client.Do).
+		var zero T
+
+		return zero, &StatusError{Response: resp, StatusCode: resp.StatusCode}
+	}
+
+	return v.(T), nil //nolint:forcetypeassert // only T values are stored
+}
+
+// decodeJSON checks resp's Content-Type and decodes its body into a T,
+// closing the body.
+func decodeJSON[T any](resp *http.Response) (T, error) {
+	defer resp.Body.Close() //nolint:errcheck // read side, nothing to report
+
+	var v T
+
+	if resp.StatusCode == http.StatusNoContent {
+		return v, nil
+	}
+
+	if ct := resp.Header.Get("Content-Type"); ct != "" && !isJSONMediaType(ct) {
+		return v, fmt.Errorf("%w: content type %q", ErrNotJSON, ct)
+	}
+
+	err := json.NewDecoder(resp.Body).Decode(&v)
+	if errors.Is(err, io.EOF) {
+		return v, nil
+	}
+
+	if err != nil {
+		return v, fmt.Errorf("httpx: decode %T: %w", v, err)
+	}
+
+	return v, nil
+}
+
+// isJSONMediaType reports whether a Content-Type value names JSON:
+// application/json or a structured-syntax +json type.
+func isJSONMediaType(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
@@ -0,0 +1,206 @@
+package httpx_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/byte4ever/r8e"
+	"github.com/byte4ever/r8e/httpx"
+)
+
+type widget struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// newJSONClient returns a client for srv that retries transient statuses.
+func newJSONClient(name string, srv *httptest.Server) *httpx.Client {
+	return httpx.NewClient(
+		name,
+		srv.Client(),
+		testClassifier,
+		r8e.WithRetry(3, r8e.ConstantBackoff(time.Millisecond)),
+	)
+}
+
+func newGetRequest(t *testing.T, url string) *http.Request {
+	t.Helper()
+
+	req, err := http.NewRequestWithContext(
+		context.Background(),
+		http.MethodGet,
+		url,
+		nil,
+	)
+	require.NoError(t, err)
+
+	return req
+}
+
+// TestDoJSONRetriesThenDecodes verifies that a transient 503 is retried and
+// the JSON object from the following success is decoded.
+func TestDoJSONRetriesThenDecodes(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+
+	srv := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, _ *http.Request) {
+				if calls.Add(1) == 1 {
+					w.WriteHeader(http.StatusServiceUnavailable)
+					return
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"id":7,"name":"gear"}`))
+			},
+		),
+	)
+	defer srv.Close()
+
+	cl := newJSONClient("json-retry", srv)
+
+	got, err := httpx.DoJSON[widget](
+		context.Background(),
+		cl,
+		newGetRequest(t, srv.URL),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, widget{ID: 7, Name: "gear"}, got)
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+// TestDoJSONDecodeErrorIsPermanent verifies that a malformed body fails
+// without being retried.
+func TestDoJSONDecodeErrorIsPermanent(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+
+	srv := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, _ *http.Request) {
+				calls.Add(1)
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"id":`))
+			},
+		),
+	)
+	defer srv.Close()
+
+	cl := newJSONClient("json-decode", srv)
+
+	_, err := httpx.DoJSON[widget](
+		context.Background(),
+		cl,
+		newGetRequest(t, srv.URL),
+	)
+	require.Error(t, err)
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+// TestDoJSONRejectsOtherContentType verifies that a non-JSON Content-Type
+// fails with ErrNotJSON, while +json types are accepted.
+func TestDoJSONRejectsOtherContentType(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		contentType string
+		wantErr     bool
+	}{
+		{contentType: "text/html; charset=utf-8", wantErr: true},
+		{contentType: "application/json; charset=utf-8"},
+		{contentType: "application/problem+json"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.contentType, func(t *testing.T) {
+			t.Parallel()
+
+			srv := httptest.NewServer(
+				http.HandlerFunc(
+					func(w http.ResponseWriter, _ *http.Request) {
+						w.Header().Set("Content-Type", tt.contentType)
+						_, _ = w.Write([]byte(`{"id":1}`))
+					},
+				),
+			)
+			defer srv.Close()
+
+			cl := newJSONClient("json-content-type", srv)
+
+			got, err := httpx.DoJSON[widget](
+				context.Background(),
+				cl,
+				newGetRequest(t, srv.URL),
+			)
+			if tt.wantErr {
+				require.ErrorIs(t, err, httpx.ErrNotJSON)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, 1, got.ID)
+		})
+	}
+}
+
+// TestDoJSONEmptyBody verifies that an empty success body yields the zero
+// value.
+func TestDoJSONEmptyBody(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusNoContent)
+			},
+		),
+	)
+	defer srv.Close()
+
+	cl := newJSONClient("json-empty", srv)
+
+	got, err := httpx.DoJSON[widget](
+		context.Background(),
+		cl,
+		newGetRequest(t, srv.URL),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, widget{}, got)
+}
+
+// TestDoJSONPermanentStatus verifies that a permanent status surfaces the
+// same StatusError as Client.Do.
+func TestDoJSONPermanentStatus(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+			},
+		),
+	)
+	defer srv.Close()
+
+	cl := newJSONClient("json-permanent", srv)
+
+	_, err := httpx.DoJSON[widget](
+		context.Background(),
+		cl,
+		newGetRequest(t, srv.URL),
+	)
+
+	var se *httpx.StatusError
+	require.ErrorAs(t, err, &se)
+	assert.Equal(t, http.StatusNotFound, se.StatusCode)
+}
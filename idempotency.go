@@ -0,0 +1,55 @@
+package r8e
+
+import (
+	"context"
+	"time"
+)
+
+// IdempotencyStore records the results of completed operations by idempotency
+// key, so a retry — or a later call carrying the same key — returns the
+// recorded result instead of running the operation again. It has the Get/Set
+// shape of [Cache]: any Cache[string, T] satisfies it, so the otter and
+// ristretto adapters can back it in-process, and a shared store (Redis, a
+// database table) dedups across replicas.
+type IdempotencyStore[T any] interface {
+	// Get returns the result recorded under key and true, or false when none
+	// is.
+	Get(key string) (T, bool)
+	// Set records value under key for ttl.
+	Set(key string, value T, ttl time.Duration)
+}
+
+// DoIdempotent runs fn at most once per key: it returns the result recorded in
+// store under key without calling fn when there is one, and otherwise calls fn
+// and records a successful result for ttl. Errors are never recorded, so a
+// failed operation can be retried. An empty key disables the guard for the
+// call.
+//
+// The guard is a lookup, not a lock: two concurrent calls with the same key
+// that both miss both run fn.
+//
+//nolint:ireturn // generic type parameter T, not an interface
+func DoIdempotent[T any](
+	ctx context.Context,
+	fn func(context.Context) (T, error),
+	key string,
+	store IdempotencyStore[T],
+	ttl time.Duration,
+) (T, error) {
+	if key == "" {
+		return fn(ctx)
+	}
+
+	if result, ok := store.Get(key); ok {
+		return result, nil
+	}
+
+	result, err := fn(ctx)
+	if err != nil {
+		return result, err
+	}
+
+	store.Set(key, result, ttl)
+
+	return result, nil
+}
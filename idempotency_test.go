@@ -0,0 +1,131 @@
+package r8e
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type idempotencyKey struct{}
+
+// withIdempotencyKey stamps ctx with the key the idempotency tests guard on.
+func withIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKey{}, key)
+}
+
+func idempotencyKeyOf(ctx context.Context) string {
+	key, _ := ctx.Value(idempotencyKey{}).(string)
+
+	return key
+}
+
+func TestPolicyIdempotencyRetryReturnsRecordedResult(t *testing.T) {
+	t.Parallel()
+
+	store := newMemCache[string]()
+
+	var calls atomic.Int64
+
+	p := NewPolicy[string]("",
+		WithClock(newImmediateTestClock()),
+		WithRetry(3, ConstantBackoff(time.Millisecond)),
+		WithIdempotency[string](idempotencyKeyOf, store, time.Hour),
+	)
+	assert.Equal(t, []string{"retry", "idempotency"}, p.Patterns())
+
+	// The charge goes through server-side and is recorded under the key, but
+	// the response is lost on the way back.
+	charge := func(ctx context.Context) (string, error) {
+		calls.Add(1)
+		store.Set(idempotencyKeyOf(ctx), "charged", time.Hour)
+
+		return "", errors.New("connection reset")
+	}
+
+	got, err := p.Do(withIdempotencyKey(context.Background(), "order-42"), charge)
+	require.NoError(t, err)
+	assert.Equal(t, "charged", got)
+	assert.Equal(t, int64(1), calls.Load(), "the retry is served from the store, not re-run")
+}
+
+func TestPolicyIdempotencyAcrossCalls(t *testing.T) {
+	t.Parallel()
+
+	store := newMemCache[string]()
+
+	var calls atomic.Int64
+
+	p := NewPolicy[string]("",
+		WithIdempotency[string](idempotencyKeyOf, store, time.Minute),
+	)
+
+	charge := func(context.Context) (string, error) {
+		return fmt.Sprintf("receipt-%d", calls.Add(1)), nil
+	}
+
+	ctx := withIdempotencyKey(context.Background(), "order-42")
+
+	first, err := p.Do(ctx, charge)
+	require.NoError(t, err)
+	assert.Equal(t, "receipt-1", first)
+	assert.Equal(t, time.Minute, store.ttls["order-42"])
+
+	second, err := p.Do(ctx, charge)
+	require.NoError(t, err)
+	assert.Equal(t, "receipt-1", second, "the recorded result is returned")
+	assert.Equal(t, int64(1), calls.Load())
+
+	// Another key, or no key at all, runs the operation.
+	_, err = p.Do(withIdempotencyKey(context.Background(), "order-43"), charge)
+	require.NoError(t, err)
+	_, err = p.Do(context.Background(), charge)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), calls.Load())
+	assert.Len(t, store.data, 2, "an unkeyed call is not recorded")
+}
+
+func TestPolicyIdempotencyDoesNotRecordErrors(t *testing.T) {
+	t.Parallel()
+
+	store := newMemCache[string]()
+	p := NewPolicy[string]("",
+		WithIdempotency[string](idempotencyKeyOf, store, time.Minute),
+	)
+
+	ctx := withIdempotencyKey(context.Background(), "order-42")
+
+	_, err := p.Do(ctx, func(context.Context) (string, error) {
+		return "partial", errors.New("declined")
+	})
+	require.Error(t, err)
+	assert.Empty(t, store.data)
+
+	got, err := p.Do(ctx, okCall)
+	require.NoError(t, err)
+	assert.Equal(t, "ok", got, "a failed operation can be retried")
+}
+
+func TestPolicyIdempotencyMisconfiguration(t *testing.T) {
+	t.Parallel()
+
+	store := newMemCache[string]()
+
+	assert.PanicsWithValue(t, ErrIdempotencyNilKeyFunc, func() {
+		NewPolicy[string]("", WithIdempotency[string](nil, store, time.Minute))
+	})
+	assert.PanicsWithValue(t, ErrIdempotencyNilStore, func() {
+		NewPolicy[string]("", WithIdempotency[string](idempotencyKeyOf, nil, time.Minute))
+	})
+	assert.PanicsWithValue(t, ErrIdempotencyNonPositiveTTL, func() {
+		NewPolicy[string]("", WithIdempotency[string](idempotencyKeyOf, store, 0))
+	})
+	assert.Panics(t, func() {
+		NewPolicy[int]("", WithIdempotency[string](idempotencyKeyOf, store, time.Minute))
+	}, "store typed for another result type")
+}
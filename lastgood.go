@@ -0,0 +1,244 @@
+package r8e
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+)
+
+// Pattern: Last Known Good — remembers the policy's most recent successful
+// result and serves it in place of a later error, so callers keep seeing the
+// last value that worked while the dependency is down. [WithStaleCacheKeyed]
+// keeps one such result per key.
+
+type (
+	// LastGoodOption configures [WithLastGood].
+	LastGoodOption func(*lastGoodDesc)
+
+	// lastGoodDesc holds the deferred last-known-good configuration; NewPolicy[T]
+	// builds the typed slot from it.
+	lastGoodDesc struct {
+		maxAge time.Duration
+	}
+
+	// lastGood is the single per-policy slot holding the latest successful
+	// result. It is not keyed: every call through the policy shares it.
+	lastGood[T any] struct {
+		clock  Clock
+		hooks  *Hooks
+		slot   atomic.Pointer[lastGoodEntry[T]]
+		maxAge time.Duration
+	}
+
+	// lastGoodEntry is one remembered result and when it was stored.
+	lastGoodEntry[T any] struct {
+		storedAt time.Time
+		value    T
+	}
+
+	// staleCacheDesc holds deferred keyed stale-cache configuration. The cache
+	// is carried as any (a Cache[string, CacheEntry[T]] erased like WithCache's)
+	// and asserted back to the policy's T in NewPolicy[T].
+	staleCacheDesc struct {
+		cache any
+		keyFn func(context.Context) string
+		ttl   time.Duration
+	}
+
+	// keyedLastGood is [lastGood] with one remembered result per key, held in
+	// a [Cache].
+	keyedLastGood[T any] struct {
+		cache Cache[string, CacheEntry[T]]
+		clock Clock
+		hooks *Hooks
+		keyFn func(context.Context) string
+		ttl   time.Duration
+	}
+)
+
+// LastGoodMaxAge bounds how old a remembered result may be and still be
+// served: once it is older than d, a failing call returns its error again. A
+// non-positive d (the default) serves the result however old it is.
+func LastGoodMaxAge(d time.Duration) LastGoodOption {
+	return func(desc *lastGoodDesc) {
+		desc.maxAge = d
+	}
+}
+
+// WithLastGood remembers the most recent successful result of the policy and,
+// when a later call fails, returns that result with a nil error instead. There
+// is one slot per policy — unlike [WithCache] it is not keyed — so it suits a
+// policy that always fetches the same thing (a config document, a price list,
+// a token). Until a first call succeeds there is nothing to serve and errors
+// pass through unchanged.
+//
+// Serving the remembered result fires OnStaleServed and OnStaleServedAge with
+// its age and marks the call degraded and stale. The pattern sits just inside
+// [WithFallback], [WithFallbackFunc] and [WithFallbackChain], so a fallback
+// only runs when there is no result to serve (none yet, or one older than
+// [LastGoodMaxAge]).
+//
+// Like [WithCache], it is code-only: absent from [PolicyConfig].
+func WithLastGood(opts ...LastGoodOption) Option {
+	return optionFunc(func(s *policySetup) {
+		desc := &lastGoodDesc{}
+		for _, opt := range opts {
+			opt(desc)
+		}
+
+		s.lastGood = desc
+	})
+}
+
+// Do runs next, remembering a successful result and serving the remembered
+// one, if still fresh enough, in place of an error.
+//
+//nolint:ireturn // generic type parameter T, not an interface
+func (lg *lastGood[T]) Do(
+	ctx context.Context,
+	next func(context.Context) (T, error),
+) (T, error) {
+	result, err := next(ctx)
+	if err == nil {
+		lg.slot.Store(&lastGoodEntry[T]{storedAt: lg.clock.Now(), value: result})
+
+		return result, nil
+	}
+
+	entry := lg.slot.Load()
+	if entry == nil {
+		return result, err
+	}
+
+	age := lg.clock.Since(entry.storedAt)
+	if lg.maxAge > 0 && age > lg.maxAge {
+		return result, err
+	}
+
+	lg.hooks.emitStaleServed(age)
+	markTrace(ctx, traceDegraded|traceStale)
+
+	return entry.value, nil
+}
+
+func newLastGoodEntry[T any](desc *lastGoodDesc, clock Clock, hooks *Hooks) PatternEntry[T] {
+	lg := &lastGood[T]{clock: clock, hooks: hooks, maxAge: desc.maxAge}
+
+	return PatternEntry[T]{
+		Priority: priorityLastGood,
+		Name:     "last_good",
+		MW: func(next func(context.Context) (T, error)) func(context.Context) (T, error) {
+			return func(ctx context.Context) (T, error) {
+				return lg.Do(ctx, next)
+			}
+		},
+	}
+}
+
+// WithStaleCacheKeyed is the keyed form of [WithLastGood]: it remembers the
+// most recent successful result for each key keyFn derives from the call's
+// context and, when a later call with the same key fails, returns that result
+// with a nil error instead, so a single policy can serve stale data per
+// entity. Each call still runs — unlike [WithCache] with [StaleIfError], a
+// remembered result is only served in place of an error, never to skip the
+// call. A result is kept for ttl; an empty key opts a call out.
+//
+// The underlying [Cache] is parameterised by [CacheEntry], e.g.
+// otter.MustNew[string, r8e.CacheEntry[T]](cfg). Serving a remembered result
+// fires OnStaleServed and OnStaleServedAge and marks the call degraded and
+// stale. The pattern sits where [WithLastGood] does, just inside the
+// fallbacks.
+//
+// A nil keyFn, a nil cache, or a non-positive ttl make [NewPolicy] panic with
+// [ErrCacheNilKeyFunc], [ErrCacheNilCache], or [ErrCacheNonPositiveTTL]; with
+// [WithPartitionKey], a nil keyFn keys calls by the partition key instead.
+// Like [WithCache], it is code-only: absent from [PolicyConfig].
+func WithStaleCacheKeyed[T any](
+	cache Cache[string, CacheEntry[T]],
+	keyFn func(context.Context) string,
+	ttl time.Duration,
+) Option {
+	return optionFunc(func(s *policySetup) {
+		desc := &staleCacheDesc{keyFn: keyFn, ttl: ttl}
+		if cache != nil {
+			desc.cache = cache
+		}
+
+		s.staleCache = desc
+	})
+}
+
+// Do runs next, remembering a successful result under the call's key and
+// serving the one remembered for that key, if still within ttl, in place of an
+// error.
+//
+//nolint:ireturn // generic type parameter T, not an interface
+func (kg *keyedLastGood[T]) Do(
+	ctx context.Context,
+	next func(context.Context) (T, error),
+) (T, error) {
+	key := kg.keyFn(ctx)
+
+	result, err := next(ctx)
+	if key == "" {
+		return result, err
+	}
+
+	if err == nil {
+		kg.cache.Set(key, CacheEntry[T]{storedAt: kg.clock.Now(), value: result}, kg.ttl)
+
+		return result, nil
+	}
+
+	// A negative entry, written by a [WithCache] sharing the cache, is no
+	// result to serve.
+	entry, ok := kg.cache.Get(key)
+	if !ok || entry.err != nil {
+		return result, err
+	}
+
+	age := kg.clock.Since(entry.storedAt)
+	if age > kg.ttl {
+		return result, err
+	}
+
+	kg.hooks.emitStaleServed(age)
+	markTrace(ctx, traceDegraded|traceStale)
+
+	return entry.value, nil
+}
+
+// newStaleCacheEntry builds the keyed stale-cache middleware. It asserts the
+// erased cache back to Cache[string, CacheEntry[T]], panicking on a mismatch
+// like [newCacheEntry].
+func newStaleCacheEntry[T any](desc *staleCacheDesc, clock Clock, hooks *Hooks) PatternEntry[T] {
+	cache, ok := desc.cache.(Cache[string, CacheEntry[T]])
+	if !ok {
+		var zero T
+
+		panic(fmt.Sprintf(
+			"r8e: WithStaleCacheKeyed value has type %T, which does not match "+
+				"policy result type Cache[string, CacheEntry[%T]]",
+			desc.cache, zero,
+		))
+	}
+
+	kg := &keyedLastGood[T]{
+		cache: cache,
+		clock: clock,
+		hooks: hooks,
+		keyFn: desc.keyFn,
+		ttl:   desc.ttl,
+	}
+
+	return PatternEntry[T]{
+		Priority: priorityStaleCache,
+		Name:     "stale_cache",
+		MW: func(next func(context.Context) (T, error)) func(context.Context) (T, error) {
+			return func(ctx context.Context) (T, error) {
+				return kg.Do(ctx, next)
+			}
+		},
+	}
+}
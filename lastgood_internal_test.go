@@ -0,0 +1,137 @@
+package r8e
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// entityCall returns a call answering val for entity while healthy is true and
+// failing otherwise.
+func entityCall(healthy *bool, val string) func(context.Context) (string, error) {
+	return func(context.Context) (string, error) {
+		if !*healthy {
+			return "", errors.New("dependency down")
+		}
+
+		return val, nil
+	}
+}
+
+func TestWithStaleCacheKeyedServesPerKey(t *testing.T) {
+	t.Parallel()
+
+	clk := newPolicyClock()
+	cache := newMemCache[CacheEntry[string]]()
+
+	var ages []time.Duration
+
+	p := NewPolicy[string]("stale-keyed",
+		WithClock(clk),
+		WithPartitionKey(tenantKey{}),
+		WithStaleCacheKeyed(cache, nil, time.Hour),
+		WithHooks(&Hooks{
+			OnStaleServedAge: func(age time.Duration) { ages = append(ages, age) },
+		}),
+	)
+
+	healthy := true
+
+	for _, tenant := range []string{"a", "b"} {
+		got, err := p.Do(forTenant(tenant), entityCall(&healthy, "v-"+tenant))
+		require.NoError(t, err)
+		assert.Equal(t, "v-"+tenant, got)
+	}
+
+	assert.Equal(t, time.Hour, cache.lastTTL("a"))
+
+	clk.advance(time.Minute)
+
+	got, err := p.Do(forTenant("a"), entityCall(&healthy, "v-a2"))
+	require.NoError(t, err)
+	assert.Equal(t, "v-a2", got, "a healthy call always runs")
+
+	healthy = false
+
+	for _, tc := range []struct{ tenant, want string }{{"a", "v-a2"}, {"b", "v-b"}} {
+		got, err = p.Do(forTenant(tc.tenant), entityCall(&healthy, ""))
+		require.NoError(t, err)
+		assert.Equal(t, tc.want, got, "each key serves its own stale value")
+	}
+
+	assert.Equal(t, []time.Duration{0, time.Minute}, ages)
+
+	_, err = p.Do(forTenant("c"), entityCall(&healthy, ""))
+	require.Error(t, err, "a key with nothing stored fails")
+}
+
+func TestWithStaleCacheKeyedExpiresAndSkipsEmptyKey(t *testing.T) {
+	t.Parallel()
+
+	clk := newPolicyClock()
+	cache := newMemCache[CacheEntry[string]]()
+	key := "k"
+
+	p := NewPolicy[string]("stale-keyed-ttl",
+		WithClock(clk),
+		WithStaleCacheKeyed(cache, func(context.Context) string { return key }, time.Minute),
+	)
+
+	healthy := true
+
+	_, err := p.Do(context.Background(), entityCall(&healthy, "v"))
+	require.NoError(t, err)
+
+	healthy = false
+
+	clk.advance(2 * time.Minute)
+
+	_, err = p.Do(context.Background(), entityCall(&healthy, ""))
+	require.Error(t, err, "a result older than ttl is not served")
+
+	key = ""
+	healthy = true
+
+	_, err = p.Do(context.Background(), entityCall(&healthy, "unkeyed"))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), cache.sets.Load(), "an empty key is not stored")
+}
+
+func TestWithStaleCacheKeyedMisconfiguration(t *testing.T) {
+	t.Parallel()
+
+	cache := newMemCache[CacheEntry[string]]()
+	keyFn := func(context.Context) string { return "k" }
+
+	assert.PanicsWithValue(t, ErrCacheNilKeyFunc, func() {
+		NewPolicy[string]("", WithStaleCacheKeyed(cache, nil, time.Minute))
+	})
+	assert.PanicsWithValue(t, ErrCacheNilCache, func() {
+		NewPolicy[string]("", WithStaleCacheKeyed[string](nil, keyFn, time.Minute))
+	})
+	assert.PanicsWithValue(t, ErrCacheNonPositiveTTL, func() {
+		NewPolicy[string]("", WithStaleCacheKeyed(cache, keyFn, 0))
+	})
+	assert.Panics(t, func() {
+		NewPolicy[int]("", WithStaleCacheKeyed(cache, keyFn, time.Minute))
+	}, "a cache typed for another result panics")
+}
+
+func TestLastGoodPatternsOwnTheirPriority(t *testing.T) {
+	t.Parallel()
+
+	keyFn := func(context.Context) string { return "k" }
+
+	// Declared innermost-first: only the priorities order them.
+	p := NewPolicy[string]("",
+		WithStaleCacheKeyed(newMemCache[CacheEntry[string]](), keyFn, time.Minute),
+		WithLastGood(),
+		WithFallback("fallback"),
+	)
+
+	assert.Equal(t, []string{"fallback", "last_good", "stale_cache"}, p.Patterns())
+}
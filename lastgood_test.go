@@ -0,0 +1,118 @@
+package r8e_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/byte4ever/r8e"
+	"github.com/byte4ever/r8e/internal/clocktest"
+)
+
+var errLastGoodDown = errors.New("dependency down")
+
+func lastGoodCall(val string, err error) func(context.Context) (string, error) {
+	return func(context.Context) (string, error) { return val, err }
+}
+
+func TestWithLastGoodServesLastSuccess(t *testing.T) {
+	t.Parallel()
+
+	clk := clocktest.New()
+
+	var ages []time.Duration
+
+	p := r8e.NewPolicy[string]("last-good",
+		r8e.WithLastGood(),
+		r8e.WithClock(clk),
+		r8e.WithHooks(&r8e.Hooks{
+			OnStaleServedAge: func(age time.Duration) { ages = append(ages, age) },
+		}),
+	)
+
+	got, err := p.Do(context.Background(), lastGoodCall("v1", nil))
+	require.NoError(t, err)
+	assert.Equal(t, "v1", got)
+
+	clk.Advance(time.Minute)
+
+	got, err = p.Do(context.Background(), lastGoodCall("", errLastGoodDown))
+	require.NoError(t, err)
+	assert.Equal(t, "v1", got)
+	assert.Equal(t, []time.Duration{time.Minute}, ages)
+
+	got, err = p.Do(context.Background(), lastGoodCall("v2", nil))
+	require.NoError(t, err)
+	assert.Equal(t, "v2", got)
+
+	got, err = p.Do(context.Background(), lastGoodCall("", errLastGoodDown))
+	require.NoError(t, err)
+	assert.Equal(t, "v2", got, "the most recent success is served")
+}
+
+func TestWithLastGoodNothingStored(t *testing.T) {
+	t.Parallel()
+
+	p := r8e.NewPolicy[string]("last-good-empty", r8e.WithLastGood())
+
+	_, err := p.Do(context.Background(), lastGoodCall("", errLastGoodDown))
+	require.ErrorIs(t, err, errLastGoodDown)
+}
+
+func TestWithLastGoodMaxAge(t *testing.T) {
+	t.Parallel()
+
+	clk := clocktest.New()
+	p := r8e.NewPolicy[string]("last-good-max-age",
+		r8e.WithLastGood(r8e.LastGoodMaxAge(time.Minute)),
+		r8e.WithClock(clk),
+	)
+
+	_, err := p.Do(context.Background(), lastGoodCall("v1", nil))
+	require.NoError(t, err)
+
+	clk.Advance(time.Minute)
+
+	got, err := p.Do(context.Background(), lastGoodCall("", errLastGoodDown))
+	require.NoError(t, err, "exactly max age is still served")
+	assert.Equal(t, "v1", got)
+
+	clk.Advance(time.Second)
+
+	_, err = p.Do(context.Background(), lastGoodCall("", errLastGoodDown))
+	require.ErrorIs(t, err, errLastGoodDown)
+}
+
+func TestWithLastGoodBeforeFallback(t *testing.T) {
+	t.Parallel()
+
+	clk := clocktest.New()
+	p := r8e.NewPolicy[string]("last-good-fallback",
+		r8e.WithFallback("fallback"),
+		r8e.WithLastGood(r8e.LastGoodMaxAge(time.Minute)),
+		r8e.WithClock(clk),
+	)
+
+	assert.Equal(t, []string{"fallback", "last_good"}, p.Patterns())
+
+	got, err := p.Do(context.Background(), lastGoodCall("", errLastGoodDown))
+	require.NoError(t, err)
+	assert.Equal(t, "fallback", got, "nothing remembered yet")
+
+	_, err = p.Do(context.Background(), lastGoodCall("v1", nil))
+	require.NoError(t, err)
+
+	got, err = p.Do(context.Background(), lastGoodCall("", errLastGoodDown))
+	require.NoError(t, err)
+	assert.Equal(t, "v1", got)
+
+	clk.Advance(2 * time.Minute)
+
+	got, err = p.Do(context.Background(), lastGoodCall("", errLastGoodDown))
+	require.NoError(t, err)
+	assert.Equal(t, "fallback", got, "expired result falls through to the fallback")
+}
@@ -0,0 +1,249 @@
+package r8e
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// Pattern: Proxy — the patterns of a policy hold a fixed Hooks whose every
+// callback forwards to the caller's current hooks, so [Policy.SetHooks] and
+// [Policy.AddHook] can swap them without rebuilding the chain.
+
+// hookSlot holds a policy's caller-supplied hooks behind an atomic pointer. The
+// pointed-to Hooks is never mutated: a change stores a new value.
+type hookSlot struct {
+	cur atomic.Pointer[Hooks]
+}
+
+// newHookSlot returns a slot holding a copy of h.
+func newHookSlot(h Hooks) *hookSlot {
+	s := &hookSlot{}
+	s.cur.Store(&h)
+
+	return s
+}
+
+// load returns the current hooks; never nil.
+func (s *hookSlot) load() *Hooks {
+	return s.cur.Load()
+}
+
+// SetHooks replaces the caller-supplied hooks of the policy — those given to
+// [WithHooks] or added by [Policy.AddHook] — and returns the ones it replaced,
+// so a debug hook attached during an incident can be removed by restoring
+// them. The swap is atomic: each event fires either the old or the new hooks,
+// never a mix, and calls in flight pick up the change from their next event.
+// Logging ([WithLogger]) and the policy's metrics are unaffected.
+//
+// OnAttemptStart and OnAttemptEnd need a pattern in the chain and fire only if
+// the policy was built with one of them or with a logger.
+func (p *Policy[T]) SetHooks(h Hooks) Hooks {
+	return *p.hooks.cur.Swap(&h)
+}
+
+// AddHook adds the callbacks set in h to the policy's current hooks: each runs
+// after the callback already set for the same event, if any. It is safe to
+// call while the policy is in use; see [Policy.SetHooks] for how the change
+// takes effect and to remove hooks again.
+func (p *Policy[T]) AddHook(h Hooks) {
+	for {
+		cur := p.hooks.load()
+
+		merged := mergeHooks(cur, &h)
+		if p.hooks.cur.CompareAndSwap(cur, &merged) {
+			return
+		}
+	}
+}
+
+// forward returns a Hooks whose every callback fires the matching callback of
+// the slot's current hooks, if set.
+func (s *hookSlot) forward() Hooks {
+	return Hooks{
+		OnRetry:              func(attempt int, err error) { s.load().emitRetry(attempt, err) },
+		OnCircuitOpen:        func() { s.load().emitCircuitOpen() },
+		OnCircuitClose:       func() { s.load().emitCircuitClose() },
+		OnCircuitHalfOpen:    func() { s.load().emitCircuitHalfOpen() },
+		OnCircuitRamping:     func() { s.load().emitCircuitRamping() },
+		OnCircuitStateChange: func(from, to CircuitState) { s.load().emitCircuitStateChange(from, to) },
+		OnRateLimited:        func() { s.load().emitRateLimited() },
+		OnBulkheadFull:       func() { s.load().emitBulkheadFull() },
+		OnBulkheadAcquired:   func() { s.load().emitBulkheadAcquired() },
+		OnBulkheadReleased:   func() { s.load().emitBulkheadReleased() },
+		OnBulkheadQueued:     func() { s.load().emitBulkheadQueued() },
+		OnBulkheadTimeout:    func() { s.load().emitBulkheadTimeout() },
+		OnCoDelShed:          func() { s.load().emitCoDelShed() },
+		OnTimeout:            func() { s.load().emitTimeout() },
+		OnHedgeTriggered:     func() { s.load().emitHedgeTriggered() },
+		OnHedgeWon:           func() { s.load().emitHedgeWon() },
+		OnFallbackUsed: func(err error) {
+			if h := s.load(); h.OnFallbackUsed != nil {
+				h.OnFallbackUsed(err)
+			}
+		},
+		OnHedgeResult: func(leg int, err error) { s.load().emitHedgeResult(leg, err) },
+		OnHedgeWonDetail: func(leg int, latency time.Duration) {
+			s.load().emitHedgeWonDetail(leg, latency)
+		},
+		OnSoftTimeout: func() { s.load().emitSoftTimeout() },
+		OnFallbackUsedDetailed: func(finalErr, rootErr error) {
+			if h := s.load(); h.OnFallbackUsedDetailed != nil {
+				h.OnFallbackUsedDetailed(finalErr, rootErr)
+			}
+		},
+		OnRetryBudgetExceeded: func() { s.load().emitRetryBudgetExceeded() },
+		OnTimeBudgetExceeded:  func() { s.load().emitTimeBudgetExceeded() },
+		OnRetriesExhausted: func(attempts int, lastErr error) {
+			s.load().emitRetriesExhausted(attempts, lastErr)
+		},
+		OnCoalesceLeader:   func() { s.load().emitCoalesceLeader() },
+		OnCoalesceFollower: func() { s.load().emitCoalesceFollower() },
+		OnCacheHit:         func() { s.load().emitCacheHit() },
+		OnCacheMiss:        func() { s.load().emitCacheMiss() },
+		OnCacheStored:      func() { s.load().emitCacheStored() },
+		OnStaleServed: func() {
+			if h := s.load(); h.OnStaleServed != nil {
+				h.OnStaleServed()
+			}
+		},
+		OnStaleServedAge: func(age time.Duration) {
+			if h := s.load(); h.OnStaleServedAge != nil {
+				h.OnStaleServedAge(age)
+			}
+		},
+		OnCacheRefreshed:            func() { s.load().emitCacheRefreshed() },
+		OnConcurrencyRejected:       func() { s.load().emitConcurrencyRejected() },
+		OnConcurrencyLimitChanged:   func(limit int) { s.load().emitConcurrencyLimitChanged(limit) },
+		OnThrottled:                 func() { s.load().emitThrottled() },
+		OnSLOShed:                   func() { s.load().emitSLOShed() },
+		OnLoadShed:                  func() { s.load().emitLoadShed() },
+		OnRateAdapted:               func(rate float64) { s.load().emitRateAdapted(rate) },
+		OnSlowCallRateExceeded:      func() { s.load().emitSlowCallRateExceeded() },
+		OnPanic:                     func(value any) { s.load().emitPanic(value) },
+		OnConcurrencyBudgetExceeded: func() { s.load().emitConcurrencyBudgetExceeded() },
+		OnChaosInjected:             func(kind string) { s.load().emitChaosInjected(kind) },
+		OnOutcome: func(outcome Outcome) {
+			if h := s.load(); h.OnOutcome != nil {
+				h.OnOutcome(outcome)
+			}
+		},
+		OnAttemptStart: func(attempt int) { s.load().emitAttemptStart(attempt) },
+		OnAttemptEnd: func(attempt int, d time.Duration, err error) {
+			s.load().emitAttemptEnd(attempt, d, err)
+		},
+	}
+}
+
+// mergeHooks returns hooks firing, for every event, a's callback and then b's.
+func mergeHooks(a, b *Hooks) Hooks {
+	return Hooks{
+		OnRetry:                     then2(a.OnRetry, b.OnRetry),
+		OnCircuitOpen:               then0(a.OnCircuitOpen, b.OnCircuitOpen),
+		OnCircuitClose:              then0(a.OnCircuitClose, b.OnCircuitClose),
+		OnCircuitHalfOpen:           then0(a.OnCircuitHalfOpen, b.OnCircuitHalfOpen),
+		OnCircuitRamping:            then0(a.OnCircuitRamping, b.OnCircuitRamping),
+		OnCircuitStateChange:        then2(a.OnCircuitStateChange, b.OnCircuitStateChange),
+		OnRateLimited:               then0(a.OnRateLimited, b.OnRateLimited),
+		OnBulkheadFull:              then0(a.OnBulkheadFull, b.OnBulkheadFull),
+		OnBulkheadAcquired:          then0(a.OnBulkheadAcquired, b.OnBulkheadAcquired),
+		OnBulkheadReleased:          then0(a.OnBulkheadReleased, b.OnBulkheadReleased),
+		OnBulkheadQueued:            then0(a.OnBulkheadQueued, b.OnBulkheadQueued),
+		OnBulkheadTimeout:           then0(a.OnBulkheadTimeout, b.OnBulkheadTimeout),
+		OnCoDelShed:                 then0(a.OnCoDelShed, b.OnCoDelShed),
+		OnTimeout:                   then0(a.OnTimeout, b.OnTimeout),
+		OnHedgeTriggered:            then0(a.OnHedgeTriggered, b.OnHedgeTriggered),
+		OnHedgeWon:                  then0(a.OnHedgeWon, b.OnHedgeWon),
+		OnFallbackUsed:              then1(a.OnFallbackUsed, b.OnFallbackUsed),
+		OnHedgeResult:               then2(a.OnHedgeResult, b.OnHedgeResult),
+		OnHedgeWonDetail:            then2(a.OnHedgeWonDetail, b.OnHedgeWonDetail),
+		OnSoftTimeout:               then0(a.OnSoftTimeout, b.OnSoftTimeout),
+		OnFallbackUsedDetailed:      then2(a.OnFallbackUsedDetailed, b.OnFallbackUsedDetailed),
+		OnRetryBudgetExceeded:       then0(a.OnRetryBudgetExceeded, b.OnRetryBudgetExceeded),
+		OnTimeBudgetExceeded:        then0(a.OnTimeBudgetExceeded, b.OnTimeBudgetExceeded),
+		OnRetriesExhausted:          then2(a.OnRetriesExhausted, b.OnRetriesExhausted),
+		OnCoalesceLeader:            then0(a.OnCoalesceLeader, b.OnCoalesceLeader),
+		OnCoalesceFollower:          then0(a.OnCoalesceFollower, b.OnCoalesceFollower),
+		OnCacheHit:                  then0(a.OnCacheHit, b.OnCacheHit),
+		OnCacheMiss:                 then0(a.OnCacheMiss, b.OnCacheMiss),
+		OnCacheStored:               then0(a.OnCacheStored, b.OnCacheStored),
+		OnStaleServed:               then0(a.OnStaleServed, b.OnStaleServed),
+		OnStaleServedAge:            then1(a.OnStaleServedAge, b.OnStaleServedAge),
+		OnCacheRefreshed:            then0(a.OnCacheRefreshed, b.OnCacheRefreshed),
+		OnConcurrencyRejected:       then0(a.OnConcurrencyRejected, b.OnConcurrencyRejected),
+		OnConcurrencyLimitChanged:   then1(a.OnConcurrencyLimitChanged, b.OnConcurrencyLimitChanged),
+		OnThrottled:                 then0(a.OnThrottled, b.OnThrottled),
+		OnSLOShed:                   then0(a.OnSLOShed, b.OnSLOShed),
+		OnLoadShed:                  then0(a.OnLoadShed, b.OnLoadShed),
+		OnRateAdapted:               then1(a.OnRateAdapted, b.OnRateAdapted),
+		OnSlowCallRateExceeded:      then0(a.OnSlowCallRateExceeded, b.OnSlowCallRateExceeded),
+		OnPanic:                     then1(a.OnPanic, b.OnPanic),
+		OnConcurrencyBudgetExceeded: then0(a.OnConcurrencyBudgetExceeded, b.OnConcurrencyBudgetExceeded),
+		OnChaosInjected:             then1(a.OnChaosInjected, b.OnChaosInjected),
+		OnOutcome:                   then1(a.OnOutcome, b.OnOutcome),
+		OnAttemptStart:              then1(a.OnAttemptStart, b.OnAttemptStart),
+		OnAttemptEnd:                then3(a.OnAttemptEnd, b.OnAttemptEnd),
+	}
+}
+
+// then0 returns a callback running first and then second, or the one that is
+// set when the other is nil. then1, then2 and then3 do the same for callbacks
+// taking one, two and three arguments.
+func then0(first, second func()) func() {
+	if first == nil {
+		return second
+	}
+
+	if second == nil {
+		return first
+	}
+
+	return func() {
+		first()
+		second()
+	}
+}
+
+func then1[A any](first, second func(A)) func(A) {
+	if first == nil {
+		return second
+	}
+
+	if second == nil {
+		return first
+	}
+
+	return func(a A) {
+		first(a)
+		second(a)
+	}
+}
+
+func then2[A, B any](first, second func(A, B)) func(A, B) {
+	if first == nil {
+		return second
+	}
+
+	if second == nil {
+		return first
+	}
+
+	return func(a A, b B) {
+		first(a, b)
+		second(a, b)
+	}
+}
+
+func then3[A, B, C any](first, second func(A, B, C)) func(A, B, C) {
+	if first == nil {
+		return second
+	}
+
+	if second == nil {
+		return first
+	}
+
+	return func(a A, b B, c C) {
+		first(a, b, c)
+		second(a, b, c)
+	}
+}
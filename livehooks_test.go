@@ -0,0 +1,144 @@
+package r8e
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// flakyFn returns a function failing n times with a fresh error before
+// succeeding.
+func flakyFn(n int) func(context.Context) (string, error) {
+	var calls atomic.Int64
+
+	return func(context.Context) (string, error) {
+		if calls.Add(1) <= int64(n) {
+			return "", errors.New("transient")
+		}
+
+		return "ok", nil
+	}
+}
+
+func TestAddHookFiresOnNextDo(t *testing.T) {
+	t.Parallel()
+
+	p := NewPolicy[string]("", WithRetry(3, ConstantBackoff(time.Millisecond)))
+
+	_, err := p.Do(context.Background(), flakyFn(1))
+	require.NoError(t, err)
+
+	var retries atomic.Int64
+
+	p.AddHook(Hooks{OnRetry: func(int, error) { retries.Add(1) }})
+
+	_, err = p.Do(context.Background(), flakyFn(2))
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), retries.Load())
+	// The policy's own counters keep counting across the change.
+	assert.Equal(t, int64(3), p.Metrics().Retries)
+}
+
+func TestAddHookKeepsExistingHooks(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu    sync.Mutex
+		order []string
+	)
+
+	record := func(tag string) func(int, error) {
+		return func(int, error) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			order = append(order, tag)
+		}
+	}
+
+	p := NewPolicy[string]("",
+		WithRetry(2, ConstantBackoff(time.Millisecond)),
+		WithHooks(&Hooks{OnRetry: record("original")}),
+	)
+	p.AddHook(Hooks{OnRetry: record("added")})
+
+	_, err := p.Do(context.Background(), flakyFn(1))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"original", "added"}, order)
+}
+
+func TestSetHooksReplacesAndRestores(t *testing.T) {
+	t.Parallel()
+
+	var original, debug atomic.Int64
+
+	p := NewPolicy[string]("",
+		WithRetry(2, ConstantBackoff(time.Millisecond)),
+		WithHooks(&Hooks{OnRetry: func(int, error) { original.Add(1) }}),
+	)
+
+	prev := p.SetHooks(Hooks{OnRetry: func(int, error) { debug.Add(1) }})
+
+	_, err := p.Do(context.Background(), flakyFn(1))
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), original.Load())
+	assert.Equal(t, int64(1), debug.Load())
+
+	p.SetHooks(prev)
+
+	_, err = p.Do(context.Background(), flakyFn(1))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), original.Load())
+	assert.Equal(t, int64(1), debug.Load())
+}
+
+func TestAddHookOutcomeAfterConstruction(t *testing.T) {
+	t.Parallel()
+
+	p := NewPolicy[string]("")
+	require.False(t, p.tracksOutcome())
+
+	var outcomes []Outcome
+
+	p.AddHook(Hooks{OnOutcome: func(o Outcome) { outcomes = append(outcomes, o) }})
+	require.True(t, p.tracksOutcome())
+
+	_, err := p.Do(context.Background(), flakyFn(0))
+	require.NoError(t, err)
+	assert.Equal(t, []Outcome{OutcomeSuccess}, outcomes)
+}
+
+func TestAddHookConcurrentWithDo(t *testing.T) {
+	t.Parallel()
+
+	p := NewPolicy[string]("", WithRetry(2, ConstantBackoff(time.Microsecond)))
+
+	var (
+		wg      sync.WaitGroup
+		retries atomic.Int64
+	)
+
+	for range 8 {
+		wg.Go(func() {
+			for range 50 {
+				_, _ = p.Do(context.Background(), flakyFn(1))
+			}
+		})
+	}
+
+	for range 20 {
+		p.AddHook(Hooks{OnRetry: func(int, error) { retries.Add(1) }})
+	}
+
+	wg.Wait()
+
+	_, err := p.Do(context.Background(), flakyFn(1))
+	require.NoError(t, err)
+	assert.Positive(t, retries.Load())
+}
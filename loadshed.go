@@ -0,0 +1,324 @@
+package r8e
+
+import (
+	"context"
+	"math/rand/v2"
+	"sync"
+	"time"
+)
+
+// ---------------------------------------------------------------------------
+// LoadShedder — latency / queue-depth load shedding
+// ---------------------------------------------------------------------------.
+
+type (
+	// loadShedConfig holds the parameters for a LoadShedder before clamp
+	// validates them and applyConfig stores them on the shedder — the form
+	// options are applied to, in both NewLoadShedder and Reconfigure.
+	loadShedConfig struct {
+		latencyThreshold time.Duration
+		maxInFlight      int
+		maxRate          float64
+		minSamples       int
+	}
+
+	// LoadShedOption configures a LoadShedder / WithLoadShedder.
+	//
+	// Pattern: Functional Options — composable optional settings applied to the
+	// private config, keeping NewLoadShedder's signature stable.
+	LoadShedOption func(*loadShedConfig)
+
+	// LoadShedder is a probabilistic load shedder driven by the caller's own
+	// view of the backend: the recent p99 latency of the calls it forwarded and
+	// the number of calls currently in flight. When either exceeds its
+	// threshold it rejects new calls locally, with [ErrLoadShed], before they
+	// reach the backend.
+	//
+	// The rejection probability grows with the excess over the threshold: a p99
+	// of twice [ShedLatency] sheds half the calls, four times sheds three
+	// quarters, so the forwarded load shrinks in proportion to how far the
+	// backend has slowed down. The in-flight signal ([ShedInFlight]) follows
+	// the same rule against the concurrency limit, and the higher of the two
+	// probabilities applies. It is capped at [ShedMaxRate] (default 0.9) so a
+	// fraction of traffic keeps measuring the backend and recovery is noticed;
+	// the latency signal stays silent until [ShedMinSamples] calls have
+	// completed in the window. As fast calls replace slow ones the p99 falls
+	// back under the threshold and shedding stops.
+	//
+	// Where the [Throttler] reacts to the backend rejecting calls, the load
+	// shedder reacts to it slowing down — the earlier signal of an overload —
+	// and unlike a bulkhead it sheds a fraction of the traffic rather than
+	// everything above a hard limit.
+	//
+	// Latency is measured against the injected [Clock] over a 10s sliding
+	// window, so behaviour is deterministic under a fake clock in tests; the
+	// p99 is refreshed once per second of that window. Construct one with
+	// NewLoadShedder; it is safe for concurrent use.
+	//
+	// Pattern: Load Shedder — sheds requests probabilistically by observed
+	// latency and queue depth, before the backend degrades into errors.
+	LoadShedder struct {
+		clock   Clock
+		hooks   *Hooks
+		latency *windowedPercentile
+		// sampler draws the [0, 1) value compared against the shed probability.
+		// It is rand.Float64 in production and is overridden only by white-box
+		// tests, which must set it before launching any concurrent Allow.
+		sampler          func() float64
+		latencyThreshold time.Duration
+		maxInFlight      int64
+		inFlight         int64
+		minSamples       int64
+		maxRate          float64
+		mu               sync.Mutex
+	}
+)
+
+const (
+	// Default load-shedder parameters. maxRate caps shedding below 1 so the
+	// backend keeps being measured; minSamples gates out small-sample noise.
+	defaultLoadShedMaxRate    = 0.9
+	defaultLoadShedMinSamples = 10
+
+	// loadShedPercentile is the latency percentile compared against the
+	// threshold.
+	loadShedPercentile = 0.99
+)
+
+// ShedLatency sets the p99 latency above which calls start being shed. A
+// non-positive value disables the latency signal. Default: disabled.
+func ShedLatency(threshold time.Duration) LoadShedOption {
+	return func(cfg *loadShedConfig) {
+		cfg.latencyThreshold = threshold
+	}
+}
+
+// ShedInFlight sets the number of concurrent calls above which new calls start
+// being shed. A non-positive value disables the in-flight signal. Default:
+// disabled.
+func ShedInFlight(n int) LoadShedOption {
+	return func(cfg *loadShedConfig) {
+		cfg.maxInFlight = n
+	}
+}
+
+// ShedMaxRate caps the probability of shedding a call, so at least 1-rate of
+// traffic always reaches the backend. Must be in (0, 1]; an out-of-range value
+// resets to the default. Default: 0.9.
+func ShedMaxRate(rate float64) LoadShedOption {
+	return func(cfg *loadShedConfig) {
+		cfg.maxRate = rate
+	}
+}
+
+// ShedMinSamples sets the number of calls that must complete within the
+// latency window before the latency signal can shed. A value below 1 resets to
+// the default. Default: 10.
+func ShedMinSamples(n int) LoadShedOption {
+	return func(cfg *loadShedConfig) {
+		cfg.minSamples = n
+	}
+}
+
+// NewLoadShedder creates a latency / in-flight load shedder. Invalid parameters
+// are clamped to defaults rather than panicking, matching NewThrottler; with
+// neither [ShedLatency] nor [ShedInFlight] set it never sheds. The clock drives
+// the latency window; pass a non-nil Clock (the policy passes its own) and a
+// non-nil *Hooks (the zero value Hooks is fine).
+func NewLoadShedder(
+	clock Clock,
+	hooks *Hooks,
+	opts ...LoadShedOption,
+) *LoadShedder {
+	cfg := loadShedConfig{
+		maxRate:    defaultLoadShedMaxRate,
+		minSamples: defaultLoadShedMinSamples,
+	}
+
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	cfg.clamp()
+
+	ls := &LoadShedder{
+		clock:   clock,
+		hooks:   hooks,
+		latency: newWindowedPercentile(clock),
+		sampler: rand.Float64,
+	}
+	ls.applyConfig(cfg)
+
+	return ls
+}
+
+// applyConfig copies a validated config onto the shedder's live parameters.
+// Call with l.mu held, or before the shedder is published (as NewLoadShedder
+// does).
+func (l *LoadShedder) applyConfig(cfg loadShedConfig) {
+	l.latencyThreshold = cfg.latencyThreshold
+	l.maxInFlight = int64(cfg.maxInFlight)
+	l.maxRate = cfg.maxRate
+	l.minSamples = int64(cfg.minSamples)
+}
+
+// currentConfig snapshots the shedder's live parameters as a loadShedConfig —
+// the read-back mirror of applyConfig. Call with l.mu held.
+func (l *LoadShedder) currentConfig() loadShedConfig {
+	return loadShedConfig{
+		latencyThreshold: l.latencyThreshold,
+		maxInFlight:      int(l.maxInFlight),
+		maxRate:          l.maxRate,
+		minSamples:       int(l.minSamples),
+	}
+}
+
+// clamp repairs out-of-range parameters. A non-positive threshold is kept as
+// the "signal disabled" marker.
+func (c *loadShedConfig) clamp() {
+	if c.latencyThreshold < 0 {
+		c.latencyThreshold = 0
+	}
+
+	if c.maxInFlight < 0 {
+		c.maxInFlight = 0
+	}
+
+	if c.maxRate <= 0 || c.maxRate > 1 {
+		c.maxRate = defaultLoadShedMaxRate
+	}
+
+	if c.minSamples < 1 {
+		c.minSamples = defaultLoadShedMinSamples
+	}
+}
+
+// Allow decides whether to admit a call, honouring the [Sheddability] stamped
+// on ctx exactly as [Throttler.Allow] does. An admitted call is counted as in
+// flight until its [LoadShedder.Record]. On a shed it emits OnLoadShed (outside
+// the lock) and returns [ErrLoadShed], otherwise nil.
+func (l *LoadShedder) Allow(ctx context.Context) error {
+	if l.admit(SheddabilityFromCtx(ctx)) {
+		return nil
+	}
+
+	l.hooks.emitLoadShed()
+
+	return ErrLoadShed
+}
+
+// admit reports whether the calling request is forwarded (true) or shed
+// (false), counting a forwarded one as in flight.
+func (l *LoadShedder) admit(shed Sheddability) bool {
+	latencyProb := l.latencyProbability()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	prob := min(max(latencyProb, l.inFlightProbabilityLocked()), l.maxRate)
+
+	var admitted bool
+
+	switch shed {
+	case SheddabilityNever:
+		admitted = true
+	case SheddabilityAlways:
+		admitted = prob <= 0
+	default:
+		admitted = prob <= 0 || l.sampler() >= prob
+	}
+
+	if admitted {
+		l.inFlight++
+	}
+
+	return admitted
+}
+
+// Record marks an admitted call as finished after elapsed, folding its latency
+// into the window. Every call admitted by [LoadShedder.Allow] must be recorded
+// exactly once; a shed call must not be.
+func (l *LoadShedder) Record(elapsed time.Duration) {
+	l.latency.observe(elapsed)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.inFlight--
+}
+
+// latencyProbability returns the shed probability implied by the recent p99:
+// the fraction of calls to drop so the forwarded share matches threshold/p99.
+// It is zero while the signal is disabled, the window holds fewer than
+// minSamples calls, or the p99 is within the threshold.
+func (l *LoadShedder) latencyProbability() float64 {
+	l.mu.Lock()
+	threshold, minSamples := l.latencyThreshold, l.minSamples
+	l.mu.Unlock()
+
+	if threshold <= 0 {
+		return 0
+	}
+
+	p99, samples := l.latency.estimate(loadShedPercentile)
+	if samples < minSamples || p99 <= threshold {
+		return 0
+	}
+
+	return 1 - float64(threshold)/float64(p99)
+}
+
+// inFlightProbabilityLocked returns the shed probability implied by the calls
+// in flight, counting the caller: zero up to maxInFlight, then the fraction
+// above it. Must be called with l.mu held.
+func (l *LoadShedder) inFlightProbabilityLocked() float64 {
+	if l.maxInFlight <= 0 || l.inFlight < l.maxInFlight {
+		return 0
+	}
+
+	return 1 - float64(l.maxInFlight)/float64(l.inFlight+1)
+}
+
+// RejectionProbability returns the current probability that a call would be
+// shed, as a point-in-time snapshot. Surfaced by Policy.Metrics as a gauge;
+// zero whenever the shedder is letting all traffic through.
+func (l *LoadShedder) RejectionProbability() float64 {
+	latencyProb := l.latencyProbability()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return min(max(latencyProb, l.inFlightProbabilityLocked()), l.maxRate)
+}
+
+// Shedding reports whether the shedder is currently shedding any load (its
+// rejection probability is above zero). Surfaced as a degraded health
+// condition.
+func (l *LoadShedder) Shedding() bool {
+	return l.RejectionProbability() > 0
+}
+
+// InFlight returns the number of admitted calls not yet recorded.
+func (l *LoadShedder) InFlight() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return int(l.inFlight)
+}
+
+// Reconfigure retunes the shedder at runtime. Options are applied on top of the
+// current parameters, so a partial update leaves the others unchanged
+// (matching Throttler.Reconfigure). The latency window and in-flight count are
+// kept. Safe for concurrent use with the admission path.
+func (l *LoadShedder) Reconfigure(opts ...LoadShedOption) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	cfg := l.currentConfig()
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	cfg.clamp()
+	l.applyConfig(cfg)
+}
@@ -0,0 +1,179 @@
+package r8e
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// observeCalls forwards n calls through the shedder with local shedding
+// disabled, each taking latency, all within the current clock epoch.
+func observeCalls(t *testing.T, ls *LoadShedder, n int, latency time.Duration) {
+	t.Helper()
+
+	ls.sampler = neverShed
+
+	for range n {
+		require.NoError(t, ls.Allow(context.Background()))
+		ls.Record(latency)
+	}
+}
+
+func TestNewLoadShedderClampsInvalidParams(t *testing.T) {
+	t.Parallel()
+
+	ls := NewLoadShedder(&stubClock{}, &Hooks{},
+		ShedLatency(-time.Second),
+		ShedInFlight(-1),
+		ShedMaxRate(1.5),
+		ShedMinSamples(0),
+	)
+
+	assert.Zero(t, ls.latencyThreshold)
+	assert.Zero(t, ls.maxInFlight)
+	assert.InEpsilon(t, defaultLoadShedMaxRate, ls.maxRate, 1e-9)
+	assert.Equal(t, int64(defaultLoadShedMinSamples), ls.minSamples)
+}
+
+func TestLoadShedderWithoutThresholdNeverSheds(t *testing.T) {
+	t.Parallel()
+
+	ls := NewLoadShedder(newPolicyClock(), &Hooks{})
+	observeCalls(t, ls, 50, time.Minute)
+
+	assert.Zero(t, ls.RejectionProbability())
+}
+
+func TestLoadShedderFollowsLatency(t *testing.T) {
+	t.Parallel()
+
+	clk := newPolicyClock()
+	ls := NewLoadShedder(clk, &Hooks{}, ShedLatency(100*time.Millisecond))
+
+	// The p99 is refreshed once per window epoch, so each phase steps the clock
+	// past one before reading the probability.
+	phase := func(latency time.Duration) float64 {
+		observeCalls(t, ls, 100, latency)
+		clk.advance(time.Second)
+
+		return ls.RejectionProbability()
+	}
+
+	assert.Zero(t, phase(50*time.Millisecond), "p99 under the threshold")
+
+	slow := phase(200 * time.Millisecond)
+	assert.InDelta(t, 0.5, slow, 0.05, "p99 at twice the threshold sheds half")
+
+	slower := phase(400 * time.Millisecond)
+	assert.Greater(t, slower, slow, "rising latency sheds more")
+	assert.LessOrEqual(t, slower, defaultLoadShedMaxRate)
+
+	// Once the slow samples age out of the window, shedding stops.
+	clk.advance(defaultLatencyWindow)
+	assert.Zero(t, phase(50*time.Millisecond), "latency recovered")
+}
+
+func TestLoadShedderLatencyNeedsMinSamples(t *testing.T) {
+	t.Parallel()
+
+	clk := newPolicyClock()
+	ls := NewLoadShedder(clk, &Hooks{},
+		ShedLatency(10*time.Millisecond), ShedMinSamples(20))
+
+	observeCalls(t, ls, 19, time.Second)
+	clk.advance(time.Second)
+	assert.Zero(t, ls.RejectionProbability())
+
+	observeCalls(t, ls, 1, time.Second)
+	clk.advance(time.Second)
+	assert.Positive(t, ls.RejectionProbability())
+}
+
+func TestLoadShedderInFlight(t *testing.T) {
+	t.Parallel()
+
+	var shed atomic.Int64
+
+	ls := NewLoadShedder(newPolicyClock(),
+		&Hooks{OnLoadShed: func() { shed.Add(1) }},
+		ShedInFlight(2))
+	ls.sampler = alwaysShed
+
+	require.NoError(t, ls.Allow(context.Background()))
+	require.NoError(t, ls.Allow(context.Background()))
+	assert.Equal(t, 2, ls.InFlight())
+
+	require.ErrorIs(t, ls.Allow(context.Background()), ErrLoadShed)
+	assert.Equal(t, int64(1), shed.Load())
+	assert.Equal(t, 2, ls.InFlight(), "a shed call is not in flight")
+
+	require.NoError(t, ls.Allow(WithSheddability(context.Background(), SheddabilityNever)),
+		"critical calls are never shed")
+
+	ls.Record(time.Millisecond)
+	ls.Record(time.Millisecond)
+	assert.Zero(t, ls.RejectionProbability())
+	require.NoError(t, ls.Allow(context.Background()))
+}
+
+func TestLoadShedderReconfigure(t *testing.T) {
+	t.Parallel()
+
+	clk := newPolicyClock()
+	ls := NewLoadShedder(clk, &Hooks{}, ShedLatency(100*time.Millisecond))
+	observeCalls(t, ls, 20, 200*time.Millisecond)
+	clk.advance(time.Second)
+	require.Positive(t, ls.RejectionProbability())
+
+	ls.Reconfigure(ShedLatency(time.Second))
+	assert.Zero(t, ls.RejectionProbability())
+	assert.InEpsilon(t, defaultLoadShedMaxRate, ls.maxRate, 1e-9, "other parameters kept")
+}
+
+func TestPolicyLoadShedder(t *testing.T) {
+	t.Parallel()
+
+	clk := newPolicyClock()
+	p := NewPolicy[string]("load-shed",
+		WithClock(clk),
+		WithLoadShedder(ShedLatency(100*time.Millisecond), ShedMinSamples(5)),
+	)
+	assert.Equal(t, []string{"load_shed"}, p.Patterns())
+
+	// Each call takes 400ms of policy-clock time.
+	slow := func(context.Context) (string, error) {
+		clk.advance(400 * time.Millisecond)
+
+		return "ok", nil
+	}
+
+	for range 5 {
+		_, err := p.Do(context.Background(), slow)
+		require.NoError(t, err)
+	}
+
+	clk.advance(time.Second)
+	p.loadShedder.sampler = alwaysShed
+
+	var called bool
+
+	_, err := p.Do(context.Background(), func(context.Context) (string, error) {
+		called = true
+
+		return "ok", nil
+	})
+	require.ErrorIs(t, err, ErrLoadShed)
+	assert.False(t, called, "a shed call never reaches the downstream")
+
+	metrics := p.Metrics()
+	assert.InDelta(t, 0.75, metrics.LoadShedProbability, 0.05)
+	assert.Equal(t, int64(1), metrics.LoadShed)
+
+	status := p.HealthStatus()
+	assert.Contains(t, status.Conditions, ConditionLoadShedding)
+	assert.True(t, status.Healthy, "load shedding is degraded, not critical")
+}
@@ -0,0 +1,485 @@
+package r8e
+
+import (
+	"context"
+	"log/slog"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ---------------------------------------------------------------------------
+// Structured logging — resilience events to log/slog
+// ---------------------------------------------------------------------------.
+
+type (
+	// EventType names a resilience lifecycle event for logging. Each value
+	// corresponds to one [Hooks] callback and is used both as the log message
+	// and as the key of the level map given to [WithLogLevels].
+	EventType string
+
+	// policyLogger writes resilience events for one policy to a slog.Logger,
+	// at the level its resolved level map assigns to each event.
+	policyLogger struct {
+		logger *slog.Logger
+		levels map[EventType]slog.Level
+		// coalescer, when non-nil, caps repeated failure records (see
+		// WithErrorCoalescing).
+		coalescer *logCoalescer
+		policy    string
+		label     string // added as a "label" attribute when set (see WithLabel)
+	}
+
+	// logCoalescer tracks one window per failure event: the first record of
+	// a window is written, the rest are only counted and reported as one
+	// summary once the window has ended.
+	logCoalescer struct {
+		clock   Clock
+		windows map[EventType]*coalesceWindow
+		window  time.Duration
+		mu      sync.Mutex
+	}
+
+	// coalesceWindow is the open window of one event.
+	coalesceWindow struct {
+		start      time.Time
+		suppressed int
+	}
+
+	// coalesceSummary reports the records suppressed during a closed window.
+	coalesceSummary struct {
+		event      EventType
+		suppressed int
+	}
+)
+
+// Resilience event types, one per [Hooks] callback.
+const (
+	EventRetry                     EventType = "retry"
+	EventCircuitOpen               EventType = "circuit_open"
+	EventCircuitClose              EventType = "circuit_close"
+	EventCircuitHalfOpen           EventType = "circuit_half_open"
+	EventCircuitRamping            EventType = "circuit_ramping"
+	EventCircuitStateChange        EventType = "circuit_state_change"
+	EventRateLimited               EventType = "rate_limited"
+	EventBulkheadFull              EventType = "bulkhead_full"
+	EventBulkheadAcquired          EventType = "bulkhead_acquired"
+	EventBulkheadReleased          EventType = "bulkhead_released"
+	EventBulkheadQueued            EventType = "bulkhead_queued"
+	EventBulkheadTimeout           EventType = "bulkhead_timeout"
+	EventCoDelShed                 EventType = "codel_shed"
+	EventTimeout                   EventType = "timeout"
+	EventSoftTimeout               EventType = "soft_timeout"
+	EventHedgeTriggered            EventType = "hedge_triggered"
+	EventHedgeWon                  EventType = "hedge_won"
+	EventHedgeResult               EventType = "hedge_result"
+	EventHedgeWonDetail            EventType = "hedge_won_detail"
+	EventFallbackUsed              EventType = "fallback_used"
+	EventFallbackUsedDetailed      EventType = "fallback_used_detailed"
+	EventRetryBudgetExceeded       EventType = "retry_budget_exceeded"
+	EventTimeBudgetExceeded        EventType = "time_budget_exceeded"
+	EventRetriesExhausted          EventType = "retries_exhausted"
+	EventCoalesceLeader            EventType = "coalesce_leader"
+	EventCoalesceFollower          EventType = "coalesce_follower"
+	EventCacheHit                  EventType = "cache_hit"
+	EventCacheMiss                 EventType = "cache_miss"
+	EventCacheStored               EventType = "cache_stored"
+	EventStaleServed               EventType = "stale_served"
+	EventStaleServedAge            EventType = "stale_served_age"
+	EventCacheRefreshed            EventType = "cache_refreshed"
+	EventConcurrencyRejected       EventType = "concurrency_rejected"
+	EventConcurrencyLimitChanged   EventType = "concurrency_limit_changed"
+	EventThrottled                 EventType = "throttled"
+	EventSLOShed                   EventType = "slo_shed"
+	EventLoadShed                  EventType = "load_shed"
+	EventRateAdapted               EventType = "rate_adapted"
+	EventSlowCallRateExceeded      EventType = "slow_call_rate_exceeded"
+	EventPanic                     EventType = "panic"
+	EventConcurrencyBudgetExceeded EventType = "concurrency_budget_exceeded"
+	EventChaosInjected             EventType = "chaos_injected"
+	EventOutcome                   EventType = "outcome"
+	EventAttemptStart              EventType = "attempt_start"
+	EventAttemptEnd                EventType = "attempt_end"
+)
+
+// defaultLogLevels is the level each event logs at unless overridden with
+// [WithLogLevels]: Warn for failures and load shedding, Info for recovery and
+// controller retuning, Error for recovered panics, and Debug for per-call
+// bookkeeping (hedges, cache and coalescing traffic, bulkhead slots, chaos).
+//
+//nolint:gochecknoglobals // read-only lookup table, never mutated after init
+var defaultLogLevels = map[EventType]slog.Level{
+	EventRetry:                     slog.LevelWarn,
+	EventCircuitOpen:               slog.LevelWarn,
+	EventCircuitClose:              slog.LevelInfo,
+	EventCircuitHalfOpen:           slog.LevelInfo,
+	EventCircuitRamping:            slog.LevelInfo,
+	EventCircuitStateChange:        slog.LevelDebug,
+	EventRateLimited:               slog.LevelWarn,
+	EventBulkheadFull:              slog.LevelWarn,
+	EventBulkheadAcquired:          slog.LevelDebug,
+	EventBulkheadReleased:          slog.LevelDebug,
+	EventBulkheadQueued:            slog.LevelDebug,
+	EventBulkheadTimeout:           slog.LevelWarn,
+	EventCoDelShed:                 slog.LevelWarn,
+	EventTimeout:                   slog.LevelWarn,
+	EventSoftTimeout:               slog.LevelWarn,
+	EventHedgeTriggered:            slog.LevelDebug,
+	EventHedgeWon:                  slog.LevelDebug,
+	EventHedgeResult:               slog.LevelDebug,
+	EventHedgeWonDetail:            slog.LevelDebug,
+	EventFallbackUsed:              slog.LevelWarn,
+	EventFallbackUsedDetailed:      slog.LevelDebug,
+	EventRetryBudgetExceeded:       slog.LevelWarn,
+	EventTimeBudgetExceeded:        slog.LevelWarn,
+	EventRetriesExhausted:          slog.LevelWarn,
+	EventCoalesceLeader:            slog.LevelDebug,
+	EventCoalesceFollower:          slog.LevelDebug,
+	EventCacheHit:                  slog.LevelDebug,
+	EventCacheMiss:                 slog.LevelDebug,
+	EventCacheStored:               slog.LevelDebug,
+	EventStaleServed:               slog.LevelWarn,
+	EventStaleServedAge:            slog.LevelDebug,
+	EventCacheRefreshed:            slog.LevelDebug,
+	EventConcurrencyRejected:       slog.LevelWarn,
+	EventConcurrencyLimitChanged:   slog.LevelInfo,
+	EventThrottled:                 slog.LevelWarn,
+	EventSLOShed:                   slog.LevelWarn,
+	EventLoadShed:                  slog.LevelWarn,
+	EventRateAdapted:               slog.LevelInfo,
+	EventSlowCallRateExceeded:      slog.LevelWarn,
+	EventPanic:                     slog.LevelError,
+	EventConcurrencyBudgetExceeded: slog.LevelWarn,
+	EventChaosInjected:             slog.LevelDebug,
+	EventOutcome:                   slog.LevelDebug,
+	EventAttemptStart:              slog.LevelDebug,
+	EventAttemptEnd:                slog.LevelDebug,
+}
+
+// WithLogger logs every resilience event of the policy to logger, in addition
+// to any [Hooks]. Each record's message is the [EventType], carries a "policy"
+// attribute (and a "label" one with [WithLabel]), and adds the event's
+// arguments where it has any ("attempt" and "err" for retries, "attempts" and
+// "err" for exhausted retries, "err" for fallbacks, "leg" and "err" for hedge
+// legs, "age" for stale values, "outcome", "limit", "rate", "value", "kind").
+// Levels follow sensible defaults — Warn for failures and shedding, Info for
+// recovery, Debug for per-call bookkeeping — and can be changed per event with
+// [WithLogLevels]. A nil logger is ignored.
+func WithLogger(logger *slog.Logger) Option {
+	return optionFunc(func(s *policySetup) {
+		if logger != nil {
+			s.logger = logger
+		}
+	})
+}
+
+// WithLogLevels overrides the level individual events log at under
+// [WithLogger], e.g. {EventRateLimited: slog.LevelInfo} to stop treating rate
+// limiting as a warning. Events absent from levels keep their default level;
+// repeated calls merge, later entries winning. It has no effect without a
+// logger. The map is copied, so later changes to it do not affect the policy.
+func WithLogLevels(levels map[EventType]slog.Level) Option {
+	return optionFunc(func(s *policySetup) {
+		if s.logLevels == nil {
+			s.logLevels = make(map[EventType]slog.Level, len(levels))
+		}
+
+		maps.Copy(s.logLevels, levels)
+	})
+}
+
+// WithErrorCoalescing bounds the log volume of a sustained outage under
+// [WithLogger]. Within each window, only the first record of every event
+// logged at Warn or above (retries, timeouts, fallbacks, shed calls, ...) is
+// written; the others are counted, and once the window has ended the count is
+// logged as one summary record: the event as message, at its level, with
+// "suppressed" (e.g. 1423) and "window" (e.g. 10s) attributes. A summary is
+// written by the first event logged after its window ends, so a steady outage
+// yields one record plus one summary per event per window. Info and Debug
+// events, hooks, and metrics are unaffected. A non-positive window disables
+// coalescing, the default.
+func WithErrorCoalescing(window time.Duration) Option {
+	return optionFunc(func(s *policySetup) {
+		s.errorCoalescing = window
+	})
+}
+
+// newPolicyLogger resolves the setup's level overrides over the defaults. It
+// returns nil when no logger is configured.
+func newPolicyLogger(name string, setup *policySetup) *policyLogger {
+	if setup.logger == nil {
+		return nil
+	}
+
+	levels := maps.Clone(defaultLogLevels)
+	maps.Copy(levels, setup.logLevels)
+
+	l := &policyLogger{
+		logger: setup.logger,
+		levels: levels,
+		policy: name,
+		label:  setup.label,
+	}
+	if setup.errorCoalescing > 0 {
+		l.coalescer = &logCoalescer{
+			clock:   setup.clock,
+			windows: make(map[EventType]*coalesceWindow),
+			window:  setup.errorCoalescing,
+		}
+	}
+
+	return l
+}
+
+// log writes one event record when the logger is enabled at the event's level;
+// the attributes are only built past that check. With coalescing enabled it
+// first writes the summaries of any ended windows, then drops the record if
+// the event's window is still open.
+func (l *policyLogger) log(event EventType, attrs ...slog.Attr) {
+	level := l.levels[event]
+
+	ctx := context.Background()
+	if !l.logger.Enabled(ctx, level) {
+		return
+	}
+
+	if l.coalescer != nil {
+		write, summaries := l.coalescer.admit(event, level >= slog.LevelWarn)
+		for _, s := range summaries {
+			l.logger.LogAttrs(ctx, l.levels[s.event], string(s.event),
+				append(l.identity(),
+					slog.Int("suppressed", s.suppressed),
+					slog.Duration("window", l.coalescer.window))...)
+		}
+
+		if !write {
+			return
+		}
+	}
+
+	l.logger.LogAttrs(ctx, level, string(event), append(l.identity(), attrs...)...)
+}
+
+// identity returns the attributes naming the policy on every record: "policy",
+// and "label" when the policy has one.
+func (l *policyLogger) identity() []slog.Attr {
+	if l.label == "" {
+		return []slog.Attr{slog.String("policy", l.policy)}
+	}
+
+	return []slog.Attr{slog.String("policy", l.policy), slog.String("label", l.label)}
+}
+
+// admit closes every window that has ended, returning the summaries of those
+// that suppressed records, and reports whether event's record is written: an
+// event not subject to coalescing always is, a failure event only when it
+// opens a new window.
+func (c *logCoalescer) admit(event EventType, coalesced bool) (bool, []coalesceSummary) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.clock.Now()
+
+	var summaries []coalesceSummary
+
+	for ev, w := range c.windows {
+		if now.Sub(w.start) < c.window {
+			continue
+		}
+
+		if w.suppressed > 0 {
+			summaries = append(summaries, coalesceSummary{event: ev, suppressed: w.suppressed})
+		}
+
+		delete(c.windows, ev)
+	}
+
+	// Map order is random; keep the summaries stable for readers.
+	slices.SortFunc(summaries, func(a, b coalesceSummary) int {
+		return strings.Compare(string(a.event), string(b.event))
+	})
+
+	if !coalesced {
+		return true, summaries
+	}
+
+	if w, open := c.windows[event]; open {
+		w.suppressed++
+
+		return false, summaries
+	}
+
+	c.windows[event] = &coalesceWindow{start: now}
+
+	return true, summaries
+}
+
+// loggingHook returns a no-argument hook that logs event and then, if set,
+// forwards to the caller's hook.
+func (l *policyLogger) loggingHook(event EventType, user func()) func() {
+	return func() {
+		l.log(event)
+
+		if user != nil {
+			user()
+		}
+	}
+}
+
+// wrap returns hooks that log every event before forwarding to user.
+//
+// Pattern: Decorator — like [policyMetrics.instrument], it preserves the Hooks
+// shape so the wrapped value is substitutable for the caller's.
+//
+//nolint:funlen // one literal entry per hook keeps the mapping auditable
+func (l *policyLogger) wrap(user *Hooks) Hooks {
+	return Hooks{
+		OnRetry: func(attempt int, err error) {
+			l.log(EventRetry, slog.Int("attempt", attempt), slog.Any("err", err))
+
+			if user.OnRetry != nil {
+				user.OnRetry(attempt, err)
+			}
+		},
+		OnCircuitOpen:     l.loggingHook(EventCircuitOpen, user.OnCircuitOpen),
+		OnCircuitClose:    l.loggingHook(EventCircuitClose, user.OnCircuitClose),
+		OnCircuitHalfOpen: l.loggingHook(EventCircuitHalfOpen, user.OnCircuitHalfOpen),
+		OnCircuitRamping:  l.loggingHook(EventCircuitRamping, user.OnCircuitRamping),
+
+		OnCircuitStateChange: func(from, to CircuitState) {
+			l.log(EventCircuitStateChange,
+				slog.String("from", string(from)), slog.String("to", string(to)))
+
+			if user.OnCircuitStateChange != nil {
+				user.OnCircuitStateChange(from, to)
+			}
+		},
+
+		OnRateLimited:      l.loggingHook(EventRateLimited, user.OnRateLimited),
+		OnBulkheadFull:     l.loggingHook(EventBulkheadFull, user.OnBulkheadFull),
+		OnBulkheadAcquired: l.loggingHook(EventBulkheadAcquired, user.OnBulkheadAcquired),
+		OnBulkheadReleased: l.loggingHook(EventBulkheadReleased, user.OnBulkheadReleased),
+		OnBulkheadQueued:   l.loggingHook(EventBulkheadQueued, user.OnBulkheadQueued),
+		OnBulkheadTimeout:  l.loggingHook(EventBulkheadTimeout, user.OnBulkheadTimeout),
+		OnCoDelShed:        l.loggingHook(EventCoDelShed, user.OnCoDelShed),
+		OnTimeout:          l.loggingHook(EventTimeout, user.OnTimeout),
+		OnSoftTimeout:      l.loggingHook(EventSoftTimeout, user.OnSoftTimeout),
+		OnHedgeTriggered:   l.loggingHook(EventHedgeTriggered, user.OnHedgeTriggered),
+		OnHedgeWon:         l.loggingHook(EventHedgeWon, user.OnHedgeWon),
+		OnHedgeResult: func(leg int, err error) {
+			l.log(EventHedgeResult, slog.Int("leg", leg), slog.Any("err", err))
+
+			if user.OnHedgeResult != nil {
+				user.OnHedgeResult(leg, err)
+			}
+		},
+		OnHedgeWonDetail: func(leg int, latency time.Duration) {
+			l.log(EventHedgeWonDetail, slog.Int("leg", leg), slog.Duration("latency", latency))
+
+			if user.OnHedgeWonDetail != nil {
+				user.OnHedgeWonDetail(leg, latency)
+			}
+		},
+		OnFallbackUsed: func(err error) {
+			l.log(EventFallbackUsed, slog.Any("err", err))
+
+			if user.OnFallbackUsed != nil {
+				user.OnFallbackUsed(err)
+			}
+		},
+		OnFallbackUsedDetailed: func(finalErr, rootErr error) {
+			l.log(EventFallbackUsedDetailed, slog.Any("err", finalErr), slog.Any("root", rootErr))
+
+			if user.OnFallbackUsedDetailed != nil {
+				user.OnFallbackUsedDetailed(finalErr, rootErr)
+			}
+		},
+		OnRetryBudgetExceeded: l.loggingHook(EventRetryBudgetExceeded, user.OnRetryBudgetExceeded),
+		OnTimeBudgetExceeded:  l.loggingHook(EventTimeBudgetExceeded, user.OnTimeBudgetExceeded),
+
+		OnRetriesExhausted: func(attempts int, lastErr error) {
+			l.log(EventRetriesExhausted, slog.Int("attempts", attempts), slog.Any("err", lastErr))
+
+			if user.OnRetriesExhausted != nil {
+				user.OnRetriesExhausted(attempts, lastErr)
+			}
+		},
+
+		OnCoalesceLeader:   l.loggingHook(EventCoalesceLeader, user.OnCoalesceLeader),
+		OnCoalesceFollower: l.loggingHook(EventCoalesceFollower, user.OnCoalesceFollower),
+		OnCacheHit:         l.loggingHook(EventCacheHit, user.OnCacheHit),
+		OnCacheMiss:        l.loggingHook(EventCacheMiss, user.OnCacheMiss),
+		OnCacheStored:      l.loggingHook(EventCacheStored, user.OnCacheStored),
+		OnStaleServed:      l.loggingHook(EventStaleServed, user.OnStaleServed),
+
+		OnStaleServedAge: func(age time.Duration) {
+			l.log(EventStaleServedAge, slog.Duration("age", age))
+
+			if user.OnStaleServedAge != nil {
+				user.OnStaleServedAge(age)
+			}
+		},
+
+		OnCacheRefreshed:      l.loggingHook(EventCacheRefreshed, user.OnCacheRefreshed),
+		OnConcurrencyRejected: l.loggingHook(EventConcurrencyRejected, user.OnConcurrencyRejected),
+		OnConcurrencyLimitChanged: func(limit int) {
+			l.log(EventConcurrencyLimitChanged, slog.Int("limit", limit))
+
+			if user.OnConcurrencyLimitChanged != nil {
+				user.OnConcurrencyLimitChanged(limit)
+			}
+		},
+		OnThrottled: l.loggingHook(EventThrottled, user.OnThrottled),
+		OnSLOShed:   l.loggingHook(EventSLOShed, user.OnSLOShed),
+		OnLoadShed:  l.loggingHook(EventLoadShed, user.OnLoadShed),
+		OnRateAdapted: func(rate float64) {
+			l.log(EventRateAdapted, slog.Float64("rate", rate))
+
+			if user.OnRateAdapted != nil {
+				user.OnRateAdapted(rate)
+			}
+		},
+		OnSlowCallRateExceeded: l.loggingHook(EventSlowCallRateExceeded, user.OnSlowCallRateExceeded),
+		OnPanic: func(value any) {
+			l.log(EventPanic, slog.Any("value", value))
+
+			if user.OnPanic != nil {
+				user.OnPanic(value)
+			}
+		},
+		OnConcurrencyBudgetExceeded: l.loggingHook(
+			EventConcurrencyBudgetExceeded, user.OnConcurrencyBudgetExceeded,
+		),
+		OnChaosInjected: func(kind string) {
+			l.log(EventChaosInjected, slog.String("kind", kind))
+
+			if user.OnChaosInjected != nil {
+				user.OnChaosInjected(kind)
+			}
+		},
+		OnOutcome: func(outcome Outcome) {
+			l.log(EventOutcome, slog.String("outcome", string(outcome)))
+
+			if user.OnOutcome != nil {
+				user.OnOutcome(outcome)
+			}
+		},
+		OnAttemptStart: func(attempt int) {
+			l.log(EventAttemptStart, slog.Int("attempt", attempt))
+
+			if user.OnAttemptStart != nil {
+				user.OnAttemptStart(attempt)
+			}
+		},
+		OnAttemptEnd: func(attempt int, d time.Duration, err error) {
+			l.log(EventAttemptEnd,
+				slog.Int("attempt", attempt), slog.Duration("duration", d), slog.Any("error", err))
+
+			if user.OnAttemptEnd != nil {
+				user.OnAttemptEnd(attempt, d, err)
+			}
+		},
+	}
+}
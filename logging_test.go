@@ -0,0 +1,346 @@
+package r8e
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ---------------------------------------------------------------------------
+// recordingHandler — captures slog records for assertions
+// ---------------------------------------------------------------------------
+
+type recordingHandler struct {
+	mu      sync.Mutex
+	records []slog.Record
+	level   slog.Level
+}
+
+func (h *recordingHandler) Enabled(_ context.Context, l slog.Level) bool {
+	return l >= h.level
+}
+
+func (h *recordingHandler) Handle(_ context.Context, r slog.Record) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.records = append(h.records, r.Clone())
+
+	return nil
+}
+
+func (h *recordingHandler) WithAttrs([]slog.Attr) slog.Handler { return h }
+func (h *recordingHandler) WithGroup(string) slog.Handler      { return h }
+
+// levels returns the level of every record, keyed by message.
+func (h *recordingHandler) levels() map[string]slog.Level {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	out := make(map[string]slog.Level, len(h.records))
+	for _, r := range h.records {
+		out[r.Message] = r.Level
+	}
+
+	return out
+}
+
+// attrs returns the attributes of the first record with the given message.
+func (h *recordingHandler) attrs(msg string) map[string]slog.Value {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for _, r := range h.records {
+		if r.Message != msg {
+			continue
+		}
+
+		out := make(map[string]slog.Value)
+		r.Attrs(func(a slog.Attr) bool {
+			out[a.Key] = a.Value
+
+			return true
+		})
+
+		return out
+	}
+
+	return nil
+}
+
+// ---------------------------------------------------------------------------
+// WithLogger / WithLogLevels
+// ---------------------------------------------------------------------------
+
+func TestWithLoggerDefaultLevels(t *testing.T) {
+	t.Parallel()
+
+	h := &recordingHandler{level: slog.LevelDebug}
+	p := NewPolicy[string]("logged",
+		WithClock(newImmediateTestClock()),
+		WithLogger(slog.New(h)),
+		WithRetry(2, ConstantBackoff(time.Millisecond)),
+		WithFallback("fb"),
+	)
+
+	boom := errors.New("boom")
+	_, err := p.Do(context.Background(), func(_ context.Context) (string, error) {
+		return "", boom
+	})
+	require.NoError(t, err)
+
+	levels := h.levels()
+	assert.Equal(t, slog.LevelWarn, levels[string(EventRetry)])
+	assert.Equal(t, slog.LevelWarn, levels[string(EventFallbackUsed)])
+
+	attrs := h.attrs(string(EventRetry))
+	require.NotNil(t, attrs)
+	assert.Equal(t, "logged", attrs["policy"].String())
+	assert.Equal(t, int64(1), attrs["attempt"].Int64())
+	assert.ErrorIs(t, attrs["err"].Any().(error), boom) //nolint:forcetypeassert // test
+}
+
+func TestWithLoggerStaleServedAge(t *testing.T) {
+	t.Parallel()
+
+	h := &recordingHandler{level: slog.LevelDebug}
+	clk := newPolicyClock()
+	p := NewPolicy[string]("stale-logged",
+		WithClock(clk),
+		WithLogger(slog.New(h)),
+		WithCache(newMemCache[CacheEntry[string]](),
+			func(context.Context) string { return "k" }, time.Minute,
+			StaleIfError(time.Hour)),
+	)
+
+	_, err := p.Do(context.Background(), func(context.Context) (string, error) {
+		return "good", nil
+	})
+	require.NoError(t, err)
+
+	clk.advance(5 * time.Minute)
+
+	got, err := p.Do(context.Background(), func(context.Context) (string, error) {
+		return "", errors.New("down")
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "good", got)
+
+	levels := h.levels()
+	assert.Equal(t, slog.LevelWarn, levels[string(EventStaleServed)])
+	assert.Equal(t, slog.LevelDebug, levels[string(EventStaleServedAge)])
+
+	attrs := h.attrs(string(EventStaleServedAge))
+	require.NotNil(t, attrs)
+	assert.Equal(t, "stale-logged", attrs["policy"].String())
+	assert.Equal(t, 5*time.Minute, attrs["age"].Duration())
+}
+
+func TestWithLogLevelsOverridesAndFallsBack(t *testing.T) {
+	t.Parallel()
+
+	h := &recordingHandler{level: slog.LevelDebug}
+	opts := []Option{
+		WithClock(newImmediateTestClock()),
+		WithLogger(slog.New(h)),
+		WithLogLevels(map[EventType]slog.Level{EventRateLimited: slog.LevelInfo}),
+		WithLogLevels(map[EventType]slog.Level{EventCircuitOpen: slog.LevelError}),
+	}
+
+	fail := func(_ context.Context) (string, error) { return "", errors.New("down") }
+
+	limited := NewPolicy[string]("limited", append(opts,
+		WithRateLimit(1),
+		WithRetry(2, ConstantBackoff(time.Millisecond)),
+	)...)
+	_, _ = limited.Do(context.Background(), fail) // spends the token, retries
+	_, _ = limited.Do(context.Background(), fail) // rate limited
+
+	breaker := NewPolicy[string]("breaker", append(opts,
+		WithCircuitBreaker(FailureThreshold(1)),
+	)...)
+	_, _ = breaker.Do(context.Background(), fail) // opens the breaker
+
+	levels := h.levels()
+	assert.Equal(t, slog.LevelInfo, levels[string(EventRateLimited)])
+	assert.Equal(t, slog.LevelError, levels[string(EventCircuitOpen)])
+	// Not overridden: the default applies.
+	assert.Equal(t, slog.LevelWarn, levels[string(EventRetry)])
+}
+
+func TestWithLoggerRespectsHandlerLevel(t *testing.T) {
+	t.Parallel()
+
+	h := &recordingHandler{level: slog.LevelInfo}
+	p := NewPolicy[string]("quiet",
+		WithLogger(slog.New(h)),
+		WithLogLevels(map[EventType]slog.Level{
+			EventRetry:            slog.LevelDebug,
+			EventRetriesExhausted: slog.LevelDebug,
+		}),
+		WithClock(newImmediateTestClock()),
+		WithRetry(3, ConstantBackoff(time.Millisecond)),
+	)
+
+	_, _ = p.Do(context.Background(), func(_ context.Context) (string, error) {
+		return "", errors.New("down")
+	})
+
+	assert.Empty(t, h.levels())
+}
+
+func TestWithLoggerNilAndUserHooks(t *testing.T) {
+	t.Parallel()
+
+	var retries int
+
+	h := &recordingHandler{level: slog.LevelDebug}
+	hooks := &Hooks{OnRetry: func(int, error) { retries++ }}
+
+	for _, logger := range []*slog.Logger{nil, slog.New(h)} {
+		p := NewPolicy[string]("",
+			WithHooks(hooks),
+			WithLogger(logger),
+			WithClock(newImmediateTestClock()),
+			WithRetry(2, ConstantBackoff(time.Millisecond)),
+		)
+
+		_, _ = p.Do(context.Background(), func(_ context.Context) (string, error) {
+			return "", errors.New("down")
+		})
+	}
+
+	// The user hook fires with and without a logger; only the second policy
+	// logged.
+	assert.Equal(t, 2, retries)
+	assert.Len(t, h.levels(), 5,
+		"retry, retries_exhausted, outcome, attempt_start and attempt_end")
+}
+
+// TestPolicyLoggerWrapsEveryHook guards against a new Hooks field being added
+// without a logging entry: every field of the wrapped Hooks must be set.
+func TestPolicyLoggerWrapsEveryHook(t *testing.T) {
+	t.Parallel()
+
+	l := newPolicyLogger("p", &policySetup{logger: slog.New(&recordingHandler{})})
+	wrapped := reflect.ValueOf(l.wrap(&Hooks{}))
+
+	for i := range wrapped.NumField() {
+		assert.False(t, wrapped.Field(i).IsNil(),
+			"Hooks.%s is not logged", wrapped.Type().Field(i).Name)
+	}
+
+	assert.Len(t, defaultLogLevels, wrapped.NumField())
+}
+
+// ---------------------------------------------------------------------------
+// WithErrorCoalescing
+// ---------------------------------------------------------------------------
+
+// count returns how many records carry the given message.
+func (h *recordingHandler) count(msg string) int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	n := 0
+
+	for _, r := range h.records {
+		if r.Message == msg {
+			n++
+		}
+	}
+
+	return n
+}
+
+// attrsOf returns the attributes of r keyed by name.
+func attrsOf(r slog.Record) map[string]slog.Value {
+	out := make(map[string]slog.Value)
+	r.Attrs(func(a slog.Attr) bool {
+		out[a.Key] = a.Value
+
+		return true
+	})
+
+	return out
+}
+
+func TestWithErrorCoalescingBoundsOutageLogs(t *testing.T) {
+	t.Parallel()
+
+	h := &recordingHandler{}
+	clk := &originClock{now: time.Now()}
+
+	var hooked int
+
+	p := NewPolicy[string]("coalesced",
+		WithRegistry(NewRegistry()),
+		WithClock(clk),
+		WithLogger(slog.New(h)),
+		WithErrorCoalescing(10*time.Second),
+		WithFallback("stale"),
+		WithHooks(&Hooks{OnFallbackUsed: func(error) { hooked++ }}),
+	)
+
+	fail := func(n int) {
+		for range n {
+			_, _ = p.Do(context.Background(), func(_ context.Context) (string, error) {
+				return "", errors.New("down")
+			})
+		}
+	}
+
+	// A burst inside one window writes a single record.
+	fail(1424)
+	assert.Equal(t, 1, h.count(string(EventFallbackUsed)))
+
+	// Still inside the window: nothing more.
+	clk.advance(9 * time.Second)
+	fail(100)
+	assert.Equal(t, 1, h.count(string(EventFallbackUsed)))
+
+	// The first failure after the window writes the summary, then itself.
+	clk.advance(time.Second)
+	fail(1)
+	require.Equal(t, 3, h.count(string(EventFallbackUsed)))
+
+	h.mu.Lock()
+	summary, next := h.records[1], h.records[2]
+	h.mu.Unlock()
+
+	attrs := attrsOf(summary)
+	assert.Equal(t, slog.LevelWarn, summary.Level)
+	assert.Equal(t, int64(1523), attrs["suppressed"].Int64())
+	assert.Equal(t, 10*time.Second, attrs["window"].Duration())
+	assert.NotContains(t, attrsOf(next), "suppressed", "a new window opens with a plain record")
+
+	// Hooks and metrics still see every failure.
+	assert.Equal(t, 1525, hooked)
+	assert.Equal(t, int64(1525), p.Metrics().FallbacksUsed)
+}
+
+func TestWithErrorCoalescingLeavesInfoEventsAlone(t *testing.T) {
+	t.Parallel()
+
+	h := &recordingHandler{}
+	l := newPolicyLogger("p", &policySetup{
+		logger:          slog.New(h),
+		clock:           &originClock{now: time.Now()},
+		errorCoalescing: time.Minute,
+	})
+
+	for range 5 {
+		l.log(EventCircuitClose) // Info
+		l.log(EventTimeout)      // Warn
+	}
+
+	assert.Equal(t, 5, h.count(string(EventCircuitClose)))
+	assert.Equal(t, 1, h.count(string(EventTimeout)))
+}
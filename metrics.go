@@ -12,6 +12,9 @@ type (
 	PolicyMetrics struct {
 		// Name is the policy name.
 		Name string `json:"name"`
+		// Label is the telemetry label set with [WithLabel]; empty when there
+		// is none.
+		Label string `json:"label,omitempty"`
 		// CircuitState is "closed", "open", or "half_open"; empty if the policy
 		// has no circuit breaker.
 		CircuitState string `json:"circuit_state"`
@@ -19,6 +22,7 @@ type (
 		// Cumulative counters since the policy was created.
 		Retries          int64 `json:"retries"`
 		Timeouts         int64 `json:"timeouts"`
+		SoftTimeouts     int64 `json:"soft_timeouts"` // see [WithTimeoutSoftHard]
 		CircuitOpens     int64 `json:"circuit_opens"`
 		CircuitCloses    int64 `json:"circuit_closes"`
 		CircuitHalfOpens int64 `json:"circuit_half_opens"`
@@ -63,6 +67,9 @@ type (
 		// SLOShed counts calls shed locally by the SLO burn-rate governor to
 		// preserve the error budget while it burns too fast (see [WithSLO]).
 		SLOShed int64 `json:"slo_shed"`
+		// LoadShed counts calls rejected by the load shedder because latency or
+		// the calls in flight exceeded their threshold (see [WithLoadShedder]).
+		LoadShed int64 `json:"load_shed"`
 		// RateAdaptations counts AIMD adjustments to the rate limiter's refill
 		// rate — both backoffs and recoveries (see [AIMD]); read it with RateLimit
 		// (the resulting live rate) to tell which direction dominates.
@@ -111,10 +118,12 @@ type (
 		// not sum.
 		RetryBudgetTokens float64 `json:"retry_budget_tokens"`
 		// CoalesceInFlight is the number of distinct coalescing keys currently
-		// executing; 0 when the policy has no coalescer.
+		// executing (debounced keys included); 0 when the policy coalesces
+		// nothing.
 		CoalesceInFlight int64 `json:"coalesce_in_flight"`
-		// ConcurrencyLimit is the adaptive limiter's current concurrency limit;
-		// 0 when the policy has no adaptive limiter.
+		// ConcurrencyLimit is the adaptive limiter's current concurrency limit
+		// (see [Policy.ConcurrencyLimit]); 0 when the policy has no adaptive
+		// limiter.
 		ConcurrencyLimit int64 `json:"concurrency_limit"`
 		// ConcurrencyInFlight is the number of calls currently admitted by the
 		// adaptive limiter; 0 when the policy has no adaptive limiter.
@@ -132,6 +141,10 @@ type (
 		// a [SheddabilityDefault] call, in [0, MaxShedRate]; 0 when the policy has
 		// no governor or it is admitting all traffic.
 		SLOShedProbability float64 `json:"slo_shed_probability"`
+		// LoadShedProbability is the load shedder's current probability of
+		// shedding a call, in [0, ShedMaxRate]; 0 when the policy has no load
+		// shedder or it is forwarding all traffic.
+		LoadShedProbability float64 `json:"load_shed_probability"`
 		// RateLimit is the rate limiter's current refill rate in tokens per
 		// second; 0 when the policy has no rate limiter. With [AIMD] it is the live
 		// adapted rate (moving within [AIMDMinRate, AIMDMaxRate]); otherwise the
@@ -202,8 +215,15 @@ type (
 	// wired in via instrumented [Hooks], so every emitted lifecycle event
 	// increments its counter regardless of whether the caller set that hook.
 	policyMetrics struct {
+		// calls, successes and failures are counted by Policy.run rather than
+		// through a hook: every call ends there.
+		calls     atomic.Int64
+		successes atomic.Int64
+		failures  atomic.Int64
+
 		retries              atomic.Int64
 		timeouts             atomic.Int64
+		softTimeouts         atomic.Int64
 		circuitOpens         atomic.Int64
 		circuitCloses        atomic.Int64
 		circuitHalfOpens     atomic.Int64
@@ -221,6 +241,7 @@ type (
 		concurrencyRejected  atomic.Int64
 		throttled            atomic.Int64
 		sloShed              atomic.Int64
+		loadShed             atomic.Int64
 		rateAdaptations      atomic.Int64
 		slowCallRateExceeded atomic.Int64
 		timeBudgetExceeded   atomic.Int64
@@ -274,10 +295,14 @@ func (m *policyMetrics) instrument(user *Hooks) Hooks {
 				user.OnRetry(attempt, err)
 			}
 		},
-		OnCircuitOpen:      countingHook(&m.circuitOpens, user.OnCircuitOpen),
-		OnCircuitClose:     countingHook(&m.circuitCloses, user.OnCircuitClose),
-		OnCircuitHalfOpen:  countingHook(&m.circuitHalfOpens, user.OnCircuitHalfOpen),
-		OnCircuitRamping:   countingHook(&m.circuitRamps, user.OnCircuitRamping),
+		OnCircuitOpen:     countingHook(&m.circuitOpens, user.OnCircuitOpen),
+		OnCircuitClose:    countingHook(&m.circuitCloses, user.OnCircuitClose),
+		OnCircuitHalfOpen: countingHook(&m.circuitHalfOpens, user.OnCircuitHalfOpen),
+		OnCircuitRamping:  countingHook(&m.circuitRamps, user.OnCircuitRamping),
+
+		// Transitions are already counted through the discrete hooks above.
+		OnCircuitStateChange: user.OnCircuitStateChange,
+
 		OnRateLimited:      countingHook(&m.rateLimited, user.OnRateLimited),
 		OnBulkheadFull:     countingHook(&m.bulkheadRejected, user.OnBulkheadFull),
 		OnBulkheadAcquired: user.OnBulkheadAcquired,
@@ -286,6 +311,7 @@ func (m *policyMetrics) instrument(user *Hooks) Hooks {
 		OnBulkheadTimeout:  countingHook(&m.bulkheadTimeouts, user.OnBulkheadTimeout),
 		OnCoDelShed:        countingHook(&m.codelShed, user.OnCoDelShed),
 		OnTimeout:          countingHook(&m.timeouts, user.OnTimeout),
+		OnSoftTimeout:      countingHook(&m.softTimeouts, user.OnSoftTimeout),
 		OnHedgeTriggered:   countingHook(&m.hedgesTriggered, user.OnHedgeTriggered),
 		OnHedgeWon:         countingHook(&m.hedgesWon, user.OnHedgeWon),
 		OnFallbackUsed: func(err error) {
@@ -295,6 +321,8 @@ func (m *policyMetrics) instrument(user *Hooks) Hooks {
 				user.OnFallbackUsed(err)
 			}
 		},
+		// Counted once, through OnFallbackUsed above.
+		OnFallbackUsedDetailed:    user.OnFallbackUsedDetailed,
 		OnRetryBudgetExceeded:     countingHook(&m.retryBudgetExceeded, user.OnRetryBudgetExceeded),
 		OnCoalesceLeader:          countingHook(&m.coalesceLeaders, user.OnCoalesceLeader),
 		OnCoalesceFollower:        countingHook(&m.coalesceFollowers, user.OnCoalesceFollower),
@@ -302,6 +330,7 @@ func (m *policyMetrics) instrument(user *Hooks) Hooks {
 		OnConcurrencyLimitChanged: user.OnConcurrencyLimitChanged,
 		OnThrottled:               countingHook(&m.throttled, user.OnThrottled),
 		OnSLOShed:                 countingHook(&m.sloShed, user.OnSLOShed),
+		OnLoadShed:                countingHook(&m.loadShed, user.OnLoadShed),
 		OnRateAdapted: func(rate float64) {
 			m.rateAdaptations.Add(1)
 
@@ -311,6 +340,7 @@ func (m *policyMetrics) instrument(user *Hooks) Hooks {
 		},
 		OnSlowCallRateExceeded: countingHook(&m.slowCallRateExceeded, user.OnSlowCallRateExceeded),
 		OnTimeBudgetExceeded:   countingHook(&m.timeBudgetExceeded, user.OnTimeBudgetExceeded),
+		OnRetriesExhausted:     user.OnRetriesExhausted,
 		OnPanic: func(value any) {
 			m.panicsRecovered.Add(1)
 
@@ -331,7 +361,34 @@ func (m *policyMetrics) instrument(user *Hooks) Hooks {
 		OnCacheStored:    countingHook(&m.cacheStores, user.OnCacheStored),
 		OnStaleServed:    countingHook(&m.cacheStaleServed, user.OnStaleServed),
 		OnCacheRefreshed: countingHook(&m.cacheRefreshes, user.OnCacheRefreshed),
+		// Counted once, through OnStaleServed above.
+		OnStaleServedAge: user.OnStaleServedAge,
+		OnOutcome:        user.OnOutcome,
+		OnHedgeResult:    user.OnHedgeResult,
+		OnHedgeWonDetail: user.OnHedgeWonDetail,
+		OnAttemptStart:   user.OnAttemptStart,
+		OnAttemptEnd:     user.OnAttemptEnd,
+	}
+}
+
+// CircuitBreakerStats returns the policy's circuit breaker internals (see
+// [CircuitBreaker.Stats]). ok is false when the policy has no circuit breaker.
+func (p *Policy[T]) CircuitBreakerStats() (stats CBStats, ok bool) {
+	if p.circuitBreaker == nil {
+		return CBStats{}, false
 	}
+
+	return p.circuitBreaker.Stats(), true
+}
+
+// RateLimiterStats returns the policy's rate limiter bucket state (see
+// [RateLimiter.Stats]). ok is false when the policy has no rate limiter.
+func (p *Policy[T]) RateLimiterStats() (stats RLStats, ok bool) {
+	if p.rateLimiter == nil {
+		return RLStats{}, false
+	}
+
+	return p.rateLimiter.Stats(), true
 }
 
 // Metrics returns a snapshot of this policy's cumulative counters and current
@@ -341,8 +398,10 @@ func (p *Policy[T]) Metrics() PolicyMetrics {
 
 	metrics := PolicyMetrics{
 		Name:                      p.name,
+		Label:                     p.label,
 		Retries:                   p.metrics.retries.Load(),
 		Timeouts:                  p.metrics.timeouts.Load(),
+		SoftTimeouts:              p.metrics.softTimeouts.Load(),
 		CircuitOpens:              p.metrics.circuitOpens.Load(),
 		CircuitCloses:             p.metrics.circuitCloses.Load(),
 		CircuitHalfOpens:          p.metrics.circuitHalfOpens.Load(),
@@ -360,6 +419,7 @@ func (p *Policy[T]) Metrics() PolicyMetrics {
 		ConcurrencyRejected:       p.metrics.concurrencyRejected.Load(),
 		Throttled:                 p.metrics.throttled.Load(),
 		SLOShed:                   p.metrics.sloShed.Load(),
+		LoadShed:                  p.metrics.loadShed.Load(),
 		RateAdaptations:           p.metrics.rateAdaptations.Load(),
 		SlowCallRateExceeded:      p.metrics.slowCallRateExceeded.Load(),
 		TimeBudgetExceeded:        p.metrics.timeBudgetExceeded.Load(),
@@ -381,16 +441,21 @@ func (p *Policy[T]) Metrics() PolicyMetrics {
 		metrics.RampRecoveryFraction = p.circuitBreaker.RampRecoveryFraction()
 	}
 
+	// Partitions (see WithPartitionKey) share one configuration: the rate and
+	// capacity are per partition, occupancy is summed, and saturation or
+	// controlled-delay load reports the worst partition.
 	if p.rateLimiter != nil {
-		metrics.Saturated = p.rateLimiter.Saturated()
+		metrics.Saturated = p.rateLimiters.anyPart((*RateLimiter).Saturated)
 		metrics.RateLimit = p.rateLimiter.CurrentRate()
 	}
 
 	if p.bulkhead != nil {
-		metrics.BulkheadInUse = p.bulkhead.InUse()
 		metrics.BulkheadCap = p.bulkhead.Cap()
-		metrics.BulkheadQueued = p.bulkhead.Queued()
-		metrics.CoDelLoad = p.bulkhead.CoDelLoad()
+		p.bulkheads.each(func(bh *Bulkhead) {
+			metrics.BulkheadInUse += bh.InUse()
+			metrics.BulkheadQueued += bh.Queued()
+			metrics.CoDelLoad = max(metrics.CoDelLoad, bh.CoDelLoad())
+		})
 	}
 
 	if p.retryBudget != nil {
@@ -405,6 +470,10 @@ func (p *Policy[T]) Metrics() PolicyMetrics {
 		metrics.CoalesceInFlight = int64(p.coalescer.InFlight())
 	}
 
+	if p.debouncer != nil {
+		metrics.CoalesceInFlight = int64(p.debouncer.InFlight())
+	}
+
 	if p.adaptive != nil {
 		metrics.ConcurrencyLimit = int64(p.adaptive.Limit())
 		metrics.ConcurrencyInFlight = int64(p.adaptive.InFlight())
@@ -419,6 +488,10 @@ func (p *Policy[T]) Metrics() PolicyMetrics {
 		metrics.SLOShedProbability = p.slo.ShedProbability()
 	}
 
+	if p.loadShedder != nil {
+		metrics.LoadShedProbability = p.loadShedder.RejectionProbability()
+	}
+
 	latency := p.latency.snapshot()
 	metrics.LatencyP50 = latency.p50
 	metrics.LatencyP95 = latency.p95
@@ -22,30 +22,52 @@ type PatternEntry[T any] struct {
 // meaningful, and they are renumbered when a pattern is inserted.
 const (
 	priorityFallback          = 0  // outermost — last resort
-	priorityCache             = 1  // read-through hit short-circuits the whole chain
-	priorityCoalesce          = 2  // collapse duplicate concurrent calls before any work
-	priorityTimeout           = 3  // global timeout (hard cancel)
-	priorityTimeBudget        = 4  // total time budget shared across retry + hedge
-	prioritySLO               = 5  // shed to protect the SLO error budget before any backend-health shed
-	priorityThrottle          = 6  // proportional load shed before the breaker trips
-	priorityCircuitBreaker    = 7  // fast-fail while the breaker is open
-	priorityRateLimiter       = 8  // throttle throughput
-	priorityBulkhead          = 9  // limit concurrency (fixed, or adaptive)
-	priorityConcurrencyBudget = 10 // tracks in-flight executions for the retry/hedge concurrency budget
-	priorityRetry             = 11 // retry transient failures, gated by the retry budget
-	priorityHedge             = 12 // closest to user function among the durable patterns
-	priorityRecover           = 13 // inside hedge so each hedge goroutine also recovers panics
-	priorityChaos             = 14 // innermost — simulated downstream every pattern wraps and reacts to
+	priorityLastGood          = 1  // last-known-good, so a fallback runs only when there is nothing to serve
+	priorityStaleCache        = 2  // per-key last-known-good (WithStaleCacheKeyed), inside the single slot
+	priorityCache             = 3  // read-through hit short-circuits the whole chain
+	priorityCoalesce          = 4  // collapse duplicate concurrent calls before any work
+	priorityTimeout           = 5  // global timeout (hard cancel)
+	priorityTimeBudget        = 6  // total time budget shared across retry + hedge
+	prioritySLO               = 7  // shed to protect the SLO error budget before any backend-health shed
+	priorityThrottle          = 8  // proportional load shed before the breaker trips
+	priorityLoadShed          = 9  // shed on rising latency / queue depth before failures reach the breaker
+	priorityCircuitBreaker    = 10 // fast-fail while the breaker is open
+	priorityCacheInBreaker    = 11 // cache with CacheInsideBreaker — an open breaker fast-fails before the lookup
+	priorityRateLimiter       = 12 // throttle throughput
+	priorityBulkhead          = 13 // limit concurrency (fixed, or adaptive)
+	priorityConcurrencyBudget = 14 // tracks in-flight executions for the retry/hedge concurrency budget
+	priorityRetry             = 15 // retry transient failures, gated by the retry budget
+	priorityIdempotency       = 16 // per attempt: a recorded result is returned before the attempt runs
+	priorityAttemptBreaker    = 17 // circuit breaker with CountAttempts — admits and records each retry attempt
+	priorityHedge             = 18 // closest to user function among the durable patterns
+	priorityAttempt           = 19 // per invocation of fn, so each retry and hedged copy is one attempt
+	priorityRecover           = 20 // inside hedge so each hedge goroutine also recovers panics
+	priorityChaos             = 21 // innermost — simulated downstream every pattern wraps and reacts to
 )
 
 // SortPatterns sorts pattern entries by priority (lowest first = outermost).
 // Stable sort to preserve order of patterns with same priority.
 func SortPatterns[T any](entries []PatternEntry[T]) []Middleware[T] {
+	sorted := sortEntries(entries)
+	if sorted == nil {
+		return nil
+	}
+
+	mws := make([]Middleware[T], 0, len(sorted))
+	for _, e := range sorted {
+		mws = append(mws, e.MW)
+	}
+
+	return mws
+}
+
+// sortEntries returns a priority-sorted copy of entries (stable, lowest first),
+// or nil when there are none. It leaves the caller's slice untouched.
+func sortEntries[T any](entries []PatternEntry[T]) []PatternEntry[T] {
 	if len(entries) == 0 {
 		return nil
 	}
 
-	// Copy to avoid mutating the caller's slice.
 	sorted := make([]PatternEntry[T], 0, len(entries))
 	sorted = append(sorted, entries...)
 
@@ -53,10 +75,5 @@ func SortPatterns[T any](entries []PatternEntry[T]) []Middleware[T] {
 		return sorted[i].Priority < sorted[j].Priority
 	})
 
-	mws := make([]Middleware[T], 0, len(sorted))
-	for _, e := range sorted {
-		mws = append(mws, e.MW)
-	}
-
-	return mws
+	return sorted
 }
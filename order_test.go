@@ -179,17 +179,23 @@ func TestPriorityConstantsAreDistinct(t *testing.T) {
 	t.Parallel()
 
 	priorities := map[string]int{
-		"fallback":        priorityFallback,
-		"cache":           priorityCache,
-		"coalesce":        priorityCoalesce,
-		"timeout":         priorityTimeout,
-		"time_budget":     priorityTimeBudget,
-		"throttle":        priorityThrottle,
-		"circuit_breaker": priorityCircuitBreaker,
-		"rate_limiter":    priorityRateLimiter,
-		"bulkhead":        priorityBulkhead,
-		"retry":           priorityRetry,
-		"hedge":           priorityHedge,
+		"fallback":         priorityFallback,
+		"last_good":        priorityLastGood,
+		"stale_cache":      priorityStaleCache,
+		"cache":            priorityCache,
+		"coalesce":         priorityCoalesce,
+		"timeout":          priorityTimeout,
+		"time_budget":      priorityTimeBudget,
+		"throttle":         priorityThrottle,
+		"load_shed":        priorityLoadShed,
+		"circuit_breaker":  priorityCircuitBreaker,
+		"cache_in_breaker": priorityCacheInBreaker,
+		"rate_limiter":     priorityRateLimiter,
+		"bulkhead":         priorityBulkhead,
+		"retry":            priorityRetry,
+		"idempotency":      priorityIdempotency,
+		"attempt_breaker":  priorityAttemptBreaker,
+		"hedge":            priorityHedge,
 	}
 
 	seen := make(map[int]string)
@@ -212,15 +218,21 @@ func TestPriorityConstantsOrdering(t *testing.T) {
 		priority int
 	}{
 		{"fallback", priorityFallback},
+		{"last_good", priorityLastGood},
+		{"stale_cache", priorityStaleCache},
 		{"cache", priorityCache},
 		{"coalesce", priorityCoalesce},
 		{"timeout", priorityTimeout},
 		{"time_budget", priorityTimeBudget},
 		{"throttle", priorityThrottle},
+		{"load_shed", priorityLoadShed},
 		{"circuit_breaker", priorityCircuitBreaker},
+		{"cache_in_breaker", priorityCacheInBreaker},
 		{"rate_limiter", priorityRateLimiter},
 		{"bulkhead", priorityBulkhead},
 		{"retry", priorityRetry},
+		{"idempotency", priorityIdempotency},
+		{"attempt_breaker", priorityAttemptBreaker},
 		{"hedge", priorityHedge},
 	}
 
@@ -0,0 +1,130 @@
+package r8e
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+)
+
+// Outcome classifies how a [Policy.Do] call ended, as one label for dashboards
+// that want more than success versus error.
+type Outcome string
+
+// Call outcomes reported by [Hooks.OnOutcome].
+const (
+	// OutcomeSuccess is a call that succeeded on its first attempt, or was
+	// served a fresh value from the cache.
+	OutcomeSuccess Outcome = "success"
+	// OutcomeDegraded is a call that succeeded only thanks to a resilience
+	// pattern: a retry recovered it, a hedge won, a fallback replaced its
+	// error, or the cache served a stale value instead of the error.
+	OutcomeDegraded Outcome = "degraded"
+	// OutcomeFailed is a call that returned an error.
+	OutcomeFailed Outcome = "failed"
+)
+
+type (
+	// Execution describes how one [Policy.DoWithResult] call resolved, as
+	// collected from the patterns it went through. A call that joined another
+	// caller's coalesced flight reports only what happened on its own path:
+	// no attempts, and none of the shared call's patterns.
+	Execution struct {
+		// Outcome is the call's label, as [Hooks.OnOutcome] reports it.
+		Outcome Outcome
+		// Latency is the call's end-to-end duration on the policy clock.
+		Latency time.Duration
+		// Attempts is how many times fn ran: 1 for a first-try success, more
+		// when retries or a hedge ran it again, 0 when a cache hit or a
+		// rejection (open breaker, rate limit, ...) answered without it.
+		Attempts int
+		// Hedged reports that the hedge fired a second attempt.
+		Hedged bool
+		// HedgeWon reports that the hedge answered before the primary.
+		HedgeWon bool
+		// CacheHit reports that the read-through cache answered from an
+		// entry without running fn — a fresh value, or a cached error.
+		CacheHit bool
+		// ServedStale reports that the cache served a stale value in place of
+		// the call's error.
+		ServedStale bool
+		// UsedFallback reports that a fallback replaced the call's error.
+		UsedFallback bool
+	}
+
+	// callTrace accumulates what the patterns did on one call. It is stored in
+	// the call's context; its fields are atomic because a hedge records from
+	// its own goroutine.
+	callTrace struct {
+		flags    atomic.Uint32
+		attempts atomic.Int32
+	}
+
+	// traceFlag is one event a pattern records on the call's callTrace.
+	traceFlag uint32
+
+	// callTraceKey is the context key under which [Policy.Do] stores the
+	// call's callTrace.
+	callTraceKey struct{}
+)
+
+const (
+	traceDegraded traceFlag = 1 << iota
+	traceHedged
+	traceHedgeWon
+	traceCacheHit
+	traceStale
+	traceFallback
+)
+
+// withCallTrace returns ctx carrying a fresh callTrace for the patterns to
+// record into.
+func withCallTrace(ctx context.Context) (context.Context, *callTrace) {
+	trace := new(callTrace)
+
+	return context.WithValue(ctx, callTraceKey{}, trace), trace
+}
+
+// markTrace records flags on the call running under ctx. It is a no-op when
+// the call is not traced.
+func markTrace(ctx context.Context, flags traceFlag) {
+	if trace, ok := ctx.Value(callTraceKey{}).(*callTrace); ok {
+		trace.flags.Or(uint32(flags))
+	}
+}
+
+// markDegraded records that a pattern rescued the call running under ctx.
+func markDegraded(ctx context.Context) {
+	markTrace(ctx, traceDegraded)
+}
+
+// has reports whether flag was recorded. A nil trace has recorded nothing.
+func (t *callTrace) has(flag traceFlag) bool {
+	return t != nil && traceFlag(t.flags.Load())&flag != 0
+}
+
+// execution returns the Execution of a call that took latency and returned err.
+func (t *callTrace) execution(latency time.Duration, err error) Execution {
+	return Execution{
+		Outcome:      classifyOutcome(err, t.has(traceDegraded)),
+		Latency:      latency,
+		Attempts:     int(t.attempts.Load()),
+		Hedged:       t.has(traceHedged),
+		HedgeWon:     t.has(traceHedgeWon),
+		CacheHit:     t.has(traceCacheHit),
+		ServedStale:  t.has(traceStale),
+		UsedFallback: t.has(traceFallback),
+	}
+}
+
+// classifyOutcome returns the outcome of a call that returned err, degraded
+// telling whether a pattern rescued it.
+func classifyOutcome(err error, degraded bool) Outcome {
+	switch {
+	case err != nil:
+		return OutcomeFailed
+	case degraded:
+		return OutcomeDegraded
+	default:
+		return OutcomeSuccess
+	}
+}
@@ -0,0 +1,265 @@
+package r8e
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// outcomeRecorder collects the outcomes reported through OnOutcome.
+type outcomeRecorder struct {
+	mu       sync.Mutex
+	outcomes []Outcome
+}
+
+func (r *outcomeRecorder) hooks() *Hooks {
+	return &Hooks{OnOutcome: func(outcome Outcome) {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+
+		r.outcomes = append(r.outcomes, outcome)
+	}}
+}
+
+func (r *outcomeRecorder) last() Outcome {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.outcomes[len(r.outcomes)-1]
+}
+
+// failFirst returns a function that fails its first call and then succeeds.
+func failFirst() func(context.Context) (string, error) {
+	var calls atomic.Int64
+
+	return func(context.Context) (string, error) {
+		if calls.Add(1) == 1 {
+			return "", errors.New("flaky")
+		}
+
+		return "ok", nil
+	}
+}
+
+func TestPolicyOnOutcome(t *testing.T) {
+	t.Parallel()
+
+	errDown := errors.New("down")
+	failing := func(context.Context) (string, error) { return "", errDown }
+
+	tests := []struct {
+		name string
+		opts []Option
+		fn   func(context.Context) (string, error)
+		want Outcome
+	}{
+		{
+			name: "clean call",
+			opts: []Option{WithRetry(3, ConstantBackoff(time.Millisecond))},
+			fn:   okCall,
+			want: OutcomeSuccess,
+		},
+		{
+			name: "no patterns",
+			fn:   okCall,
+			want: OutcomeSuccess,
+		},
+		{
+			name: "recovered by retry",
+			opts: []Option{WithRetry(3, ConstantBackoff(time.Millisecond))},
+			fn:   failFirst(),
+			want: OutcomeDegraded,
+		},
+		{
+			name: "fallback",
+			opts: []Option{WithFallback("default")},
+			fn:   failing,
+			want: OutcomeDegraded,
+		},
+		{
+			name: "error propagates",
+			opts: []Option{WithRetry(2, ConstantBackoff(time.Millisecond))},
+			fn:   failing,
+			want: OutcomeFailed,
+		},
+		{
+			name: "fallback function fails too",
+			opts: []Option{WithFallbackFunc(func(err error) (string, error) { return "", err })},
+			fn:   failing,
+			want: OutcomeFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var rec outcomeRecorder
+
+			opts := append([]Option{
+				WithHooks(rec.hooks()),
+				WithClock(newImmediateTestClock()),
+			}, tt.opts...)
+
+			p := NewPolicy[string]("", opts...)
+			_, _ = p.Do(context.Background(), tt.fn)
+
+			require.Len(t, rec.outcomes, 1)
+			assert.Equal(t, tt.want, rec.outcomes[0])
+		})
+	}
+}
+
+func TestPolicyOnOutcomeStaleServed(t *testing.T) {
+	t.Parallel()
+
+	var rec outcomeRecorder
+
+	clk := newPolicyClock()
+	p := NewPolicy[string]("",
+		WithClock(clk),
+		WithHooks(rec.hooks()),
+		WithCache(newMemCache[CacheEntry[string]](),
+			func(context.Context) string { return "k" }, time.Minute,
+			StaleIfError(time.Hour)),
+	)
+
+	_, err := p.Do(context.Background(), okCall)
+	require.NoError(t, err)
+	assert.Equal(t, OutcomeSuccess, rec.last())
+
+	_, err = p.Do(context.Background(), okCall)
+	require.NoError(t, err)
+	assert.Equal(t, OutcomeSuccess, rec.last(), "fresh cache hit")
+
+	clk.advance(5 * time.Minute)
+
+	_, err = p.Do(context.Background(), func(context.Context) (string, error) {
+		return "", errors.New("down")
+	})
+	require.NoError(t, err)
+	assert.Equal(t, OutcomeDegraded, rec.last(), "stale value served")
+}
+
+func TestPolicyOnOutcomeHedgeWon(t *testing.T) {
+	t.Parallel()
+
+	var (
+		rec   outcomeRecorder
+		calls atomic.Int64
+	)
+
+	p := NewPolicy[string]("",
+		WithHooks(rec.hooks()),
+		WithHedge(time.Millisecond),
+	)
+
+	// The primary hangs until cancelled; the hedge answers at once.
+	got, err := p.Do(context.Background(), func(ctx context.Context) (string, error) {
+		if calls.Add(1) == 1 {
+			<-ctx.Done()
+
+			return "", ctx.Err()
+		}
+
+		return "hedge", nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "hedge", got)
+	assert.Equal(t, OutcomeDegraded, rec.last())
+}
+
+func TestPolicyDoWithResultRetryThenSuccess(t *testing.T) {
+	t.Parallel()
+
+	p := NewPolicy[string]("",
+		WithClock(newImmediateTestClock()),
+		WithRetry(3, ConstantBackoff(time.Millisecond)),
+		WithFallback("default"),
+	)
+
+	got, exec, err := p.DoWithResult(context.Background(), failFirst())
+	require.NoError(t, err)
+	assert.Equal(t, "ok", got)
+	assert.Equal(t, 2, exec.Attempts)
+	assert.False(t, exec.UsedFallback)
+	assert.False(t, exec.Hedged)
+	assert.Equal(t, OutcomeDegraded, exec.Outcome)
+}
+
+func TestPolicyDoWithResultFallbackAndCache(t *testing.T) {
+	t.Parallel()
+
+	clk := newPolicyClock()
+	p := NewPolicy[string]("",
+		WithClock(clk),
+		WithFallback("default"),
+		WithCache(newMemCache[CacheEntry[string]](),
+			func(context.Context) string { return "k" }, time.Minute,
+			StaleIfError(time.Hour)),
+	)
+
+	failing := func(context.Context) (string, error) { return "", errors.New("down") }
+
+	got, exec, err := p.DoWithResult(context.Background(), func(context.Context) (string, error) {
+		clk.advance(25 * time.Millisecond)
+
+		return "", errors.New("down")
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "default", got)
+	assert.Equal(t, Execution{
+		Outcome:      OutcomeDegraded,
+		Latency:      25 * time.Millisecond,
+		Attempts:     1,
+		UsedFallback: true,
+	}, exec)
+
+	_, _, err = p.DoWithResult(context.Background(), okCall)
+	require.NoError(t, err)
+
+	got, exec, err = p.DoWithResult(context.Background(), failing)
+	require.NoError(t, err)
+	assert.Equal(t, "ok", got)
+	assert.Equal(t, Execution{Outcome: OutcomeSuccess, CacheHit: true}, exec, "fn does not run on a hit")
+
+	clk.advance(5 * time.Minute)
+
+	got, exec, err = p.DoWithResult(context.Background(), failing)
+	require.NoError(t, err)
+	assert.Equal(t, "ok", got)
+	assert.True(t, exec.ServedStale)
+	assert.False(t, exec.UsedFallback, "the stale value answered before the fallback")
+	assert.Equal(t, 1, exec.Attempts)
+}
+
+func TestPolicyDoWithResultHedge(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int64
+
+	p := NewPolicy[string]("", WithHedge(time.Millisecond))
+
+	// The primary hangs until cancelled; the hedge answers at once.
+	got, exec, err := p.DoWithResult(context.Background(), func(ctx context.Context) (string, error) {
+		if calls.Add(1) == 1 {
+			<-ctx.Done()
+
+			return "", ctx.Err()
+		}
+
+		return "hedge", nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "hedge", got)
+	assert.True(t, exec.Hedged)
+	assert.True(t, exec.HedgeWon)
+	assert.Equal(t, 2, exec.Attempts)
+	assert.Equal(t, OutcomeDegraded, exec.Outcome)
+}
@@ -0,0 +1,166 @@
+package r8e
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+)
+
+// Pattern: Partitioning — one independent rate limiter and bulkhead per
+// partition key read from the call's context, so a noisy tenant exhausts only
+// its own tokens and slots.
+
+// partitioned holds one instance of a pattern per partition key, created on the
+// first call that carries the key. Calls without a key share base, which is
+// also the instance the policy's single-valued accessors report. Adjustments
+// made through apply reach every existing partition and are replayed on the
+// ones created later, so a hot reload covers partitions that do not exist yet.
+// Only the latest adjustment of each [partitionSetting] is kept, so repeated
+// reloads neither grow the replay nor lengthen partition creation.
+type partitioned[V any] struct {
+	keyFn func(context.Context) string // nil: unpartitioned, base only
+	base  V
+	build func() V
+	parts sync.Map // string → V
+
+	mu    sync.Mutex // serializes partition creation against apply
+	tunes []partitionTune[V]
+}
+
+// partitionSetting names what an adjustment passed to apply changes, so a
+// later adjustment of the same setting replaces it in the replay.
+type partitionSetting int
+
+const (
+	settingRate partitionSetting = iota
+	settingBulkhead
+	settingAIMD
+)
+
+// partitionTune is one recorded adjustment: the latest for its setting.
+type partitionTune[V any] struct {
+	fn      func(V)
+	setting partitionSetting
+}
+
+// WithPartitionKey reads a partition key from the call's context value stored
+// under contextKey and applies it uniformly to the policy's keyed patterns:
+//   - [WithRateLimit] and [WithBulkhead] keep one independent limiter and
+//     bulkhead per partition, built from the same options, so one partition
+//     exhausting its tokens or slots does not affect another;
+//   - [WithCoalesce] and [WithCache] given a nil key function key their calls by
+//     the partition key instead.
+//
+// The context value must be a string or a [fmt.Stringer]; a call whose context
+// carries neither, or an empty key, shares the unpartitioned limiter and
+// bulkhead and is neither coalesced nor cached. Partitions are created on
+// first use and never dropped, so keys should come from a bounded set such as
+// tenant IDs. Health and metrics cover every partition: the bulkhead occupancy
+// is summed, and the policy is reported saturated or full when any partition
+// is. A [Policy.Reconfigure] of the rate or bulkhead applies to every
+// partition, including those created afterwards.
+func WithPartitionKey(contextKey any) Option {
+	return optionFunc(func(s *policySetup) {
+		s.partitionKey = contextKey
+	})
+}
+
+// partitionKeyFunc returns a key function reading the partition key stored
+// under contextKey, "" when there is none.
+func partitionKeyFunc(contextKey any) func(context.Context) string {
+	return func(ctx context.Context) string {
+		switch key := ctx.Value(contextKey).(type) {
+		case string:
+			return key
+		case fmt.Stringer:
+			return key.String()
+		default:
+			return ""
+		}
+	}
+}
+
+// newPartitioned returns the partition set for base, creating new partitions
+// with build. A nil contextKey leaves the set unpartitioned: every call uses
+// base.
+func newPartitioned[V any](contextKey any, base V, build func() V) *partitioned[V] {
+	ps := &partitioned[V]{base: base, build: build}
+	if contextKey != nil {
+		ps.keyFn = partitionKeyFunc(contextKey)
+	}
+
+	return ps
+}
+
+// forCall returns the instance for the partition ctx belongs to.
+//
+//nolint:ireturn // generic type parameter V, not an interface
+func (ps *partitioned[V]) forCall(ctx context.Context) V {
+	if ps.keyFn == nil {
+		return ps.base
+	}
+
+	key := ps.keyFn(ctx)
+	if key == "" {
+		return ps.base
+	}
+
+	if part, ok := ps.parts.Load(key); ok {
+		return part.(V) //nolint:forcetypeassert // only V is ever stored
+	}
+
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	if part, ok := ps.parts.Load(key); ok {
+		return part.(V) //nolint:forcetypeassert // only V is ever stored
+	}
+
+	part := ps.build()
+	for _, tune := range ps.tunes {
+		tune.fn(part)
+	}
+
+	ps.parts.Store(key, part)
+
+	return part
+}
+
+// each calls fn with base and every partition created so far.
+func (ps *partitioned[V]) each(fn func(V)) {
+	fn(ps.base)
+	ps.parts.Range(func(_, part any) bool {
+		fn(part.(V)) //nolint:forcetypeassert // only V is ever stored
+
+		return true
+	})
+}
+
+// anyPart reports whether pred holds for base or any partition.
+func (ps *partitioned[V]) anyPart(pred func(V) bool) bool {
+	found := false
+
+	ps.each(func(part V) {
+		found = found || pred(part)
+	})
+
+	return found
+}
+
+// apply runs tune on every partition and, when partitioned, records it for
+// partitions created later in place of the previous adjustment of setting.
+// The recorded adjustments keep the order they were last applied in.
+func (ps *partitioned[V]) apply(setting partitionSetting, tune func(V)) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	if ps.keyFn != nil {
+		ps.tunes = slices.DeleteFunc(ps.tunes, func(prev partitionTune[V]) bool {
+			return prev.setting == setting
+		})
+		ps.tunes = append(ps.tunes, partitionTune[V]{fn: tune, setting: setting})
+	}
+
+	ps.each(tune)
+}
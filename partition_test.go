@@ -0,0 +1,159 @@
+package r8e
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type tenantKey struct{}
+
+func forTenant(tenant string) context.Context {
+	return context.WithValue(context.Background(), tenantKey{}, tenant)
+}
+
+func okCall(context.Context) (string, error) { return "ok", nil }
+
+func TestWithPartitionKeyRateLimitAndBulkhead(t *testing.T) {
+	t.Parallel()
+
+	p := NewPolicy[string]("partitioned",
+		WithClock(&stubClock{now: time.Now()}), // frozen: no token refill
+		WithPartitionKey(tenantKey{}),
+		WithRateLimit(2),
+		WithBulkhead(1),
+	)
+
+	// Tenant a spends its two tokens; b's bucket is untouched.
+	for range 2 {
+		_, err := p.Do(forTenant("a"), okCall)
+		require.NoError(t, err)
+	}
+
+	_, err := p.Do(forTenant("a"), okCall)
+	require.ErrorIs(t, err, ErrRateLimited)
+
+	_, err = p.Do(forTenant("b"), okCall)
+	require.NoError(t, err)
+
+	// Tenant c holds its only bulkhead slot; c is full, d is not.
+	started := make(chan struct{})
+	release := make(chan struct{})
+	done := make(chan error, 1)
+
+	go func() {
+		_, err := p.Do(forTenant("c"), func(context.Context) (string, error) {
+			close(started)
+			<-release
+
+			return "ok", nil
+		})
+		done <- err
+	}()
+
+	<-started
+
+	_, err = p.Do(forTenant("c"), okCall)
+	require.ErrorIs(t, err, ErrBulkheadFull)
+
+	_, err = p.Do(forTenant("d"), okCall)
+	require.NoError(t, err)
+
+	status := p.HealthStatus()
+	assert.Contains(t, status.Conditions, ConditionRateLimited, "tenant a is saturated")
+	assert.Contains(t, status.Conditions, ConditionBulkheadFull, "tenant c is full")
+	assert.Equal(t, int64(1), p.Metrics().BulkheadInUse)
+
+	close(release)
+	require.NoError(t, <-done)
+
+	// Calls without a key share the unpartitioned limiter and bulkhead.
+	_, err = p.Do(context.Background(), okCall)
+	require.NoError(t, err)
+}
+
+func TestWithPartitionKeyReconfigureReachesNewPartitions(t *testing.T) {
+	t.Parallel()
+
+	p := NewPolicy[string]("partitioned-reload",
+		WithClock(&stubClock{now: time.Now()}),
+		WithPartitionKey(tenantKey{}),
+		WithRateLimit(1),
+	)
+
+	_, err := p.Do(forTenant("early"), okCall)
+	require.NoError(t, err)
+
+	rate := 3.0
+	require.NoError(t, p.Reconfigure(PolicyConfig{RateLimit: &rate}))
+
+	p.rateLimiters.each(func(rl *RateLimiter) {
+		assert.InDelta(t, rate, rl.CurrentRate(), 0)
+	})
+
+	late := p.rateLimiters.forCall(forTenant("late"))
+	assert.InDelta(t, rate, late.CurrentRate(), 0, "created after the reload")
+}
+
+func TestWithPartitionKeyReplaysLatestSettingOnly(t *testing.T) {
+	t.Parallel()
+
+	p := NewPolicy[string]("partitioned-reloads",
+		WithClock(&stubClock{now: time.Now()}),
+		WithPartitionKey(tenantKey{}),
+		WithRateLimit(1),
+		WithBulkhead(1),
+	)
+
+	for i := range 50 {
+		rate, slots := float64(i+2), i+2
+		require.NoError(t, p.Reconfigure(PolicyConfig{RateLimit: &rate, Bulkhead: &slots}))
+	}
+
+	assert.Len(t, p.rateLimiters.tunes, 1, "one rate setting, however many reloads")
+	assert.Len(t, p.bulkheads.tunes, 1, "one bulkhead setting, however many reloads")
+
+	late := forTenant("late")
+	assert.InDelta(t, 51.0, p.rateLimiters.forCall(late).CurrentRate(), 0)
+	assert.Equal(t, int64(51), p.bulkheads.forCall(late).Cap())
+}
+
+func TestWithPartitionKeyKeysCoalesceAndCache(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int64
+
+	p := NewPolicy[string]("partitioned-cache",
+		WithPartitionKey(tenantKey{}),
+		WithCache(newMemCache[CacheEntry[string]](), nil, time.Minute),
+	)
+
+	fn := func(ctx context.Context) (string, error) {
+		calls.Add(1)
+
+		return ctx.Value(tenantKey{}).(string), nil //nolint:forcetypeassert // test
+	}
+
+	for _, tenant := range []string{"a", "a", "b", "a"} {
+		got, err := p.Do(forTenant(tenant), fn)
+		require.NoError(t, err)
+		assert.Equal(t, tenant, got)
+	}
+
+	assert.Equal(t, int64(2), calls.Load(), "one execution per partition key")
+}
+
+func TestPartitionKeyFunc(t *testing.T) {
+	t.Parallel()
+
+	keyFn := partitionKeyFunc(tenantKey{})
+
+	assert.Equal(t, "acme", keyFn(forTenant("acme")))
+	assert.Equal(t, "1s", keyFn(context.WithValue(context.Background(), tenantKey{}, time.Second)))
+	assert.Empty(t, keyFn(context.WithValue(context.Background(), tenantKey{}, 42)))
+	assert.Empty(t, keyFn(context.Background()))
+}
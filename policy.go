@@ -3,6 +3,8 @@ package r8e
 import (
 	"context"
 	"fmt"
+	"log/slog"
+	"slices"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -22,16 +24,21 @@ type (
 	// non-option to [NewPolicy] is a compile error and a misconfigured policy
 	// cannot be built silently.
 	Policy[T any] struct {
-		chain             Middleware[T]
+		chain             Middleware[T] // nil when the policy has no patterns
+		patterns          []string      // entry names, outermost first (see Patterns)
 		circuitBreaker    *CircuitBreaker
 		rateLimiter       *RateLimiter
 		bulkhead          *Bulkhead
+		rateLimiters      *partitioned[*RateLimiter] // rateLimiter plus any partitions
+		bulkheads         *partitioned[*Bulkhead]    // bulkhead plus any partitions
 		adaptive          *AdaptiveLimiter
 		throttler         *Throttler
 		slo               *SLOGovernor
+		loadShedder       *LoadShedder
 		retryBudget       *RetryBudget
 		concurrencyBudget *ConcurrencyBudget
 		coalescer         *Coalescer[T]
+		debouncer         *Debouncer[T]
 		registry          *Registry
 		metrics           *policyMetrics
 		// clock drives the latency window (and is the same clock injected into
@@ -58,6 +65,7 @@ type (
 		hedge      *atomic.Int64                 // hedge delay in nanoseconds
 		retry      *atomic.Pointer[retryRuntime] // retry attempts/strategy/opts
 		name       string
+		label      string // telemetry tag, see WithLabel
 		deps       []HealthReporter
 		// reconfigureMu serializes Reconfigure so two concurrent callers cannot
 		// lose a load-modify-store update to a hot-swapped cell (e.g. timeBudget,
@@ -66,6 +74,28 @@ type (
 		// affectsReadiness gates Kubernetes readiness when this policy is
 		// critically unhealthy (see WithReadinessImpact). False by default.
 		affectsReadiness bool
+		// maintenance, when set, masks the reported health for readiness
+		// purposes while every pattern keeps enforcing (see SetMaintenance).
+		maintenance atomic.Bool
+		// disabled holds one switch per pattern name, consulted by the chain on
+		// every call (see SetPatternEnabled); built once and never resized.
+		disabled map[string]*atomic.Bool
+		// opts are the options the policy was built from, kept so With can
+		// derive a variant by layering more on top.
+		opts []Option
+		// onOutcome is the (wrapped) OnOutcome hook, fired for calls whose
+		// outcome is tracked (see tracksOutcome).
+		onOutcome func(Outcome)
+		// hooks holds the caller's hooks, swapped by SetHooks and AddHook.
+		hooks *hookSlot
+		// logged records that a logger is configured; it logs every outcome.
+		logged bool
+		// contextField, when non-nil, wraps Do's errors in a *PolicyError
+		// carrying a context value (see WithContextField).
+		contextField *contextFieldDesc
+		// errorClassifier, when non-nil, classifies fn's unmarked errors
+		// before the patterns see them (see WithErrorClassifier).
+		errorClassifier func(error) ErrorClass
 	}
 
 	// retryRuntime is the hot-swappable retry configuration read per call.
@@ -99,29 +129,67 @@ type (
 		clock    Clock
 		hooks    Hooks
 		registry *Registry
+		// label tags the policy's telemetry without registering it (see
+		// WithLabel).
+		label string
+		// timerFunc, when non-nil, replaces the clock's NewTimer (see
+		// WithTimerFunc); resolveSetup folds it into clock.
+		timerFunc func(time.Duration) Timer
+		// logger, when non-nil, logs every hook event (see WithLogger), at the
+		// levels in logLevels layered over defaultLogLevels.
+		logger    *slog.Logger
+		logLevels map[EventType]slog.Level
+		// errorCoalescing, when positive, caps repeated Warn-and-above log
+		// records to one per event per window (see WithErrorCoalescing).
+		errorCoalescing time.Duration
+		// opts are the options the setup was resolved from (see Policy.With).
+		opts []Option
 
 		timeout           *time.Duration
 		timeoutAdaptive   *adaptiveTimeoutConfig
 		timeBudget        *time.Duration
 		retry             *retryDesc
 		circuitBreaker    *circuitBreakerDesc
+		circuitStore      CircuitStore
 		rateLimit         *rateLimitDesc
 		bulkhead          *bulkheadDesc
 		adaptive          *adaptiveDesc
 		throttle          *throttleDesc
 		slo               *sloDesc
+		loadShed          *loadShedDesc
 		hedge             *time.Duration
 		hedgeAdaptive     *adaptiveHedgeConfig
+		hedgePredicate    func(elapsed time.Duration) bool
+		hedgeSelector     any
+		hedgeSelectWait   time.Duration
 		fallbackValue     *staticFallback
 		fallbackFunc      *funcFallback
+		fallbackChain     *chainFallback
 		retryBudget       *RetryBudget
 		concurrencyBudget *ConcurrencyBudget
 		coalesce          *coalesceDesc
 		cache             *cacheDesc
+		idempotency       *idempotencyDesc
 		chaos             *chaosDesc
+		lastGood          *lastGoodDesc
+		staleCache        *staleCacheDesc
+		contextField      *contextFieldDesc
+		errorClassifier   func(error) ErrorClass
 		deps              []HealthReporter
 
 		affectsReadiness bool
+		// timeoutCooperative runs the timeout on the caller's goroutine (see
+		// CooperativeTimeout).
+		timeoutCooperative bool
+		// timeoutPartial keeps a timed-out call's partial result (see
+		// PartialTimeout).
+		timeoutPartial bool
+		// timeoutSoft, when non-nil, is the soft threshold of a two-phase
+		// timeout (see WithTimeoutSoftHard).
+		timeoutSoft *time.Duration
+		// partitionKey, when non-nil, is the context key the keyed patterns read
+		// their partition from (see WithPartitionKey).
+		partitionKey any
 		// propagateDeadline requests a hard clock-driven deadline derived from
 		// the time budget (see PropagateDeadline); ignored without timeBudget.
 		propagateDeadline bool
@@ -131,6 +199,12 @@ type (
 		// panicRecover, when true, adds the innermost recover middleware that
 		// catches panics and converts them to *PanicError (see WithRecover).
 		panicRecover bool
+		// ownedRetryBudget / ownedConcurrencyBudget hold the options of a
+		// budget the policy creates for itself (WithRetryBudget /
+		// WithConcurrencyBudget), nil when the budget is shared or absent, so a
+		// PolicyTemplate can give each instance its own.
+		ownedRetryBudget       []RetryBudgetOption
+		ownedConcurrencyBudget []ConcurrencyBudgetOption
 	}
 
 	// retryDesc holds deferred retry configuration.
@@ -155,13 +229,16 @@ type (
 	rateLimitDesc struct {
 		opts []RateLimitOption
 		rate float64
+		cost int // tokens each call takes; see WithRateLimitN
 	}
 
 	// coalesceDesc holds deferred request-coalescing configuration. A non-nil
 	// pointer marks coalescing as requested; keyFn nil within it is the
-	// misconfiguration NewPolicy rejects with ErrCoalesceNilKeyFunc.
+	// misconfiguration NewPolicy rejects with ErrCoalesceNilKeyFunc. A
+	// positive window keeps each success for that long (see WithDebounce).
 	coalesceDesc struct {
-		keyFn func(context.Context) string
+		keyFn  func(context.Context) string
+		window time.Duration
 	}
 
 	// adaptiveDesc holds deferred adaptive-concurrency configuration.
@@ -174,6 +251,11 @@ type (
 		opts []ThrottleOption
 	}
 
+	// loadShedDesc holds deferred load-shedder configuration.
+	loadShedDesc struct {
+		opts []LoadShedOption
+	}
+
 	// sloDesc holds deferred SLO burn-rate governor configuration.
 	sloDesc struct {
 		opts   []SLOOption
@@ -191,6 +273,15 @@ type (
 		ttl   time.Duration
 	}
 
+	// idempotencyDesc holds deferred idempotency-guard configuration. The store
+	// is carried as any (an IdempotencyStore[T] erased like WithFallback's value)
+	// and asserted back to the policy's T in NewPolicy[T].
+	idempotencyDesc struct {
+		store any
+		keyFn func(context.Context) string
+		ttl   time.Duration
+	}
+
 	// staticFallback carries a WithFallback value (typed T, erased to any).
 	// NewPolicy[T] asserts it back to T and panics on a mismatch, since a
 	// fallback typed for a different T than the policy is a programmer error.
@@ -203,6 +294,13 @@ type (
 	funcFallback struct {
 		fn any
 	}
+
+	// chainFallback carries the WithFallbackChain providers
+	// ([]func(context.Context, error) (T, error), erased to any), asserted back
+	// to T in NewPolicy[T].
+	chainFallback struct {
+		providers any
+	}
 )
 
 func (f optionFunc) apply(s *policySetup) { f(s) }
@@ -210,6 +308,98 @@ func (f optionFunc) apply(s *policySetup) { f(s) }
 // Name returns the policy's name.
 func (p *Policy[T]) Name() string { return p.name }
 
+// Label returns the telemetry label set with [WithLabel], "" when there is
+// none.
+func (p *Policy[T]) Label() string { return p.label }
+
+// Patterns returns the names of the policy's patterns in execution order,
+// outermost first — e.g. ["timeout", "circuit_breaker", "retry"] — as the
+// chain was built. It lets tests assert a policy's composition without reaching
+// into its internals; the returned slice is a copy.
+func (p *Policy[T]) Patterns() []string {
+	return slices.Clone(p.patterns)
+}
+
+// SetPatternEnabled switches the named pattern (one of [Policy.Patterns]) off
+// or back on at runtime, without rebuilding the policy: while disabled, the
+// chain skips it and passes each call straight to the next pattern — e.g. to
+// lift the rate limiter while a partner raises the quota, or to stop retrying
+// to shed load during an incident. The pattern keeps its state (a breaker
+// stays open, a bucket keeps its tokens) and resumes with it when re-enabled.
+// A call already in flight keeps the patterns it started with. It reports
+// false, changing nothing, when the policy has no pattern of that name. Safe
+// for concurrent use with Do.
+func (p *Policy[T]) SetPatternEnabled(name string, enabled bool) bool {
+	flag, ok := p.disabled[name]
+	if ok {
+		flag.Store(!enabled)
+	}
+
+	return ok
+}
+
+// PatternEnabled reports whether the named pattern is currently enabled (see
+// SetPatternEnabled); false when the policy has no pattern of that name.
+func (p *Policy[T]) PatternEnabled(name string) bool {
+	flag, ok := p.disabled[name]
+
+	return ok && !flag.Load()
+}
+
+// With derives a new policy named name from the options p was built with, with
+// opts layered on top. A later option replaces the setting of an earlier one,
+// so a new [WithTimeout] overrides the base timeout while every other pattern
+// is kept; options that add to a list, such as [DependsOn], add to it. The
+// derived policy is built like [NewPolicy] — with its own circuit breaker,
+// limiters, and metrics, registered with the base policy's registry unless
+// opts choose another — and p is left unchanged. Runtime changes made to p
+// with [Policy.Reconfigure] are not carried over. It panics on the same
+// misconfigurations as NewPolicy.
+func (p *Policy[T]) With(name string, opts ...Option) *Policy[T] {
+	return NewPolicy[T](name, append(slices.Clone(p.opts), opts...)...)
+}
+
+// RetryAttempts returns the retry pattern's current maximum attempt count,
+// reflecting any [Policy.Reconfigure]. ok is false when the policy has no
+// retry.
+func (p *Policy[T]) RetryAttempts() (attempts int, ok bool) {
+	if p.retry == nil {
+		return 0, false
+	}
+
+	return p.retry.Load().maxAttempts, true
+}
+
+// CircuitBreakerThreshold returns the circuit breaker's current consecutive-
+// failure threshold (see [FailureThreshold]), reflecting any
+// [Policy.Reconfigure]. ok is false when the policy has no circuit breaker.
+func (p *Policy[T]) CircuitBreakerThreshold() (threshold int, ok bool) {
+	if p.circuitBreaker == nil {
+		return 0, false
+	}
+
+	p.circuitBreaker.mu.Lock()
+	defer p.circuitBreaker.mu.Unlock()
+
+	return p.circuitBreaker.cfg.failureThreshold, true
+}
+
+// ConcurrencyLimit returns the policy's live concurrency limit: the adaptive
+// limit of [WithAdaptiveBulkhead] or [WithAdaptiveConcurrency] as it moves, or
+// the [WithBulkhead] capacity — reported in [PolicyMetrics] as
+// ConcurrencyLimit and BulkheadCap respectively. ok is false when the policy
+// limits no concurrency.
+func (p *Policy[T]) ConcurrencyLimit() (limit int, ok bool) {
+	switch {
+	case p.adaptive != nil:
+		return p.adaptive.Limit(), true
+	case p.bulkhead != nil:
+		return p.bulkhead.Limit(), true
+	default:
+		return 0, false
+	}
+}
+
 // Do executes fn through the composed middleware chain.
 //
 //nolint:ireturn // generic type parameter T, not an interface
@@ -217,18 +407,117 @@ func (p *Policy[T]) Do(
 	ctx context.Context,
 	fn func(context.Context) (T, error),
 ) (T, error) {
+	var trace *callTrace
+	if p.tracksOutcome() {
+		ctx, trace = withCallTrace(ctx)
+	}
+
+	result, _, err := p.run(ctx, fn, trace)
+
+	return result, err
+}
+
+// tracksOutcome reports whether Do should trace the call for OnOutcome: an
+// OnOutcome hook is currently set, or a logger logs outcomes. Otherwise Do pays
+// nothing for it.
+func (p *Policy[T]) tracksOutcome() bool {
+	return p.logged || p.hooks.load().OnOutcome != nil
+}
+
+// DoWithResult is [Policy.Do] that also reports how the call resolved: how many
+// times fn ran, whether the hedge fired or won, whether the result came from
+// the cache, a stale entry or a fallback, and the end-to-end latency. The
+// patterns record into an accumulator carried by the call's context, so the
+// [Execution] describes this call alone, however many run concurrently.
+//
+//nolint:ireturn // generic type parameter T, not an interface
+func (p *Policy[T]) DoWithResult(
+	ctx context.Context,
+	fn func(context.Context) (T, error),
+) (T, Execution, error) {
+	ctx, trace := withCallTrace(ctx)
+
+	counted := func(ctx context.Context) (T, error) {
+		trace.attempts.Add(1)
+
+		return fn(ctx)
+	}
+
+	result, latency, err := p.run(ctx, counted, trace)
+
+	return result, trace.execution(latency, err), err
+}
+
+// run is the body of Do: it runs fn through the chain under ctx, recording
+// into trace (nil when the call is untraced), and returns the result, the
+// call's end-to-end latency and its error.
+//
+//nolint:ireturn // generic type parameter T, not an interface
+func (p *Policy[T]) run(
+	ctx context.Context,
+	fn func(context.Context) (T, error),
+	trace *callTrace,
+) (T, time.Duration, error) {
 	start := p.clock.Now()
-	wrapped := p.chain(fn)
+
+	if p.errorClassifier != nil {
+		fn = classifyErrors(fn, p.errorClassifier)
+	}
+
+	// Fast path: a pattern-less policy calls fn directly (see composeChain).
+	wrapped := fn
+	if p.chain != nil {
+		wrapped = p.chain(fn)
+	}
 
 	result, err := wrapped(ctx)
 
 	// Record the end-to-end latency of every call — success or failure, including
 	// fast-fail rejections — so the percentiles describe the policy's real
 	// outward latency.
-	p.latency.observe(p.clock.Since(start))
+	latency := p.clock.Since(start)
+	p.latency.observe(latency)
+	p.metrics.recordCall(err)
+
+	if trace != nil {
+		p.onOutcome(classifyOutcome(err, trace.has(traceDegraded)))
+	}
+
+	if err != nil && p.contextField != nil {
+		return result, latency, p.contextField.wrap(ctx, p.name, err)
+	}
 
 	//nolint:wrapcheck // middleware chain error returned as-is
-	return result, err
+	return result, latency, err
+}
+
+// composeChain builds the policy's middleware with the cheapest shape for the
+// pattern count: nil for none (Do then calls fn directly), the middleware
+// itself for one, and the general [Chain] otherwise. Each is behaviourally
+// identical to Chain; the special cases only drop the identity wrapper and the
+// per-call loop from the hot path.
+func composeChain[T any](mws []Middleware[T]) Middleware[T] {
+	switch len(mws) {
+	case 0:
+		return nil
+	case 1:
+		return mws[0]
+	default:
+		return Chain[T](mws...)
+	}
+}
+
+// switchable wraps mw so the chain skips it while disabled is set. The switch
+// is read when Do composes the chain for a call, so a call sees one consistent
+// set of patterns and an enabled pattern costs a single atomic load.
+func switchable[T any](mw Middleware[T], disabled *atomic.Bool) Middleware[T] {
+	return func(next func(context.Context) (T, error)) func(context.Context) (T, error) {
+		if disabled.Load() {
+			return next
+		}
+
+		return mw(next)
+	}
 }
 
 // ---------------------------------------------------------------------------
@@ -242,6 +531,20 @@ func WithClock(c Clock) Option {
 	})
 }
 
+// WithTimerFunc overrides how the policy arms timers, leaving the clock's Now
+// and Since in place: every timer the policy's patterns create — retry backoff
+// sleeps, hedge delays, blocking rate-limit waits, timeouts — comes from fn
+// instead of the clock's NewTimer. Use it to adjust sleeps without writing a
+// full [Clock], e.g. to snap delays to a scheduler tick. It composes with
+// [WithClock] in either order; a nil fn is ignored.
+func WithTimerFunc(fn func(d time.Duration) Timer) Option {
+	return optionFunc(func(s *policySetup) {
+		if fn != nil {
+			s.timerFunc = fn
+		}
+	})
+}
+
 // WithHooks sets the lifecycle hooks for all resilience patterns within this
 // policy. A nil argument is ignored, leaving the default (no-op) hooks.
 func WithHooks(h *Hooks) Option {
@@ -260,9 +563,29 @@ func WithRegistry(reg *Registry) Option {
 	})
 }
 
+// WithLabel tags the policy's telemetry with label without naming it, so an
+// unregistered policy stays observable:
+//
+//	charge := r8e.NewPolicy[Receipt]("", r8e.WithLabel("checkout-charge"),
+//		r8e.WithRetry(3, backoff))
+//
+// The label is reported by [Policy.Label], as [PolicyMetrics.Label], as a
+// "label" attribute on every [WithLogger] record, and by the r8eotel bridge on
+// the spans of a traced policy and the metrics of any policy its MetricsSource
+// exposes. Unlike a name it does not register the policy with any [Registry];
+// on a named policy it is reported alongside the name. With [Do] the policy is
+// discarded after the call, so only [Hooks] and the logger see the label.
+func WithLabel(label string) Option {
+	return optionFunc(func(s *policySetup) {
+		s.label = label
+	})
+}
+
 // WithTimeout adds a timeout that cancels slow calls after the given duration.
 // Pass [AdaptiveTimeout] to instead tune the timeout from observed latency
-// percentiles, using the duration as the hard ceiling and warmup fallback.
+// percentiles, using the duration as the hard ceiling and warmup fallback,
+// [CooperativeTimeout] to enforce it without spawning a goroutine, and
+// [PartialTimeout] to return a timed-out call's partial result.
 func WithTimeout(timeout time.Duration, opts ...TimeoutOption) Option {
 	var cfg timeoutConfig
 	for _, opt := range opts {
@@ -271,7 +594,30 @@ func WithTimeout(timeout time.Duration, opts ...TimeoutOption) Option {
 
 	return optionFunc(func(s *policySetup) {
 		s.timeout = &timeout
+		s.timeoutSoft = nil
 		s.timeoutAdaptive = cfg.adaptive
+		s.timeoutCooperative = cfg.cooperative
+		s.timeoutPartial = cfg.partial
+	})
+}
+
+// WithTimeoutSoftHard adds a two-phase timeout: a call still running after soft
+// fires the OnSoftTimeout hook (and counts in the SoftTimeouts metric) but is
+// left to finish, while one still running after hard is cancelled with
+// [ErrTimeout] exactly as [WithTimeout](hard) would. Use it to flag slow calls
+// before giving up on them. The soft threshold is measured on the policy
+// [Clock]; soft must be positive and below hard, else [NewPolicy] panics with
+// [ErrSoftTimeoutNotBelowHard]. opts are the [WithTimeout] options: with
+// [AdaptiveTimeout], hard is the ceiling and soft stays fixed. Reconfiguring
+// the timeout retunes hard only. The soft phase watches each call from its own
+// goroutine and timer, even with [CooperativeTimeout], so it brings back the
+// per-call goroutine that option otherwise saves.
+func WithTimeoutSoftHard(soft, hard time.Duration, opts ...TimeoutOption) Option {
+	timeout := WithTimeout(hard, opts...)
+
+	return optionFunc(func(s *policySetup) {
+		timeout.apply(s)
+		s.timeoutSoft = &soft
 	})
 }
 
@@ -337,6 +683,7 @@ func WithRetry(
 func WithRetryBudget(opts ...RetryBudgetOption) Option {
 	return optionFunc(func(s *policySetup) {
 		s.retryBudget = NewRetryBudget(opts...)
+		s.ownedRetryBudget = append([]RetryBudgetOption{}, opts...)
 	})
 }
 
@@ -348,6 +695,7 @@ func WithRetryBudget(opts ...RetryBudgetOption) Option {
 func WithSharedRetryBudget(budget *RetryBudget) Option {
 	return optionFunc(func(s *policySetup) {
 		s.retryBudget = budget
+		s.ownedRetryBudget = nil
 	})
 }
 
@@ -362,6 +710,7 @@ func WithSharedRetryBudget(budget *RetryBudget) Option {
 func WithConcurrencyBudget(opts ...ConcurrencyBudgetOption) Option {
 	return optionFunc(func(s *policySetup) {
 		s.concurrencyBudget = NewConcurrencyBudget(opts...)
+		s.ownedConcurrencyBudget = append([]ConcurrencyBudgetOption{}, opts...)
 	})
 }
 
@@ -372,6 +721,7 @@ func WithConcurrencyBudget(opts ...ConcurrencyBudgetOption) Option {
 func WithSharedConcurrencyBudget(budget *ConcurrencyBudget) Option {
 	return optionFunc(func(s *policySetup) {
 		s.concurrencyBudget = budget
+		s.ownedConcurrencyBudget = nil
 	})
 }
 
@@ -384,10 +734,23 @@ func WithCircuitBreaker(opts ...CircuitBreakerOption) Option {
 }
 
 // WithRateLimit adds a token-bucket rate limiter that allows rate tokens per
-// second.
+// second. A non-positive rate means no limit: the limiter lets every call
+// through and never reports rate_limited, while a tiny positive rate (0.1, one
+// call every ten seconds) still throttles. The limiter stays in the chain, so
+// [Policy.Reconfigure] can switch it on later.
 func WithRateLimit(rate float64, opts ...RateLimitOption) Option {
 	return optionFunc(func(s *policySetup) {
-		s.rateLimit = &rateLimitDesc{rate: rate, opts: opts}
+		s.rateLimit = &rateLimitDesc{rate: rate, opts: opts, cost: 1}
+	})
+}
+
+// WithRateLimitN is [WithRateLimit] for a policy whose every call costs n
+// tokens — one call sending a batch of n messages, say. Each call takes its n
+// tokens atomically through [RateLimiter.AllowN], so a call whose cost exceeds
+// the bucket capacity is always rejected. A cost below 1 counts as 1.
+func WithRateLimitN(rate float64, n int, opts ...RateLimitOption) Option {
+	return optionFunc(func(s *policySetup) {
+		s.rateLimit = &rateLimitDesc{rate: rate, opts: opts, cost: max(n, 1)}
 	})
 }
 
@@ -424,6 +787,18 @@ func WithAdaptiveConcurrency(opts ...AdaptiveOption) Option {
 	})
 }
 
+// WithAdaptiveBulkhead is a bulkhead whose concurrency limit adapts between
+// floor and ceiling instead of being fixed: shorthand for
+// WithAdaptiveConcurrency(MinLimit(floor), MaxLimit(ceiling)). The limit grows
+// while latency is steady under load, contracts as latency rises, and is cut
+// multiplicatively when calls time out (see [AdaptiveLimiter]). Like
+// [WithAdaptiveConcurrency] it is mutually exclusive with [WithBulkhead]; read
+// the live limit from [Policy.ConcurrencyLimit] or
+// [PolicyMetrics.ConcurrencyLimit].
+func WithAdaptiveBulkhead(floor, ceiling int) Option {
+	return WithAdaptiveConcurrency(MinLimit(floor), MaxLimit(ceiling))
+}
+
 // WithAdaptiveThrottle adds a Google-SRE client-side adaptive throttler: a
 // probabilistic load shedder that rejects calls locally, with [ErrThrottled],
 // in proportion to how heavily the backend is already rejecting them (see
@@ -484,6 +859,26 @@ func WithSLO(target float64, opts ...SLOOption) Option {
 	})
 }
 
+// WithLoadShedder adds a load shedder that rejects calls locally, with
+// [ErrLoadShed], once the recent p99 latency exceeds [ShedLatency] or the calls
+// in flight exceed [ShedInFlight]. It sheds a fraction of calls that grows with
+// the excess over the threshold, capped by [ShedMaxRate], and stops as latency
+// recovers (see [LoadShedder]). With neither threshold set it never sheds.
+//
+// It sits just outside the circuit breaker, inside the adaptive throttler, so
+// a slowing backend is relieved before its slowness turns into the failures
+// the breaker counts. A shed call never reaches the inner patterns. Calls
+// stamped [SheddabilityNever] are always admitted.
+//
+// Observability: the OnLoadShed hook, the LoadShed counter, the
+// LoadShedProbability gauge, and the degraded health condition
+// [ConditionLoadShedding] while it sheds.
+func WithLoadShedder(opts ...LoadShedOption) Option {
+	return optionFunc(func(s *policySetup) {
+		s.loadShed = &loadShedDesc{opts: opts}
+	})
+}
+
 // WithHedge adds a hedged request that fires a second concurrent call after
 // delay. Pass [AdaptiveHedge] to instead fire at an observed latency percentile,
 // using the duration as the hard ceiling and warmup fallback so only genuine
@@ -497,9 +892,29 @@ func WithHedge(delay time.Duration, opts ...HedgeOption) Option {
 	return optionFunc(func(s *policySetup) {
 		s.hedge = &delay
 		s.hedgeAdaptive = cfg.adaptive
+		s.hedgePredicate = cfg.predicate
+		s.hedgeSelector = cfg.selector
+		s.hedgeSelectWait = cfg.selectWait
 	})
 }
 
+// WithHedgeIf is [WithHedge] with a gate: once the delay has elapsed, the
+// hedge fires only if predicate, called with the primary's elapsed time,
+// returns true. Return false to let a slow primary run alone — during a known
+// maintenance window, say, or for a call that is not safe to duplicate. The
+// predicate runs before the concurrency budget is consulted and before
+// OnHedgeTriggered, so a declined hedge takes no permit and is not reported. A
+// nil predicate always hedges.
+func WithHedgeIf(
+	delay time.Duration,
+	predicate func(elapsed time.Duration) bool,
+	opts ...HedgeOption,
+) Option {
+	gate := func(cfg *hedgeConfig) { cfg.predicate = predicate }
+
+	return WithHedge(delay, append(slices.Clip(opts), gate)...)
+}
+
 // WithRecover adds panic recovery: if the user function (or any inner pattern)
 // panics, the panic is caught and returned as a *[PanicError] instead of
 // crashing the process. The recovered value, a goroutine stack trace, and the
@@ -537,13 +952,31 @@ func WithRecover() Option {
 // whose fn never returns would otherwise park a goroutine and wedge its key.
 // Both a nil keyFn and a missing timeout are misconfigurations: [NewPolicy]
 // panics with [ErrCoalesceNilKeyFunc] or [ErrCoalesceWithoutTimeout]
-// respectively.
+// respectively. With [WithPartitionKey], a nil keyFn groups calls by the
+// partition key instead.
 func WithCoalesce(keyFn func(context.Context) string) Option {
 	return optionFunc(func(s *policySetup) {
 		s.coalesce = &coalesceDesc{keyFn: keyFn}
 	})
 }
 
+// WithDebounce adds request coalescing with a result-sharing window (see
+// [Debouncer]): calls for the same key arriving while an execution is in
+// flight, or within window after it succeeded, receive that execution's result
+// instead of running again. keyFn derives the key exactly as for
+// [WithCoalesce], and an empty key opts a call out.
+//
+// Debouncing takes coalescing's place in the chain and replaces a
+// [WithCoalesce] given alongside it (the last of the two wins); it has the same
+// requirements, so [NewPolicy] panics with [ErrCoalesceNilKeyFunc] or
+// [ErrCoalesceWithoutTimeout]. A non-positive window shares only in-flight
+// executions, exactly like WithCoalesce.
+func WithDebounce(window time.Duration, keyFn func(context.Context) string) Option {
+	return optionFunc(func(s *policySetup) {
+		s.coalesce = &coalesceDesc{keyFn: keyFn, window: window}
+	})
+}
+
 // WithCache adds a read-through result cache (see [ReadThroughCache]): keyFn
 // derives a cache key from the call's context — stamp request identity into ctx
 // upstream and read it back here, exactly as [WithCoalesce] does, so one keyFn
@@ -553,8 +986,10 @@ func WithCoalesce(keyFn func(context.Context) string) Option {
 //
 // The cache sits just inside the fallback layer and outside every other pattern,
 // so a hit avoids even coalescing, and a fallback value is never cached (only a
-// genuine downstream success is). Pair it with [WithCoalesce] to collapse the
-// burst of concurrent misses on a hot key into one downstream call.
+// genuine downstream success is). [CacheInsideBreaker] moves it just inside the
+// circuit breaker, so an open breaker fast-fails before the lookup. Pair it
+// with [WithCoalesce] to collapse the burst of concurrent misses on a hot key
+// into one downstream call.
 //
 // The underlying [Cache] is parameterised by [CacheEntry], e.g.
 // otter.MustNew[string, r8e.CacheEntry[T]](cfg). Configure stale-if-error,
@@ -566,7 +1001,8 @@ func WithCoalesce(keyFn func(context.Context) string) Option {
 //
 // A nil keyFn, a nil cache, or a non-positive ttl are misconfigurations:
 // [NewPolicy] panics with [ErrCacheNilKeyFunc], [ErrCacheNilCache], or
-// [ErrCacheNonPositiveTTL] respectively. With [RefreshAhead] set, the policy
+// [ErrCacheNonPositiveTTL] respectively; with [WithPartitionKey], a nil keyFn
+// keys calls by the partition key instead. With [RefreshAhead] set, the policy
 // must also have a [WithTimeout] to bound the detached background reload, else
 // [NewPolicy] panics with [ErrRefreshAheadWithoutTimeout].
 func WithCache[T any](
@@ -580,9 +1016,40 @@ func WithCache[T any](
 	})
 }
 
+// WithIdempotency guards a non-idempotent operation — a POST that charges a
+// card — against running twice. Before each attempt, the result recorded in
+// store under keyFn's key is returned without calling fn; after a successful
+// attempt, its result is recorded for ttl. A retry whose earlier attempt
+// succeeded but lost its response, or a later Do carrying the same key, thus
+// gets the recorded result back. Errors are never recorded, and a call whose
+// keyFn returns "" is not guarded (see [DoIdempotent]).
+//
+// It sits just inside retry, so every attempt is checked, and outside the
+// per-attempt circuit breaker and hedge, so a recorded result is served even
+// while the breaker is open. The guard is a lookup, not a lock: pair it with
+// [WithCoalesce] to keep concurrent calls with the same key from both running.
+// The store's value type must match the Policy's type parameter T; a mismatch
+// panics in [NewPolicy]. A nil keyFn, a nil store, or a non-positive ttl make
+// [NewPolicy] panic with [ErrIdempotencyNilKeyFunc], [ErrIdempotencyNilStore],
+// or [ErrIdempotencyNonPositiveTTL].
+func WithIdempotency[T any](
+	keyFn func(context.Context) string,
+	store IdempotencyStore[T],
+	ttl time.Duration,
+) Option {
+	return optionFunc(func(s *policySetup) {
+		desc := &idempotencyDesc{keyFn: keyFn, ttl: ttl}
+		if store != nil {
+			desc.store = store
+		}
+
+		s.idempotency = desc
+	})
+}
+
 // WithFallback adds a static fallback value returned when the call fails.
 // The value's type must match the Policy's type parameter T; a mismatch panics
-// in [NewPolicy].
+// in [NewPolicy] with an error wrapping [ErrFallbackTypeMismatch].
 func WithFallback[T any](val T) Option {
 	return optionFunc(func(s *policySetup) {
 		s.fallbackValue = &staticFallback{value: val}
@@ -591,13 +1058,27 @@ func WithFallback[T any](val T) Option {
 
 // WithFallbackFunc adds a fallback function called with the error when the call
 // fails. The function signature must be func(error) (T, error) matching the
-// Policy's type parameter; a mismatch panics in [NewPolicy].
+// Policy's type parameter; a mismatch panics in [NewPolicy] with an error
+// wrapping [ErrFallbackTypeMismatch].
 func WithFallbackFunc[T any](fn func(error) (T, error)) Option {
 	return optionFunc(func(s *policySetup) {
 		s.fallbackFunc = &funcFallback{fn: fn}
 	})
 }
 
+// WithFallbackChain adds an ordered list of fallback providers — say a cache,
+// then a secondary endpoint, then a static default. When the call fails, each
+// provider is called in turn with the call's error until one returns a nil
+// error; if all fail, the last provider's error is returned. OnFallbackUsed
+// fires once per provider tried (see [DoFallbackChain]). The providers' result
+// type must match the Policy's type parameter T; a mismatch panics in
+// [NewPolicy] with an error wrapping [ErrFallbackTypeMismatch].
+func WithFallbackChain[T any](providers ...func(context.Context, error) (T, error)) Option {
+	return optionFunc(func(s *policySetup) {
+		s.fallbackChain = &chainFallback{providers: slices.Clone(providers)}
+	})
+}
+
 // DependsOn declares hierarchical health dependencies. If any dependency
 // reports CriticalityCritical and is unhealthy, this policy's health
 // status will be degraded.
@@ -632,32 +1113,137 @@ func WithReadinessImpact() Option {
 // priority via [SortPatterns] before chaining. A named policy auto-registers
 // with its registry (or [DefaultRegistry] if none is given).
 func NewPolicy[T any](name string, opts ...Option) *Policy[T] {
+	setup := resolveSetup(opts)
+
+	return buildPolicy[T](name, &setup, setup.patternCount())
+}
+
+// resolveSetup applies opts, validates the result, and fills in defaults. The
+// returned setup is never mutated afterwards, so it can be built into any
+// number of policies (see [PolicyTemplate]).
+func resolveSetup(opts []Option) policySetup {
 	var setup policySetup
 	for _, opt := range opts {
 		opt.apply(&setup)
 	}
 
+	setup.applyPartitionKey()
 	validateSetup(&setup)
 
+	setup.opts = slices.Clone(opts)
+
 	if setup.clock == nil {
-		setup.clock = RealClock{}
+		setup.clock = currentDefaultClock()
+	}
+
+	if setup.timerFunc != nil {
+		setup.clock = timerFuncClock{Clock: setup.clock, newTimer: setup.timerFunc}
+	}
+
+	return setup
+}
+
+// applyPartitionKey gives the keyed patterns configured without a key function
+// the [WithPartitionKey] key function.
+func (s *policySetup) applyPartitionKey() {
+	if s.partitionKey == nil {
+		return
+	}
+
+	keyFn := partitionKeyFunc(s.partitionKey)
+
+	if s.coalesce != nil && s.coalesce.keyFn == nil {
+		s.coalesce.keyFn = keyFn
+	}
+
+	if s.cache != nil && s.cache.keyFn == nil {
+		s.cache.keyFn = keyFn
+	}
+
+	if s.staleCache != nil && s.staleCache.keyFn == nil {
+		s.staleCache.keyFn = keyFn
+	}
+}
+
+// patternCount returns an upper bound on the number of pattern entries setup
+// builds, used to size the entry slice in one allocation.
+func (s *policySetup) patternCount() int {
+	n := 0
+
+	for _, present := range []bool{
+		s.timeout != nil, s.timeBudget != nil, s.retry != nil,
+		s.concurrencyBudget != nil, s.circuitBreaker != nil,
+		s.rateLimit != nil, s.bulkhead != nil, s.adaptive != nil,
+		s.throttle != nil, s.slo != nil, s.loadShed != nil,
+		s.hedge != nil, s.panicRecover,
+		s.chaos != nil, s.cache != nil, s.coalesce != nil,
+		s.fallbackValue != nil, s.fallbackFunc != nil, s.fallbackChain != nil,
+		s.lastGood != nil, s.staleCache != nil,
+		s.idempotency != nil,
+		s.attemptsHooked(),
+	} {
+		if present {
+			n++
+		}
+	}
+
+	return n
+}
+
+// attemptsHooked reports whether the policy wraps the user function to fire
+// OnAttemptStart and OnAttemptEnd: either is set, or a logger logs them.
+func (s *policySetup) attemptsHooked() bool {
+	return s.logger != nil || s.hooks.OnAttemptStart != nil || s.hooks.OnAttemptEnd != nil
+}
+
+// buildPolicy instantiates a policy from a resolved setup: it creates fresh
+// runtime state (breaker, limiters, bulkhead, reloadable cells, metrics) for
+// every configured pattern, chains them, and registers named policies. setup
+// is only read; entryCap sizes the pattern entry slice.
+func buildPolicy[T any](name string, setup *policySetup, entryCap int) *Policy[T] {
+	// Wrap the caller's hooks so every lifecycle event is also logged when a
+	// logger is configured (see WithLogger) and increments a metrics counter
+	// (see policyMetrics.instrument). The caller's hooks themselves sit behind
+	// a slot so SetHooks and AddHook can swap them later.
+	slot := newHookSlot(setup.hooks)
+	forwarded := slot.forward()
+
+	userHooks := &forwarded
+	if logger := newPolicyLogger(name, setup); logger != nil {
+		logged := logger.wrap(userHooks)
+		userHooks = &logged
+	}
+
+	var reg *Registry
+	if name != "" {
+		reg = setup.registry
+		if reg == nil {
+			reg = DefaultRegistry()
+		}
 	}
 
-	// Wrap the caller's hooks so every lifecycle event also increments a
-	// metrics counter (see policyMetrics.instrument).
 	metrics := &policyMetrics{}
-	hooks := metrics.instrument(&setup.hooks)
+	hooks := metrics.instrument(userHooks)
 	clock := setup.clock
 
+	if reg != nil {
+		hooks.OnCircuitStateChange = reg.readinessStateChangeHook(hooks.OnCircuitStateChange)
+	}
+
+	entries := make([]PatternEntry[T], 0, entryCap)
+
 	var (
-		entries         []PatternEntry[T]
 		circuitBreaker  *CircuitBreaker
 		rateLimiter     *RateLimiter
 		bulkhead        *Bulkhead
+		rateLimiters    *partitioned[*RateLimiter]
+		bulkheads       *partitioned[*Bulkhead]
 		adaptive        *AdaptiveLimiter
 		throttler       *Throttler
 		slo             *SLOGovernor
+		loadShedder     *LoadShedder
 		coalescer       *Coalescer[T]
+		debouncer       *Debouncer[T]
 		timeoutCell     *atomic.Int64
 		adaptiveTimeout *adaptiveTimeout
 		timeBudgetCell  *atomic.Pointer[timeBudgetState]
@@ -670,20 +1256,25 @@ func NewPolicy[T any](name string, opts ...Option) *Policy[T] {
 		timeoutCell = new(atomic.Int64)
 		timeoutCell.Store(int64(*setup.timeout))
 
+		run := runnerFor[T](setup.timeoutCooperative, setup.timeoutPartial)
+		if setup.timeoutSoft != nil {
+			run = withSoftTimeout(run, *setup.timeoutSoft, clock)
+		}
+
 		if setup.timeoutAdaptive != nil {
 			adaptiveTimeout = newAdaptiveTimeout(setup.timeoutAdaptive, clock)
 			entries = append(
 				entries,
-				newAdaptiveTimeoutEntry[T](timeoutCell, adaptiveTimeout, &hooks),
+				newAdaptiveTimeoutEntry(timeoutCell, adaptiveTimeout, run, &hooks),
 			)
 		} else {
-			entries = append(entries, newTimeoutEntry[T](timeoutCell, &hooks))
+			entries = append(entries, newTimeoutEntry(timeoutCell, run, &hooks))
 		}
 	}
 
 	if setup.timeBudget != nil {
 		timeBudgetCell = new(atomic.Pointer[timeBudgetState])
-		timeBudgetCell.Store(newTimeBudgetState(&setup))
+		timeBudgetCell.Store(newTimeBudgetState(setup))
 		entries = append(
 			entries,
 			newTimeBudgetEntry[T](timeBudgetCell, clock, &hooks),
@@ -714,18 +1305,31 @@ func NewPolicy[T any](name string, opts ...Option) *Policy[T] {
 	}
 
 	if setup.circuitBreaker != nil {
-		circuitBreaker = NewCircuitBreaker(clock, &hooks, setup.circuitBreaker.opts...)
+		cbOpts := setup.circuitBreaker.opts
+		if setup.circuitStore != nil {
+			cbOpts = append(slices.Clone(cbOpts), SharedCircuitState(setup.circuitStore, name))
+		}
+
+		circuitBreaker = NewCircuitBreaker(clock, &hooks, cbOpts...)
 		entries = append(entries, newCircuitBreakerEntry[T](circuitBreaker))
 	}
 
 	if setup.rateLimit != nil {
-		rateLimiter = NewRateLimiter(setup.rateLimit.rate, clock, &hooks, setup.rateLimit.opts...)
-		entries = append(entries, newRateLimiterEntry[T](rateLimiter))
+		newLimiter := func() *RateLimiter {
+			return NewRateLimiter(setup.rateLimit.rate, clock, &hooks, setup.rateLimit.opts...)
+		}
+		rateLimiter = newLimiter()
+		rateLimiters = newPartitioned(setup.partitionKey, rateLimiter, newLimiter)
+		entries = append(entries, newRateLimiterEntry[T](rateLimiters, setup.rateLimit.cost))
 	}
 
 	if setup.bulkhead != nil {
-		bulkhead = NewBulkhead(setup.bulkhead.maxConcurrent, clock, &hooks, setup.bulkhead.opts...)
-		entries = append(entries, newBulkheadEntry[T](bulkhead))
+		newBulkhead := func() *Bulkhead {
+			return NewBulkhead(setup.bulkhead.maxConcurrent, clock, &hooks, setup.bulkhead.opts...)
+		}
+		bulkhead = newBulkhead()
+		bulkheads = newPartitioned(setup.partitionKey, bulkhead, newBulkhead)
+		entries = append(entries, newBulkheadEntry[T](bulkheads))
 	}
 
 	if setup.adaptive != nil {
@@ -743,24 +1347,37 @@ func NewPolicy[T any](name string, opts ...Option) *Policy[T] {
 		entries = append(entries, newSLOEntry[T](slo))
 	}
 
+	if setup.loadShed != nil {
+		loadShedder = NewLoadShedder(clock, &hooks, setup.loadShed.opts...)
+		entries = append(entries, newLoadShedEntry[T](loadShedder, clock))
+	}
+
 	if setup.hedge != nil {
 		hedgeCell = new(atomic.Int64)
 		hedgeCell.Store(int64(*setup.hedge))
 
+		base := HedgeParams{
+			Hooks:       &hooks,
+			Clock:       clock,
+			Budget:      setup.concurrencyBudget,
+			ShouldHedge: setup.hedgePredicate,
+			Selector:    setup.hedgeSelector,
+			SelectWait:  setup.hedgeSelectWait,
+		}
+		hedgeSelector[T](&base) // a mistyped selector panics here, not per call
+
 		if setup.hedgeAdaptive != nil {
 			adaptiveHedge = newAdaptiveHedge(setup.hedgeAdaptive, clock)
-			entries = append(
-				entries,
-				newAdaptiveHedgeEntry[T](hedgeCell, adaptiveHedge, &hooks, setup.concurrencyBudget),
-			)
+			entries = append(entries, newAdaptiveHedgeEntry[T](hedgeCell, adaptiveHedge, base))
 		} else {
-			entries = append(
-				entries,
-				newHedgeEntry[T](hedgeCell, &hooks, clock, setup.concurrencyBudget),
-			)
+			entries = append(entries, newHedgeEntry[T](hedgeCell, base))
 		}
 	}
 
+	if setup.attemptsHooked() {
+		entries = append(entries, newAttemptEntry[T](clock, &hooks))
+	}
+
 	if setup.panicRecover {
 		entries = append(entries, newRecoverEntry[T](&hooks))
 	}
@@ -773,7 +1390,18 @@ func NewPolicy[T any](name string, opts ...Option) *Policy[T] {
 		entries = append(entries, newCacheEntry[T](setup.cache, clock, &hooks))
 	}
 
-	if setup.coalesce != nil {
+	if setup.idempotency != nil {
+		entries = append(entries, newIdempotencyEntry[T](setup.idempotency))
+	}
+
+	switch {
+	case setup.coalesce != nil && setup.coalesce.window > 0:
+		debouncer = NewDebouncer[T](clock, &hooks, setup.coalesce.window)
+		entries = append(
+			entries,
+			newDebounceEntry[T](debouncer, setup.coalesce.keyFn),
+		)
+	case setup.coalesce != nil:
 		coalescer = NewCoalescer[T](&hooks)
 		entries = append(
 			entries,
@@ -789,28 +1417,58 @@ func NewPolicy[T any](name string, opts ...Option) *Policy[T] {
 		entries = append(entries, newFuncFallbackEntry[T](*setup.fallbackFunc, &hooks))
 	}
 
-	chain := Chain[T](SortPatterns[T](entries)...)
+	if setup.fallbackChain != nil {
+		entries = append(entries, newChainFallbackEntry[T](*setup.fallbackChain, &hooks))
+	}
+
+	// Appended after the fallbacks at the same priority, so it sits inside
+	// them and a remembered result is served before any fallback runs.
+	if setup.lastGood != nil {
+		entries = append(entries, newLastGoodEntry[T](setup.lastGood, clock, &hooks))
+	}
 
-	var reg *Registry
-	if name != "" {
-		reg = setup.registry
-		if reg == nil {
-			reg = DefaultRegistry()
+	if setup.staleCache != nil {
+		entries = append(entries, newStaleCacheEntry[T](setup.staleCache, clock, &hooks))
+	}
+
+	sorted := sortEntries(entries)
+
+	patterns := make([]string, 0, len(sorted))
+	mws := make([]Middleware[T], 0, len(sorted))
+	disabled := make(map[string]*atomic.Bool, len(sorted))
+
+	for _, e := range sorted {
+		flag, ok := disabled[e.Name]
+		if !ok {
+			flag = new(atomic.Bool)
+			disabled[e.Name] = flag
 		}
+
+		patterns = append(patterns, e.Name)
+		mws = append(mws, switchable(e.MW, flag))
 	}
 
+	chain := composeChain(mws)
+
 	policy := &Policy[T]{
 		name:              name,
+		label:             setup.label,
 		chain:             chain,
+		patterns:          patterns,
+		disabled:          disabled,
 		circuitBreaker:    circuitBreaker,
 		rateLimiter:       rateLimiter,
 		bulkhead:          bulkhead,
+		rateLimiters:      rateLimiters,
+		bulkheads:         bulkheads,
 		adaptive:          adaptive,
 		throttler:         throttler,
 		slo:               slo,
+		loadShedder:       loadShedder,
 		retryBudget:       setup.retryBudget,
 		concurrencyBudget: setup.concurrencyBudget,
 		coalescer:         coalescer,
+		debouncer:         debouncer,
 		metrics:           metrics,
 		clock:             clock,
 		latency:           newLatencyWindow(clock),
@@ -823,6 +1481,12 @@ func NewPolicy[T any](name string, opts ...Option) *Policy[T] {
 		deps:              setup.deps,
 		affectsReadiness:  setup.affectsReadiness,
 		registry:          reg,
+		opts:              setup.opts,
+		onOutcome:         hooks.OnOutcome,
+		hooks:             slot,
+		logged:            setup.logger != nil,
+		contextField:      setup.contextField,
+		errorClassifier:   setup.errorClassifier,
 	}
 
 	if reg != nil {
@@ -894,6 +1558,38 @@ func checkSetupInvariants(setup *policySetup) error {
 		}
 	}
 
+	if setup.staleCache != nil {
+		switch {
+		case setup.staleCache.keyFn == nil:
+			return ErrCacheNilKeyFunc
+		case setup.staleCache.cache == nil:
+			return ErrCacheNilCache
+		case setup.staleCache.ttl <= 0:
+			return ErrCacheNonPositiveTTL
+		}
+	}
+
+	if setup.timeoutSoft != nil &&
+		(*setup.timeoutSoft <= 0 || *setup.timeoutSoft >= *setup.timeout) {
+		return ErrSoftTimeoutNotBelowHard
+	}
+
+	if setup.idempotency != nil {
+		switch {
+		case setup.idempotency.keyFn == nil:
+			return ErrIdempotencyNilKeyFunc
+		case setup.idempotency.store == nil:
+			return ErrIdempotencyNilStore
+		case setup.idempotency.ttl <= 0:
+			return ErrIdempotencyNonPositiveTTL
+		}
+	}
+
+	// A circuit store shares breaker state; without a breaker there is none.
+	if setup.circuitStore != nil && setup.circuitBreaker == nil {
+		return ErrCircuitStoreWithoutBreaker
+	}
+
 	// The bulkhead and the adaptive limiter both drive the concurrency slot;
 	// configuring both is contradictory.
 	if setup.bulkhead != nil && setup.adaptive != nil {
@@ -912,13 +1608,17 @@ func checkSetupInvariants(setup *policySetup) error {
 // Per-pattern middleware entry builders
 // ---------------------------------------------------------------------------.
 
-func newTimeoutEntry[T any](cell *atomic.Int64, hooks *Hooks) PatternEntry[T] {
+func newTimeoutEntry[T any](
+	cell *atomic.Int64,
+	run timeoutRunner[T],
+	hooks *Hooks,
+) PatternEntry[T] {
 	return PatternEntry[T]{
 		Priority: priorityTimeout,
 		Name:     "timeout",
 		MW: func(next func(context.Context) (T, error)) func(context.Context) (T, error) {
 			return func(ctx context.Context) (T, error) {
-				return DoTimeout[T](ctx, time.Duration(cell.Load()), next, hooks)
+				return run(ctx, callTimeout(ctx, time.Duration(cell.Load())), next, hooks)
 			}
 		},
 	}
@@ -931,6 +1631,7 @@ func newTimeoutEntry[T any](cell *atomic.Int64, hooks *Hooks) PatternEntry[T] {
 func newAdaptiveTimeoutEntry[T any](
 	cell *atomic.Int64,
 	at *adaptiveTimeout,
+	run timeoutRunner[T],
 	hooks *Hooks,
 ) PatternEntry[T] {
 	return PatternEntry[T]{
@@ -940,7 +1641,7 @@ func newAdaptiveTimeoutEntry[T any](
 			return func(ctx context.Context) (T, error) {
 				ceiling := time.Duration(cell.Load())
 				start := at.clock.Now()
-				result, err := DoTimeout[T](ctx, at.compute(ceiling), next, hooks)
+				result, err := run(ctx, callTimeout(ctx, at.compute(ceiling)), next, hooks)
 				at.record(at.clock.Since(start), err)
 
 				return result, err
@@ -1045,15 +1746,30 @@ func newConcurrencyBudgetEntry[T any](budget *ConcurrencyBudget) PatternEntry[T]
 	}
 }
 
+// newCircuitBreakerEntry builds the breaker middleware. It admits and records
+// exactly once per pass: outside retry (the default) that is once per call, so
+// a call that fails then succeeds on retry records a single success; with
+// [CountAttempts] the entry sits inside retry and records once per attempt,
+// marking a rejection [Permanent] so retry stops rather than backing off
+// against an open breaker.
 func newCircuitBreakerEntry[T any](cb *CircuitBreaker) PatternEntry[T] {
+	priority := priorityCircuitBreaker
+	if cb.cfg.countAttempts {
+		priority = priorityAttemptBreaker
+	}
+
 	return PatternEntry[T]{
-		Priority: priorityCircuitBreaker,
+		Priority: priority,
 		Name:     "circuit_breaker",
 		MW: func(next func(context.Context) (T, error)) func(context.Context) (T, error) {
 			return func(ctx context.Context) (T, error) {
-				if err := cb.Allow(); err != nil {
+				if err := cb.AllowContext(ctx); err != nil {
 					var zero T
 
+					if priority == priorityAttemptBreaker {
+						return zero, Permanent(err)
+					}
+
 					return zero, err //nolint:wrapcheck // circuit breaker error returned as-is
 				}
 
@@ -1062,7 +1778,21 @@ func newCircuitBreakerEntry[T any](cb *CircuitBreaker) PatternEntry[T] {
 				// the work the breaker wraps — including inner retry/hedge — the
 				// same granularity at which it records success and failure.
 				start := cb.clock.Now()
+
+				// A next that panics never returns: record it as a failure on
+				// the way out and let the panic carry on unwinding, so the
+				// breaker's counts still see the call.
+				returned := false
+
+				defer func() {
+					if !returned {
+						cb.Record(cb.clock.Since(start), ErrPanic)
+					}
+				}()
+
 				val, err := next(ctx)
+				returned = true
+
 				cb.Record(cb.clock.Since(start), err)
 
 				return val, err //nolint:wrapcheck // caller's error returned as-is
@@ -1086,13 +1816,31 @@ func admitRecordEntry[T any](
 		Name:     name,
 		MW: func(next func(context.Context) (T, error)) func(context.Context) (T, error) {
 			return func(ctx context.Context) (T, error) {
+				if err := ctx.Err(); err != nil {
+					var zero T
+
+					return zero, err //nolint:wrapcheck // preserving context error identity
+				}
+
 				if err := admit(ctx); err != nil {
 					var zero T
 
 					return zero, err //nolint:wrapcheck // admission error returned as-is
 				}
 
+				// As in the breaker entry, a panicking next is recorded as an
+				// ErrPanic failure before the panic unwinds further.
+				returned := false
+
+				defer func() {
+					if !returned {
+						record(ErrPanic)
+					}
+				}()
+
 				val, err := next(ctx)
+				returned = true
+
 				record(err)
 
 				return val, err //nolint:wrapcheck // caller's error returned as-is
@@ -1101,18 +1849,62 @@ func admitRecordEntry[T any](
 	}
 }
 
-func newRateLimiterEntry[T any](rl *RateLimiter) PatternEntry[T] {
-	return admitRecordEntry[T](
-		priorityRateLimiter, "rate_limiter", rl.Allow, rl.RecordOutcome,
-	)
+// newRateLimiterEntry admits each call through its partition's limiter, taking
+// cost tokens; an unpartitioned set always yields the one limiter.
+func newRateLimiterEntry[T any](limiters *partitioned[*RateLimiter], cost int) PatternEntry[T] {
+	if limiters.keyFn == nil {
+		rl := limiters.base
+		allow := func(ctx context.Context) error { return rl.AllowN(ctx, cost) }
+
+		return admitRecordEntry[T](
+			priorityRateLimiter, "rate_limiter", allow, rl.RecordOutcome,
+		)
+	}
+
+	return PatternEntry[T]{
+		Priority: priorityRateLimiter,
+		Name:     "rate_limiter",
+		MW: func(next func(context.Context) (T, error)) func(context.Context) (T, error) {
+			return func(ctx context.Context) (T, error) {
+				if err := ctx.Err(); err != nil {
+					var zero T
+
+					return zero, err //nolint:wrapcheck // preserving context error identity
+				}
+
+				rl := limiters.forCall(ctx)
+				if err := rl.AllowN(ctx, cost); err != nil {
+					var zero T
+
+					return zero, err //nolint:wrapcheck // admission error returned as-is
+				}
+
+				returned := false
+
+				defer func() {
+					if !returned {
+						rl.RecordOutcome(ErrPanic)
+					}
+				}()
+
+				val, err := next(ctx)
+				returned = true
+
+				rl.RecordOutcome(err)
+
+				return val, err //nolint:wrapcheck // caller's error returned as-is
+			}
+		},
+	}
 }
 
-func newBulkheadEntry[T any](bh *Bulkhead) PatternEntry[T] {
+func newBulkheadEntry[T any](bulkheads *partitioned[*Bulkhead]) PatternEntry[T] {
 	return PatternEntry[T]{
 		Priority: priorityBulkhead,
 		Name:     "bulkhead",
 		MW: func(next func(context.Context) (T, error)) func(context.Context) (T, error) {
 			return func(ctx context.Context) (T, error) {
+				bh := bulkheads.forCall(ctx)
 				if err := bh.Acquire(ctx); err != nil {
 					var zero T
 
@@ -1133,16 +1925,29 @@ func newAdaptiveEntry[T any](limiter *AdaptiveLimiter) PatternEntry[T] {
 		Name:     "adaptive_concurrency",
 		MW: func(next func(context.Context) (T, error)) func(context.Context) (T, error) {
 			return func(ctx context.Context) (T, error) {
-				done, err := limiter.Acquire()
+				if err := ctx.Err(); err != nil {
+					var zero T
+
+					return zero, err //nolint:wrapcheck // preserving context error identity
+				}
+
+				done, err := limiter.acquire()
 				if err != nil {
 					var zero T
 
 					return zero, err //nolint:wrapcheck // limiter error returned as-is
 				}
 
-				defer done()
+				var callErr error
 
-				return next(ctx)
+				// Deferred so the slot is returned even if next panics.
+				defer func() { done(isLimiterDrop(ctx, callErr)) }()
+
+				var result T
+
+				result, callErr = next(ctx)
+
+				return result, callErr
 			}
 		},
 	}
@@ -1160,23 +1965,49 @@ func newSLOEntry[T any](gov *SLOGovernor) PatternEntry[T] {
 	)
 }
 
-func newHedgeEntry[T any](
-	cell *atomic.Int64,
-	hooks *Hooks,
-	clock Clock,
-	budget *ConcurrencyBudget,
-) PatternEntry[T] {
+// newLoadShedEntry admits each call through the shedder and records its
+// latency, timed with the policy clock. The record is deferred so a panicking
+// call still leaves the in-flight count.
+func newLoadShedEntry[T any](shedder *LoadShedder, clock Clock) PatternEntry[T] {
+	return PatternEntry[T]{
+		Priority: priorityLoadShed,
+		Name:     "load_shed",
+		MW: func(next func(context.Context) (T, error)) func(context.Context) (T, error) {
+			return func(ctx context.Context) (T, error) {
+				if err := ctx.Err(); err != nil {
+					var zero T
+
+					return zero, err //nolint:wrapcheck // preserving context error identity
+				}
+
+				if err := shedder.Allow(ctx); err != nil {
+					var zero T
+
+					return zero, err //nolint:wrapcheck // admission error returned as-is
+				}
+
+				start := clock.Now()
+				defer func() { shedder.Record(clock.Since(start)) }()
+
+				return next(ctx)
+			}
+		},
+	}
+}
+
+// newHedgeEntry builds the fixed-delay hedge middleware: each call runs DoHedge
+// with base (hooks, clock, budget, predicate and selector) and the delay read
+// from the reloadable cell.
+func newHedgeEntry[T any](cell *atomic.Int64, base HedgeParams) PatternEntry[T] {
 	return PatternEntry[T]{
 		Priority: priorityHedge,
 		Name:     "hedge",
 		MW: func(next func(context.Context) (T, error)) func(context.Context) (T, error) {
 			return func(ctx context.Context) (T, error) {
-				return DoHedge[T](ctx, next, HedgeParams{
-					Delay:  time.Duration(cell.Load()),
-					Hooks:  hooks,
-					Clock:  clock,
-					Budget: budget,
-				})
+				params := base
+				params.Delay = time.Duration(cell.Load())
+
+				return DoHedge[T](ctx, next, params)
 			}
 		},
 	}
@@ -1186,28 +2017,24 @@ func newHedgeEntry[T any](
 // fires after ah.compute(ceiling) where ceiling is the reloadable cell, and each
 // primary attempt's completion latency is recorded back into the controller's
 // percentile window (success-only — a winning hedge cancels the primary, whose
-// censored latency the recorder then drops). The controller carries the policy
-// clock (ah.clock), so it need not be threaded in separately.
+// censored latency the recorder then drops). The rest of each call's params
+// come from base.
 func newAdaptiveHedgeEntry[T any](
 	cell *atomic.Int64,
 	ah *adaptiveHedge,
-	hooks *Hooks,
-	budget *ConcurrencyBudget,
+	base HedgeParams,
 ) PatternEntry[T] {
+	base.RecordPrimary = ah.record
+
 	return PatternEntry[T]{
 		Priority: priorityHedge,
 		Name:     "hedge",
 		MW: func(next func(context.Context) (T, error)) func(context.Context) (T, error) {
 			return func(ctx context.Context) (T, error) {
-				ceiling := time.Duration(cell.Load())
+				params := base
+				params.Delay = ah.compute(time.Duration(cell.Load()))
 
-				return DoHedge[T](ctx, next, HedgeParams{
-					Delay:         ah.compute(ceiling),
-					Hooks:         hooks,
-					Clock:         ah.clock,
-					Budget:        budget,
-					RecordPrimary: ah.record,
-				})
+				return DoHedge[T](ctx, next, params)
 			}
 		},
 	}
@@ -1224,12 +2051,30 @@ func newAdaptiveHedgeEntry[T any](
 // is a pure, independent field setter). Only ever called for a non-nil
 // descriptor (see checkSetupInvariants).
 func (d *cacheDesc) refreshAheadEnabled() bool {
+	cfg := d.resolvedOptions()
+
+	return cfg.refreshTTL > 0 && cfg.refreshTTL < d.ttl
+}
+
+// priority returns the cache layer's chain priority: just inside the fallback by
+// default, or just inside the circuit breaker with [CacheInsideBreaker].
+func (d *cacheDesc) priority() int {
+	if d.resolvedOptions().insideBreaker {
+		return priorityCacheInBreaker
+	}
+
+	return priorityCache
+}
+
+// resolvedOptions applies the descriptor's opaque [CacheOption] setters to a
+// throwaway cacheOptions so construction-time checks can inspect them.
+func (d *cacheDesc) resolvedOptions() cacheOptions {
 	var cfg cacheOptions
 	for _, opt := range d.opts {
 		opt(&cfg)
 	}
 
-	return cfg.refreshTTL > 0 && cfg.refreshTTL < d.ttl
+	return cfg
 }
 
 // newCacheEntry builds the read-through-cache middleware. It asserts the erased
@@ -1256,7 +2101,7 @@ func newCacheEntry[T any](
 	rc := NewReadThroughCache[T](cache, desc.ttl, opts...)
 
 	return PatternEntry[T]{
-		Priority: priorityCache,
+		Priority: desc.priority(),
 		Name:     "cache",
 		MW: func(next func(context.Context) (T, error)) func(context.Context) (T, error) {
 			return func(ctx context.Context) (T, error) {
@@ -1266,6 +2111,32 @@ func newCacheEntry[T any](
 	}
 }
 
+// newIdempotencyEntry builds the idempotency-guard middleware. It asserts the
+// erased store back to IdempotencyStore[T], panicking on a mismatch like the
+// fallback entries.
+func newIdempotencyEntry[T any](desc *idempotencyDesc) PatternEntry[T] {
+	store, ok := desc.store.(IdempotencyStore[T])
+	if !ok {
+		var zero T
+
+		panic(fmt.Sprintf(
+			"r8e: WithIdempotency store has type %T, which does not match policy "+
+				"result type IdempotencyStore[%T]",
+			desc.store, zero,
+		))
+	}
+
+	return PatternEntry[T]{
+		Priority: priorityIdempotency,
+		Name:     "idempotency",
+		MW: func(next func(context.Context) (T, error)) func(context.Context) (T, error) {
+			return func(ctx context.Context) (T, error) {
+				return DoIdempotent[T](ctx, next, desc.keyFn(ctx), store, desc.ttl)
+			}
+		},
+	}
+}
+
 func newCoalesceEntry[T any](
 	coalescer *Coalescer[T],
 	keyFn func(context.Context) string,
@@ -1287,14 +2158,34 @@ func newCoalesceEntry[T any](
 	}
 }
 
+func newDebounceEntry[T any](
+	debouncer *Debouncer[T],
+	keyFn func(context.Context) string,
+) PatternEntry[T] {
+	return PatternEntry[T]{
+		Priority: priorityCoalesce,
+		Name:     "debounce",
+		MW: func(next func(context.Context) (T, error)) func(context.Context) (T, error) {
+			return func(ctx context.Context) (T, error) {
+				key := keyFn(ctx)
+				if key == "" {
+					return next(ctx)
+				}
+
+				return debouncer.Do(ctx, key, next)
+			}
+		},
+	}
+}
+
 func newStaticFallbackEntry[T any](desc staticFallback, hooks *Hooks) PatternEntry[T] {
 	val, ok := desc.value.(T)
 	if !ok {
 		var zero T
 
-		panic(fmt.Sprintf(
-			"r8e: WithFallback value has type %T, which does not match policy result type %T",
-			desc.value, zero,
+		panic(fmt.Errorf(
+			"%w: WithFallback value has type %T, policy result type is %T",
+			ErrFallbackTypeMismatch, desc.value, zero,
 		))
 	}
 
@@ -1314,9 +2205,9 @@ func newFuncFallbackEntry[T any](desc funcFallback, hooks *Hooks) PatternEntry[T
 	if !ok {
 		var zero T
 
-		panic(fmt.Sprintf(
-			"r8e: WithFallbackFunc has type %T, which does not match policy result type %T",
-			desc.fn, zero,
+		panic(fmt.Errorf(
+			"%w: WithFallbackFunc has type %T, policy result type is %T",
+			ErrFallbackTypeMismatch, desc.fn, zero,
 		))
 	}
 
@@ -1330,3 +2221,25 @@ func newFuncFallbackEntry[T any](desc funcFallback, hooks *Hooks) PatternEntry[T
 		},
 	}
 }
+
+func newChainFallbackEntry[T any](desc chainFallback, hooks *Hooks) PatternEntry[T] {
+	providers, ok := desc.providers.([]func(context.Context, error) (T, error))
+	if !ok {
+		var zero T
+
+		panic(fmt.Errorf(
+			"%w: WithFallbackChain providers have type %T, policy result type is %T",
+			ErrFallbackTypeMismatch, desc.providers, zero,
+		))
+	}
+
+	return PatternEntry[T]{
+		Priority: priorityFallback,
+		Name:     "fallback_chain",
+		MW: func(next func(context.Context) (T, error)) func(context.Context) (T, error) {
+			return func(ctx context.Context) (T, error) {
+				return DoFallbackChain[T](ctx, next, providers, hooks)
+			}
+		},
+	}
+}
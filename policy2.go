@@ -0,0 +1,69 @@
+package r8e
+
+import "context"
+
+type (
+	// Pair carries the two results of a [Policy2] call through the
+	// single-valued policy machinery. Options typed by the policy's result —
+	// [WithCache], [ChaosOutcome], and the like — take a Pair[A, B].
+	Pair[A, B any] struct {
+		First  A
+		Second B
+	}
+
+	// Policy2 is a [Policy] for functions returning two values and an error,
+	// such as (data, meta, error), sparing callers a wrapper struct. It applies
+	// the same patterns in the same order: the values are packed into a [Pair]
+	// on the way out of fn and unpacked on the way out of [Policy2.Do2]. The
+	// embedded Policy gives access to its name, health, metrics, and
+	// [Policy.Reconfigure].
+	//
+	// Pattern: Adapter — reuses Policy[Pair[A, B]] rather than duplicating the
+	// middleware chain for a second result.
+	Policy2[A, B any] struct {
+		*Policy[Pair[A, B]]
+	}
+)
+
+// NewPolicy2 creates a [Policy2] with the given name and options, exactly as
+// [NewPolicy] does. Fallbacks are given with [WithFallback2] and
+// [WithFallbackFunc2].
+func NewPolicy2[A, B any](name string, opts ...Option) *Policy2[A, B] {
+	return &Policy2[A, B]{Policy: NewPolicy[Pair[A, B]](name, opts...)}
+}
+
+// Do2 executes fn through the policy's patterns and returns its two values and
+// error as [Policy.Do] would return them: a timeout, exhausted retries, or a
+// rejection (open breaker, rate limit, ...) yields the zero values.
+//
+//nolint:ireturn // generic type parameters A and B, not interfaces
+func (p *Policy2[A, B]) Do2(
+	ctx context.Context,
+	fn func(context.Context) (A, B, error),
+) (A, B, error) {
+	result, err := p.Do(ctx, func(ctx context.Context) (Pair[A, B], error) {
+		first, second, err := fn(ctx)
+
+		return Pair[A, B]{First: first, Second: second}, err
+	})
+
+	return result.First, result.Second, err
+}
+
+// WithFallback2 adds static fallback values returned by [Policy2.Do2] when the
+// call fails. Their types must match the Policy2's type parameters; a mismatch
+// panics in [NewPolicy2].
+func WithFallback2[A, B any](first A, second B) Option {
+	return WithFallback(Pair[A, B]{First: first, Second: second})
+}
+
+// WithFallbackFunc2 adds a fallback function called with the error when a
+// [Policy2] call fails. Its result types must match the Policy2's type
+// parameters; a mismatch panics in [NewPolicy2].
+func WithFallbackFunc2[A, B any](fn func(error) (A, B, error)) Option {
+	return WithFallbackFunc(func(err error) (Pair[A, B], error) {
+		first, second, fallbackErr := fn(err)
+
+		return Pair[A, B]{First: first, Second: second}, fallbackErr
+	})
+}
@@ -0,0 +1,85 @@
+package r8e
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicy2RetryPropagatesBothValues(t *testing.T) {
+	t.Parallel()
+
+	p := NewPolicy2[int, string]("",
+		WithClock(newImmediateTestClock()),
+		WithRetry(3, ConstantBackoff(time.Millisecond)),
+	)
+
+	calls := 0
+
+	count, label, err := p.Do2(context.Background(), func(context.Context) (int, string, error) {
+		calls++
+		if calls < 3 {
+			return 0, "", errors.New("flaky")
+		}
+
+		return 42, "answer", nil
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 42, count)
+	assert.Equal(t, "answer", label)
+	assert.Equal(t, 3, calls)
+	assert.Equal(t, int64(2), p.Metrics().Retries, "the embedded policy reports")
+}
+
+func TestPolicy2TimeoutReturnsZeroValues(t *testing.T) {
+	t.Parallel()
+
+	p := NewPolicy2[int, string]("", WithTimeout(10*time.Millisecond))
+
+	count, label, err := p.Do2(context.Background(), func(ctx context.Context) (int, string, error) {
+		<-ctx.Done()
+
+		return 7, "late", ctx.Err()
+	})
+
+	require.ErrorIs(t, err, ErrTimeout)
+	assert.Zero(t, count)
+	assert.Empty(t, label)
+}
+
+func TestPolicy2Fallbacks(t *testing.T) {
+	t.Parallel()
+
+	failing := func(context.Context) (int, string, error) {
+		return 0, "", errors.New("down")
+	}
+
+	static := NewPolicy2[int, string]("", WithFallback2(-1, "cached"))
+
+	count, label, err := static.Do2(context.Background(), failing)
+	require.NoError(t, err)
+	assert.Equal(t, -1, count)
+	assert.Equal(t, "cached", label)
+
+	dynamic := NewPolicy2[int, string]("", WithFallbackFunc2(func(err error) (int, string, error) {
+		return 0, err.Error(), nil
+	}))
+
+	count, label, err = dynamic.Do2(context.Background(), failing)
+	require.NoError(t, err)
+	assert.Zero(t, count)
+	assert.Equal(t, "down", label)
+}
+
+func TestPolicy2FallbackTypeMismatchPanics(t *testing.T) {
+	t.Parallel()
+
+	assert.Panics(t, func() {
+		NewPolicy2[int, string]("", WithFallback2("wrong", 1))
+	})
+}
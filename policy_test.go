@@ -463,6 +463,65 @@ func TestPolicyName(t *testing.T) {
 	require.Equal(t, "my-policy", p.Name())
 }
 
+// ---------------------------------------------------------------------------
+// TestPolicyWith — derived policies override options, base stays unchanged
+// ---------------------------------------------------------------------------
+
+func TestPolicyWith(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		reg := NewRegistry()
+		base := NewPolicy[string]("orders",
+			WithRegistry(reg),
+			WithTimeout(time.Second),
+			WithCircuitBreaker(FailureThreshold(3)),
+		)
+		derived := base.With("orders-fast", WithTimeout(50*time.Millisecond))
+
+		require.Equal(t, "orders", base.Name())
+		require.Equal(t, "orders-fast", derived.Name())
+		require.Equal(t, base.Patterns(), derived.Patterns())
+		require.Equal(t, time.Second, base.WorstCaseDuration())
+		require.Equal(t, 50*time.Millisecond, derived.WorstCaseDuration())
+		require.NotSame(t, base.circuitBreaker, derived.circuitBreaker)
+
+		slow := func(ctx context.Context) (string, error) {
+			select {
+			case <-time.After(200 * time.Millisecond):
+				return "done", nil
+			case <-ctx.Done():
+				return "", ctx.Err()
+			}
+		}
+
+		result, err := base.Do(context.Background(), slow)
+		require.NoError(t, err)
+		require.Equal(t, "done", result)
+
+		_, err = derived.Do(context.Background(), slow)
+		require.ErrorIs(t, err, ErrTimeout)
+
+		var names []string
+		for _, m := range reg.Snapshot() {
+			names = append(names, m.Name)
+		}
+
+		require.ElementsMatch(t, []string{"orders", "orders-fast"}, names)
+	})
+}
+
+func TestPolicyWithLayersOnTemplateOptions(t *testing.T) {
+	t.Parallel()
+
+	base := NewPolicyTemplate[string](WithTimeout(time.Second)).New("template-base")
+	derived := base.With("template-derived",
+		WithRetry(3, ConstantBackoff(time.Millisecond), PerAttemptTimeout(100*time.Millisecond)))
+
+	require.Equal(t, []string{"timeout"}, base.Patterns())
+	require.Equal(t, []string{"timeout", "retry"}, derived.Patterns())
+	// 3 × 100ms attempts + 2 × 1ms backoffs, inside the inherited timeout.
+	require.Equal(t, 302*time.Millisecond, derived.WorstCaseDuration())
+}
+
 // ---------------------------------------------------------------------------
 // TestPolicyDoConcurrent — concurrent Do calls are safe (for race detector)
 // ---------------------------------------------------------------------------
@@ -511,10 +570,144 @@ func TestPolicyPassthroughError(t *testing.T) {
 	require.ErrorIs(t, err, sentinel)
 }
 
+// ---------------------------------------------------------------------------
+// TestPolicyCancelledContextShortCircuitsAdmission — a done ctx takes nothing
+// ---------------------------------------------------------------------------
+
+func TestPolicyCancelledContextShortCircuitsAdmission(t *testing.T) {
+	t.Parallel()
+
+	clk := &stubClock{now: time.Now()}
+	p := NewPolicy[string]("cancelled-admission",
+		WithClock(clk),
+		WithCircuitBreaker(FailureThreshold(1), RecoveryTimeout(time.Second)),
+		WithRateLimit(2), // the breaker-opening call below spends one token
+		WithBulkhead(1),
+	)
+
+	// Open the breaker, then let the recovery window elapse: the next admitted
+	// call would be the half-open probe.
+	_, _ = p.Do(context.Background(), func(_ context.Context) (string, error) {
+		return "", errors.New("boom")
+	})
+	require.Equal(t, CircuitOpen, p.circuitBreaker.State())
+	clk.setElapsed(2 * time.Second)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var calls int
+
+	_, err := p.Do(ctx, func(_ context.Context) (string, error) {
+		calls++
+
+		return "ok", nil
+	})
+	require.ErrorIs(t, err, context.Canceled)
+	require.Zero(t, calls)
+
+	// No admission layer acted on the cancelled call.
+	require.Equal(t, CircuitOpen, p.circuitBreaker.State(), "no half-open probe consumed")
+	require.Zero(t, p.bulkhead.InUse())
+	require.False(t, p.rateLimiter.Saturated(), "no token consumed")
+}
+
+// ---------------------------------------------------------------------------
+// composeChain — fast paths behave exactly like Chain
+// ---------------------------------------------------------------------------
+
+func TestComposeChainMatchesChain(t *testing.T) {
+	t.Parallel()
+
+	// tagging appends its tag on the way in and out, so the trace records both
+	// nesting order and that every middleware wrapped next exactly once.
+	tagging := func(tag string, trace *[]string) Middleware[string] {
+		return func(next func(context.Context) (string, error)) func(context.Context) (string, error) {
+			return func(ctx context.Context) (string, error) {
+				*trace = append(*trace, tag+">")
+				v, err := next(ctx)
+				*trace = append(*trace, "<"+tag)
+
+				return tag + "(" + v + ")", err
+			}
+		}
+	}
+
+	sentinel := errors.New("inner")
+
+	for n := range 4 {
+		var composedTrace, chainTrace []string
+
+		var composed, chained []Middleware[string]
+		for i := range n {
+			tag := string(rune('a' + i))
+			composed = append(composed, tagging(tag, &composedTrace))
+			chained = append(chained, tagging(tag, &chainTrace))
+		}
+
+		fn := func(_ context.Context) (string, error) { return "fn", sentinel }
+
+		got := fn
+		if mw := composeChain(composed); mw != nil {
+			got = mw(fn)
+		} else {
+			require.Zero(t, n, "only an empty chain composes to nil")
+		}
+
+		gotVal, gotErr := got(context.Background())
+		wantVal, wantErr := Chain(chained...)(fn)(context.Background())
+
+		require.Equal(t, wantVal, gotVal, "value with %d patterns", n)
+		require.Equal(t, wantErr, gotErr, "error with %d patterns", n)
+		require.Equal(t, chainTrace, composedTrace, "order with %d patterns", n)
+	}
+}
+
 // ---------------------------------------------------------------------------
 // BenchmarkPolicyDo — benchmark Policy.Do with a single pattern
 // ---------------------------------------------------------------------------
 
+// BenchmarkChainFastPath compares the general Chain against the composeChain
+// fast paths used by Policy.Do, for zero and one pattern:
+//
+//	go test -run '^$' -bench '^BenchmarkChainFastPath$' -benchmem ./
+func BenchmarkChainFastPath(b *testing.B) {
+	ctx := context.Background()
+	okFn := func(_ context.Context) (string, error) { return "ok", nil }
+	fallback := newStaticFallbackEntry[string](staticFallback{value: "fb"}, &Hooks{}).MW
+
+	for _, bc := range []struct {
+		name string
+		mws  []Middleware[string]
+	}{
+		{"zero-patterns", nil},
+		{"one-pattern", []Middleware[string]{fallback}},
+	} {
+		b.Run(bc.name+"/chain", func(b *testing.B) {
+			b.ReportAllocs()
+
+			chain := Chain(bc.mws...)
+			for b.Loop() {
+				_, _ = chain(okFn)(ctx)
+			}
+		})
+
+		b.Run(bc.name+"/fast", func(b *testing.B) {
+			b.ReportAllocs()
+
+			chain := composeChain(bc.mws)
+			for b.Loop() {
+				wrapped := okFn
+				if chain != nil {
+					wrapped = chain(okFn)
+				}
+
+				_, _ = wrapped(ctx)
+			}
+		})
+	}
+}
+
 func BenchmarkPolicyDo(b *testing.B) {
 	p := NewPolicy[string]("bench",
 		WithFallback("fallback"),
@@ -2,6 +2,7 @@ package r8econf
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"time"
@@ -45,6 +46,10 @@ func LoadCacheConfig(path, name string) (r8e.CacheConfig, error) {
 		MaxSize: raw.MaxSize,
 	}
 
+	// Out-of-range values are collected and reported together, each with its
+	// path, as [r8e.ValidateConfig] reports a policy's.
+	var errs []error
+
 	if raw.TTL != "" {
 		ttl, ttlErr := time.ParseDuration(raw.TTL)
 		if ttlErr != nil {
@@ -55,8 +60,28 @@ func LoadCacheConfig(path, name string) (r8e.CacheConfig, error) {
 			)
 		}
 
+		if ttl <= 0 {
+			errs = append(errs, cacheFieldError(name, "ttl", "must be > 0"))
+		}
+
 		cacheCfg.TTL = ttl
 	}
 
+	if raw.MaxSize < 0 {
+		errs = append(errs, cacheFieldError(name, "max_size", "must be >= 0"))
+	}
+
+	if len(errs) > 0 {
+		return r8e.CacheConfig{}, errors.Join(errs...)
+	}
+
 	return cacheCfg, nil
 }
+
+// cacheFieldError reports an out-of-range field of the named cache entry.
+func cacheFieldError(name, field, problem string) error {
+	return fmt.Errorf("r8e: %w", &r8e.ConfigFieldError{
+		Path:    "caches." + name + "." + field,
+		Problem: problem,
+	})
+}
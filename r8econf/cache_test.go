@@ -8,6 +8,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/byte4ever/r8e"
 )
 
 func TestLoadCacheConfigValid(t *testing.T) {
@@ -43,3 +45,10 @@ func TestLoadCacheConfigInvalidTTL(t *testing.T) {
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "ttl")
 }
+
+func TestLoadCacheConfigOutOfRange(t *testing.T) {
+	_, err := LoadCacheConfig("../testdata/cache.json", "zero-ttl")
+	require.ErrorIs(t, err, r8e.ErrInvalidConfig)
+	assert.Contains(t, err.Error(), "caches.zero-ttl.ttl must be > 0")
+	assert.Contains(t, err.Error(), "caches.zero-ttl.max_size must be >= 0")
+}
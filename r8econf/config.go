@@ -8,7 +8,9 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"maps"
 	"os"
+	"slices"
 	"sync"
 
 	"github.com/byte4ever/r8e"
@@ -27,7 +29,13 @@ type (
 	Store struct {
 		configs  map[string]r8e.PolicyConfig
 		registry *r8e.Registry
-		mu       sync.RWMutex
+		// loaded stamps the file version configs came from, so [Store.Watch]
+		// reloads on any change since, including one made before it started.
+		loaded fileStamp
+		// envPrefixes are the prefixes given to [Store.ApplyEnvOverrides], whose
+		// overrides every reload reapplies.
+		envPrefixes []string
+		mu          sync.RWMutex
 	}
 )
 
@@ -50,34 +58,90 @@ func readConfig(path string) (map[string]r8e.PolicyConfig, error) {
 		return nil, fmt.Errorf("r8e: parse config: %w", err)
 	}
 
-	for name := range cfg.Policies {
-		pc := cfg.Policies[name]
-		if _, buildErr := r8e.BuildOptions(&pc); buildErr != nil {
-			return nil, fmt.Errorf("r8e: policy %q: %w", name, buildErr)
-		}
+	if err = validatePolicies(cfg.Policies); err != nil {
+		return nil, err
 	}
 
 	return cfg.Policies, nil
 }
 
+// validatePolicies checks every policy and returns all the problems found,
+// joined, rather than stopping at the first: each out-of-range value as
+// "r8e: policies.<name>.<field> <problem>" (see [r8e.ValidateConfig]), then,
+// for a policy whose values are all in range, the error [r8e.BuildOptions]
+// returns for it. Policies are visited in name order so the report is stable.
+func validatePolicies(policies map[string]r8e.PolicyConfig) error {
+	var errs []error
+
+	for _, name := range slices.Sorted(maps.Keys(policies)) {
+		pc := policies[name]
+
+		if err := r8e.ValidateConfig(&pc); err != nil {
+			errs = append(errs, prefixFieldErrors(err, "policies."+name+".")...)
+
+			continue
+		}
+
+		if _, err := r8e.BuildOptions(&pc); err != nil {
+			errs = append(errs, fmt.Errorf("r8e: policy %q: %w", name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// prefixFieldErrors splits the joined error [r8e.ValidateConfig] returns and
+// prefixes each field path, so it names the field within the whole file.
+func prefixFieldErrors(err error, prefix string) []error {
+	joined, ok := err.(interface{ Unwrap() []error })
+	if !ok {
+		return []error{fmt.Errorf("r8e: %w", err)}
+	}
+
+	out := make([]error, 0, len(joined.Unwrap()))
+
+	for _, e := range joined.Unwrap() {
+		var fieldErr *r8e.ConfigFieldError
+		if errors.As(e, &fieldErr) {
+			e = &r8e.ConfigFieldError{Path: prefix + fieldErr.Path, Problem: fieldErr.Problem}
+		}
+
+		out = append(out, fmt.Errorf("r8e: %w", e))
+	}
+
+	return out
+}
+
 // Load reads a JSON configuration file and returns a [Store] of policy
 // configurations. Actual [r8e.Policy] instances are not created until
 // [GetPolicy] is called, allowing the caller to provide type parameters and
 // additional code-level options.
 //
-// All policies are validated eagerly via [r8e.BuildOptions], so configuration
-// errors surface at load time rather than at [GetPolicy].
+// All policies are validated eagerly via [r8e.ValidateConfig] and
+// [r8e.BuildOptions], so configuration errors surface at load time rather than
+// at [GetPolicy]. Every problem in the file is reported at once, each
+// out-of-range value with its path, e.g.
+// "r8e: policies.payment-api.rate_limit must be >= 0".
 //
 // Duration values (timeout, recovery_timeout, base_delay, max_delay, hedge)
 // are parsed using time.ParseDuration. Supported backoff strategies:
-// "constant", "exponential", "linear", "exponential_jitter".
+// "constant", "exponential", "linear", "exponential_jitter", "full_jitter",
+// "equal_jitter", and any name added with [r8e.RegisterBackoff] before Load.
 func Load(path string) (*Store, error) {
+	// Stamp before reading: a write racing the read at worst costs Watch one
+	// redundant reload, never a missed one.
+	stamp, _ := statFile(path)
+
 	configs, err := readConfig(path)
 	if err != nil {
 		return nil, err
 	}
 
-	return &Store{configs: configs, registry: r8e.NewRegistry()}, nil
+	return &Store{
+		configs:  configs,
+		registry: r8e.NewRegistry(),
+		loaded:   stamp,
+	}, nil
 }
 
 // Reload re-reads and validates the configuration file, replaces the store's
@@ -86,15 +150,28 @@ func Load(path string) (*Store, error) {
 // simply pick up the new configuration on their next [GetPolicy]. A policy
 // whose set of patterns changed cannot be reconfigured in place and yields an
 // error (aggregated across all policies); the new configuration is still
-// stored.
+// stored. Environment overrides set up with [Store.ApplyEnvOverrides] are
+// reapplied on top of the new file.
 func (s *Store) Reload(path string) error {
+	stamp, _ := statFile(path)
+
 	configs, err := readConfig(path)
 	if err != nil {
 		return err
 	}
 
 	s.mu.Lock()
+
+	for _, prefix := range s.envPrefixes {
+		if configs, err = applyEnvOverrides(configs, prefix, os.Environ()); err != nil {
+			s.mu.Unlock()
+
+			return err
+		}
+	}
+
 	s.configs = configs
+	s.loaded = stamp
 	s.mu.Unlock()
 
 	var errs []error
@@ -77,6 +77,63 @@ func TestLoadErrorPaths(t *testing.T) {
 	}
 }
 
+func TestLoadReportsEveryOutOfRangeValue(t *testing.T) {
+	_, err := Load("../testdata/invalid_values.json")
+	require.Error(t, err)
+	require.ErrorIs(t, err, r8e.ErrInvalidConfig)
+
+	for _, want := range []string{
+		"policies.payment-api.timeout must be > 0",
+		"policies.payment-api.rate_limit must be >= 0",
+		"policies.payment-api.retry.max_attempts must be >= 1",
+		"policies.inventory-api.circuit_breaker.failure_threshold must be >= 1",
+		"policies.inventory-api.bulkhead must be >= 1",
+		"policies.search-api.hedge must be > 0",
+		"policies.search-api.concurrency_budget.max_ratio must be in (0, 1]",
+	} {
+		assert.Contains(t, err.Error(), want)
+	}
+
+	var fieldErr *r8e.ConfigFieldError
+	require.ErrorAs(t, err, &fieldErr)
+	assert.Equal(t, "policies.inventory-api.bulkhead", fieldErr.Path, "policies in name order")
+}
+
+func TestLoadOutOfRangeValue(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy string
+		want   string
+	}{
+		{"zero max_attempts", `{"retry": {"max_attempts": 0, "backoff": "constant", "base_delay": "1ms"}}`,
+			"policies.svc.retry.max_attempts must be >= 1"},
+		{"negative rate", `{"rate_limit": -1}`, "policies.svc.rate_limit must be >= 0"},
+		{"negative failure threshold", `{"circuit_breaker": {"failure_threshold": -1}}`,
+			"policies.svc.circuit_breaker.failure_threshold must be >= 1"},
+		{"zero half-open probes", `{"circuit_breaker": {"half_open_max_attempts": 0}}`,
+			"policies.svc.circuit_breaker.half_open_max_attempts must be >= 1"},
+		{"slow-call rate above 1", `{"circuit_breaker": {"slow_call_duration": "1s", "slow_call_rate_threshold": 1.5}}`,
+			"policies.svc.circuit_breaker.slow_call_rate_threshold must be in [0, 1]"},
+		{"error percentage above 100", `{"circuit_breaker": {"error_threshold_percentage": 101}}`,
+			"policies.svc.circuit_breaker.error_threshold_percentage must be in [1, 100]"},
+		{"negative base delay", `{"retry": {"max_attempts": 3, "backoff": "constant", "base_delay": "-1ms"}}`,
+			"policies.svc.retry.base_delay must be >= 0"},
+		{"zero time budget", `{"time_budget": "0s", "hedge": "10ms"}`, "policies.svc.time_budget must be > 0"},
+		{"adaptive limits inverted", `{"adaptive_concurrency": {"min_limit": 10, "max_limit": 5}}`,
+			"policies.svc.adaptive_concurrency.min_limit must not exceed max_limit"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := writeTempFile(t, `{"policies": {"svc": `+tt.policy+`}}`)
+
+			_, err := Load(path)
+			require.ErrorIs(t, err, r8e.ErrInvalidConfig)
+			assert.Contains(t, err.Error(), tt.want)
+		})
+	}
+}
+
 func TestGetPolicyFromConfigRuns(t *testing.T) {
 	store, err := Load("../testdata/valid.json")
 	require.NoError(t, err)
@@ -169,7 +226,7 @@ func TestGetPolicyRegistersInRegistry(t *testing.T) {
 }
 
 func TestLoadBackoffStrategies(t *testing.T) {
-	strategies := []string{"constant", "exponential", "linear", "exponential_jitter"}
+	strategies := []string{"constant", "exponential", "linear", "exponential_jitter", "full_jitter", "equal_jitter"}
 
 	for _, strat := range strategies {
 		t.Run(strat, func(t *testing.T) {
@@ -199,6 +256,50 @@ func TestLoadBackoffStrategies(t *testing.T) {
 	}
 }
 
+func TestLoadRegisteredBackoff(t *testing.T) {
+	var delays atomic.Int64
+
+	r8e.RegisterBackoff("r8econf_custom", func(base, _ time.Duration) r8e.BackoffStrategy {
+		return r8e.BackoffFunc(func(int) time.Duration {
+			delays.Add(1)
+
+			return base
+		})
+	})
+
+	path := writeTempFile(t, `{
+		"policies": {
+			"custom": {
+				"retry": {
+					"max_attempts": 3,
+					"backoff": "r8econf_custom",
+					"base_delay": "1ms"
+				}
+			}
+		}
+	}`)
+
+	store, err := Load(path)
+	require.NoError(t, err)
+
+	policy, err := GetPolicy[string](store, "custom")
+	require.NoError(t, err)
+
+	var calls int
+
+	got, err := policy.Do(context.Background(), func(context.Context) (string, error) {
+		calls++
+		if calls < 3 {
+			return "", errors.New("transient")
+		}
+
+		return "ok", nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "ok", got)
+	assert.Equal(t, int64(2), delays.Load())
+}
+
 // TestGetPolicyInvalidStoredConfig exercises the GetPolicy error path: a Store
 // holding an invalid config (only reachable by bypassing Load's eager
 // validation) must surface the build error rather than swallow it.
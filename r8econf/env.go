@@ -0,0 +1,297 @@
+package r8econf
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/byte4ever/r8e"
+)
+
+// envOverride is one environment variable that targets a stored policy field.
+type envOverride struct {
+	name  string // the variable, for error messages
+	value string
+	field []int // reflect index path into r8e.PolicyConfig
+}
+
+// envFields maps a normalized field path (JSON names upper-cased with the
+// underscores removed, e.g. "RETRYMAXATTEMPTS") to its index path in
+// [r8e.PolicyConfig]: one index for a top-level field, two for a field of a
+// nested block.
+//
+//nolint:gochecknoglobals // read-only lookup table, built once at init
+var envFields = buildEnvFields()
+
+func buildEnvFields() map[string][]int {
+	fields := make(map[string][]int)
+	policyType := reflect.TypeFor[r8e.PolicyConfig]()
+
+	for i := range policyType.NumField() {
+		field := policyType.Field(i)
+		name := jsonName(field)
+
+		block := field.Type.Elem()
+		if block.Kind() != reflect.Struct {
+			fields[normalizeEnv(name)] = []int{i}
+
+			continue
+		}
+
+		for j := range block.NumField() {
+			fields[normalizeEnv(name+jsonName(block.Field(j)))] = []int{i, j}
+		}
+	}
+
+	return fields
+}
+
+// jsonName returns the field's JSON name, without options.
+func jsonName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+
+	return name
+}
+
+// normalizeEnv upper-cases s and drops everything but letters and digits, so
+// "payment-api" and "max_attempts" compare as PAYMENTAPI and MAXATTEMPTS.
+func normalizeEnv(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z':
+			return r - 'a' + 'A'
+		case r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+			return r
+		default:
+			return -1
+		}
+	}, s)
+}
+
+// ApplyEnvOverrides overrides the stored policy configurations with
+// environment variables named <prefix>_<POLICY>_<FIELD>, for tuning a
+// containerized deployment without editing the mounted file:
+//
+//	R8E_PAYMENT_API_TIMEOUT=3s
+//	R8E_PAYMENTAPI_RETRY_MAXATTEMPTS=5
+//	R8E_PAYMENT_API_CIRCUIT_BREAKER_FAILURE_THRESHOLD=10
+//
+// POLICY is the policy name and FIELD the JSON path of the field (a nested
+// block's name followed by the field's), both compared upper-cased with
+// everything but letters and digits removed, so underscores between words are
+// optional. POLICY is matched by prefix against the stored policy names, the
+// longest first, so a name such as "payment-api" may span several segments.
+// Values use the file's syntax: durations such as "3s", numbers, and true or
+// false. A variable naming a policy absent from the store or an unknown field
+// is ignored.
+//
+// Overrides apply to the stored configurations, so policies built afterwards
+// with [GetPolicy] reflect them; policies already built are not retuned. The
+// prefix is remembered and the overrides reapplied by every later
+// [Store.Reload], so a hot reload does not drop them. A malformed value, or one
+// that makes a policy invalid, returns an error naming the offending variable
+// and leaves the store unchanged.
+func (s *Store) ApplyEnvOverrides(prefix string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	configs, err := applyEnvOverrides(s.configs, prefix, os.Environ())
+	if err != nil {
+		return err
+	}
+
+	s.configs = configs
+	if !slices.Contains(s.envPrefixes, prefix) {
+		s.envPrefixes = append(s.envPrefixes, prefix)
+	}
+
+	return nil
+}
+
+// applyEnvOverrides returns a copy of configs with the variables of environ
+// under prefix applied. configs itself is not modified.
+func applyEnvOverrides(
+	configs map[string]r8e.PolicyConfig,
+	prefix string,
+	environ []string,
+) (map[string]r8e.PolicyConfig, error) {
+	overrides := matchEnvOverrides(configs, prefix, environ)
+	if len(overrides) == 0 {
+		return configs, nil
+	}
+
+	updated := make(map[string]r8e.PolicyConfig, len(configs))
+	for name, pc := range configs {
+		updated[name] = pc
+	}
+
+	for name, vars := range overrides {
+		pc, err := overridePolicy(configs[name], vars)
+		if err != nil {
+			return nil, fmt.Errorf("r8e: policy %q: %w", name, err)
+		}
+
+		updated[name] = pc
+	}
+
+	return updated, nil
+}
+
+// matchEnvOverrides groups the variables of environ that target a stored
+// policy's known field by policy name, in variable-name order.
+func matchEnvOverrides(
+	configs map[string]r8e.PolicyConfig,
+	prefix string,
+	environ []string,
+) map[string][]envOverride {
+	policies := make(map[string][]string, len(configs))
+	for name := range configs {
+		key := normalizeEnv(name)
+		policies[key] = append(policies[key], name)
+	}
+
+	// Longest first, so "payment-api" wins over "payment" for PAYMENT_API_...
+	// when both leave a known field.
+	keys := slices.SortedFunc(maps.Keys(policies), func(a, b string) int {
+		return cmp.Or(len(b)-len(a), strings.Compare(a, b))
+	})
+
+	prefix = strings.TrimSuffix(prefix, "_") + "_"
+	overrides := make(map[string][]envOverride)
+
+	for _, entry := range environ {
+		name, value, _ := strings.Cut(entry, "=")
+
+		rest, ok := strings.CutPrefix(name, prefix)
+		if !ok {
+			continue
+		}
+
+		policy, field, ok := matchEnvPolicy(keys, normalizeEnv(rest))
+		if !ok {
+			continue
+		}
+
+		for _, target := range policies[policy] {
+			overrides[target] = append(overrides[target],
+				envOverride{name: name, value: value, field: field})
+		}
+	}
+
+	for _, vars := range overrides {
+		slices.SortFunc(vars, func(a, b envOverride) int {
+			return strings.Compare(a.name, b.name)
+		})
+	}
+
+	return overrides
+}
+
+// matchEnvPolicy splits the normalized variable name rest into the first of the
+// normalized policy names keys it starts with and the known field that
+// follows it. The policy segment is matched by prefix rather than cut at an
+// underscore, since a policy name may itself contain underscores or dashes.
+func matchEnvPolicy(keys []string, rest string) (policy string, field []int, ok bool) {
+	for _, key := range keys {
+		path, found := strings.CutPrefix(rest, key)
+		if !found {
+			continue
+		}
+
+		if field, ok = envFields[path]; ok {
+			return key, field, true
+		}
+	}
+
+	return "", nil, false
+}
+
+// overridePolicy applies vars to a copy of pc and validates the result with
+// [r8e.BuildOptions]. When the result is invalid, the variable that makes it so
+// on its own is named; failing that, all of them are.
+func overridePolicy(pc r8e.PolicyConfig, vars []envOverride) (r8e.PolicyConfig, error) { //nolint:gocritic // copied on purpose
+	updated := pc
+	for _, v := range vars {
+		if err := setEnvField(&updated, v); err != nil {
+			return pc, err
+		}
+	}
+
+	_, err := r8e.BuildOptions(&updated)
+	if err == nil {
+		return updated, nil
+	}
+
+	for _, v := range vars {
+		single := pc
+		_ = setEnvField(&single, v) // already parsed once above
+
+		if _, singleErr := r8e.BuildOptions(&single); singleErr != nil {
+			return pc, fmt.Errorf("env %s=%q: %w", v.name, v.value, singleErr)
+		}
+	}
+
+	names := make([]string, len(vars))
+	for i, v := range vars {
+		names[i] = v.name
+	}
+
+	return pc, fmt.Errorf("env %s: %w", strings.Join(names, ", "), err)
+}
+
+// setEnvField parses v.value into the field of pc it targets. A nested block is
+// copied before it is written, so the configuration pc was copied from keeps
+// its own block; a missing block is created.
+func setEnvField(pc *r8e.PolicyConfig, v envOverride) error {
+	target := reflect.ValueOf(pc).Elem().Field(v.field[0])
+
+	if len(v.field) == 2 {
+		block := reflect.New(target.Type().Elem())
+		if !target.IsNil() {
+			block.Elem().Set(target.Elem())
+		}
+
+		target.Set(block)
+		target = block.Elem().Field(v.field[1])
+	}
+
+	value := reflect.New(target.Type().Elem())
+
+	var err error
+
+	switch elem := value.Elem(); elem.Kind() {
+	case reflect.String:
+		elem.SetString(v.value)
+	case reflect.Int:
+		var n int64
+		if n, err = strconv.ParseInt(v.value, 10, 0); err == nil {
+			elem.SetInt(n)
+		}
+	case reflect.Float64:
+		var f float64
+		if f, err = strconv.ParseFloat(v.value, 64); err == nil {
+			elem.SetFloat(f)
+		}
+	case reflect.Bool:
+		var b bool
+		if b, err = strconv.ParseBool(v.value); err == nil {
+			elem.SetBool(b)
+		}
+	default:
+		err = errors.ErrUnsupported
+	}
+
+	if err != nil {
+		return fmt.Errorf("env %s=%q: %w", v.name, v.value, err)
+	}
+
+	target.Set(value)
+
+	return nil
+}
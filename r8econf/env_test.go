@@ -0,0 +1,140 @@
+package r8econf
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/byte4ever/r8e"
+)
+
+func TestApplyEnvOverrides(t *testing.T) {
+	t.Setenv("R8E_PAYMENTAPI_RETRY_MAXATTEMPTS", "5")
+	t.Setenv("R8E_PAYMENTAPI_TIMEOUT", "3s")
+	t.Setenv("R8E_PAYMENTAPI_CIRCUIT_BREAKER_FAILURE_THRESHOLD", "9")
+	t.Setenv("R8E_PAYMENTAPI_NOT_A_FIELD", "ignored")
+	t.Setenv("R8E_UNKNOWNAPI_TIMEOUT", "ignored")
+
+	store, err := Load("../testdata/valid.json")
+	require.NoError(t, err)
+	require.NoError(t, store.ApplyEnvOverrides("R8E"))
+
+	payment, err := GetPolicy[string](store, "payment-api")
+	require.NoError(t, err)
+
+	attempts, ok := payment.RetryAttempts()
+	require.True(t, ok)
+	assert.Equal(t, 5, attempts, "the file says 3")
+	assert.Equal(t, 3*time.Second, payment.WorstCaseDuration(), "the file says 2s")
+
+	threshold, ok := payment.CircuitBreakerThreshold()
+	require.True(t, ok)
+	assert.Equal(t, 9, threshold)
+
+	// The rest of the block is kept: the backoff still comes from the file.
+	assert.Equal(t, "exponential", *store.configs["payment-api"].Retry.Backoff)
+
+	// Other policies keep their file values.
+	notify, err := GetPolicy[string](store, "notification-api")
+	require.NoError(t, err)
+
+	attempts, _ = notify.RetryAttempts()
+	assert.Equal(t, 5, attempts)
+	assert.Equal(t, 5*time.Second, notify.WorstCaseDuration())
+}
+
+func TestMatchEnvOverridesUnderscoredPolicyName(t *testing.T) {
+	configs := map[string]r8e.PolicyConfig{
+		"payment":     {},
+		"payment-api": {},
+		"payment_api": {},
+	}
+
+	overrides := matchEnvOverrides(configs, "R8E", []string{
+		"R8E_PAYMENT_API_TIMEOUT=3s",
+		"R8E_PAYMENT_TIMEOUT=1s",
+		"R8E_PAYMENT_API_RETRY_MAX_ATTEMPTS=5",
+	})
+
+	names := func(policy string) []string {
+		var vars []string
+		for _, v := range overrides[policy] {
+			vars = append(vars, v.name)
+		}
+
+		return vars
+	}
+
+	// Both spellings normalize to PAYMENTAPI, the longest name that leaves a
+	// known field.
+	for _, policy := range []string{"payment-api", "payment_api"} {
+		assert.Equal(t, []string{
+			"R8E_PAYMENT_API_RETRY_MAX_ATTEMPTS",
+			"R8E_PAYMENT_API_TIMEOUT",
+		}, names(policy), policy)
+	}
+
+	assert.Equal(t, []string{"R8E_PAYMENT_TIMEOUT"}, names("payment"))
+}
+
+func TestApplyEnvOverridesMalformedValue(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+	}{
+		{name: "R8E_PAYMENTAPI_RETRY_MAXATTEMPTS", value: "many"},
+		{name: "R8E_PAYMENTAPI_TIMEOUT", value: "soon"},
+		{name: "R8E_PAYMENTAPI_RETRY_BACKOFF", value: "sideways"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(tt.name, tt.value)
+
+			store, err := Load("../testdata/valid.json")
+			require.NoError(t, err)
+
+			err = store.ApplyEnvOverrides("R8E_")
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.name)
+
+			attempts, _ := mustPolicy(t, store).RetryAttempts()
+			assert.Equal(t, 3, attempts, "the store is left unchanged")
+		})
+	}
+}
+
+func TestApplyEnvOverridesSurviveReload(t *testing.T) {
+	t.Setenv("APP_PAYMENTAPI_RETRY_MAXATTEMPTS", "7")
+
+	data, err := os.ReadFile("../testdata/valid.json")
+	require.NoError(t, err)
+
+	path := writeTempFile(t, string(data))
+
+	store, err := Load(path)
+	require.NoError(t, err)
+	require.NoError(t, store.ApplyEnvOverrides("APP"))
+
+	policy := mustPolicy(t, store)
+
+	require.NoError(t, store.Reload(path))
+
+	attempts, _ := policy.RetryAttempts()
+	assert.Equal(t, 7, attempts, "the live policy is retuned with the override")
+
+	attempts, _ = mustPolicy(t, store).RetryAttempts()
+	assert.Equal(t, 7, attempts)
+}
+
+func mustPolicy(t *testing.T, store *Store) *r8e.Policy[string] {
+	t.Helper()
+
+	policy, err := GetPolicy[string](store, "payment-api")
+	require.NoError(t, err)
+
+	return policy
+}
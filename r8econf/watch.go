@@ -0,0 +1,128 @@
+package r8econf
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"time"
+)
+
+type (
+	// WatchOption configures [Store.Watch].
+	//
+	// Pattern: Functional Options — composable optional settings applied to the
+	// private config, keeping the Watch signature stable.
+	WatchOption func(*watchConfig)
+
+	// watchConfig accumulates [WatchOption] values.
+	watchConfig struct {
+		onReload func(error)
+		interval time.Duration
+	}
+
+	// fileStamp identifies a version of the watched file by the metadata a
+	// rewrite changes; comparing stamps avoids re-reading an unchanged file.
+	fileStamp struct {
+		modTime time.Time
+		size    int64
+	}
+)
+
+// defaultWatchInterval is how often [Store.Watch] checks the file by default.
+const defaultWatchInterval = time.Second
+
+// WatchInterval sets how often [Store.Watch] checks the file for changes. A
+// non-positive interval keeps the default of one second.
+func WatchInterval(d time.Duration) WatchOption {
+	return func(cfg *watchConfig) {
+		if d > 0 {
+			cfg.interval = d
+		}
+	}
+}
+
+// OnReload sets a callback invoked after every reload [Store.Watch] triggers,
+// with the error [Store.Reload] returned (nil on success). Watch keeps running
+// after a failed reload, so this is where to log or alert on a bad file.
+func OnReload(fn func(err error)) WatchOption {
+	return func(cfg *watchConfig) {
+		cfg.onReload = fn
+	}
+}
+
+// Watch hot-reloads the store whenever the configuration file at path changes.
+// It checks the file's modification time and size every [WatchInterval] and
+// calls [Store.Reload] when either differs from the last version seen, so
+// already-built policies are retuned in place and later [GetPolicy] calls build
+// from the new configuration. The file is polled rather than watched through OS
+// notifications to keep the package free of dependencies; polling also
+// survives editors and config managers that replace the file by renaming a new
+// one over it.
+//
+// Watch blocks until ctx is done and returns ctx.Err(); run it in its own
+// goroutine. A reload that fails — an unreadable or invalid file, or a
+// structural change a live policy cannot absorb — is reported through
+// [OnReload] and does not stop the watch: an invalid file leaves the previous
+// configuration in force, and the next change is picked up as usual. Changes
+// are detected against the version the store last loaded, so a file rewritten
+// between [Load] and Watch is reloaded on the first check.
+//
+// Calls in flight during a reload are unaffected and finish with the settings
+// they started with; the new values apply from the next call (see
+// [r8e.Policy.Reconfigure]).
+func (s *Store) Watch(ctx context.Context, path string, opts ...WatchOption) error {
+	cfg := watchConfig{interval: defaultWatchInterval}
+	for _, o := range opts {
+		o(&cfg)
+	}
+
+	// Start from the version the store last loaded, so a change made between
+	// Load and Watch is not mistaken for the baseline.
+	s.mu.RLock()
+	last := s.loaded
+	s.mu.RUnlock()
+
+	ticker := time.NewTicker(cfg.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err() //nolint:wrapcheck // preserving context error identity
+		case <-ticker.C:
+		}
+
+		stamp, err := statFile(path)
+		if errors.Is(err, fs.ErrNotExist) {
+			// Mid-replace or deleted: keep the current config and wait for
+			// the file to come back.
+			continue
+		}
+
+		if err == nil && stamp == last {
+			continue
+		}
+
+		last = stamp
+
+		reloadErr := err
+		if reloadErr == nil {
+			reloadErr = s.Reload(path)
+		}
+
+		if cfg.onReload != nil {
+			cfg.onReload(reloadErr)
+		}
+	}
+}
+
+// statFile returns the stamp of the file at path.
+func statFile(path string) (fileStamp, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return fileStamp{}, err //nolint:wrapcheck // callers test fs.ErrNotExist
+	}
+
+	return fileStamp{modTime: info.ModTime(), size: info.Size()}, nil
+}
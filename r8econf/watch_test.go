@@ -0,0 +1,147 @@
+package r8econf
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// retryConfig renders a one-policy config file with the given retry count.
+func retryConfig(attempts int) string {
+	return fmt.Sprintf(
+		`{"policies":{"p":{"timeout":"1s","retry":{"max_attempts":%d,`+
+			`"backoff":"constant","base_delay":"10ms"}}}}`,
+		attempts,
+	)
+}
+
+func overwrite(t *testing.T, path, content string) {
+	t.Helper()
+
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+}
+
+// startWatch runs store.Watch in the background with a short interval and
+// returns a channel carrying each reload's result.
+func startWatch(t *testing.T, store *Store, path string) <-chan error {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	reloads := make(chan error, 8)
+	done := make(chan error, 1)
+
+	go func() {
+		done <- store.Watch(ctx, path,
+			WatchInterval(5*time.Millisecond),
+			OnReload(func(err error) { reloads <- err }),
+		)
+	}()
+
+	t.Cleanup(func() {
+		cancel()
+		require.ErrorIs(t, <-done, context.Canceled)
+	})
+
+	return reloads
+}
+
+func awaitReload(t *testing.T, reloads <-chan error) error {
+	t.Helper()
+
+	select {
+	case err := <-reloads:
+		return err
+	case <-time.After(5 * time.Second):
+		t.Fatal("no reload observed")
+
+		return nil
+	}
+}
+
+func TestStoreReloadUpdatesRetryAttempts(t *testing.T) {
+	path := writeTempFile(t, retryConfig(3))
+
+	store, err := Load(path)
+	require.NoError(t, err)
+
+	live, err := GetPolicy[string](store, "p")
+	require.NoError(t, err)
+
+	attempts, ok := live.RetryAttempts()
+	require.True(t, ok)
+	require.Equal(t, 3, attempts)
+
+	overwrite(t, path, retryConfig(5))
+	require.NoError(t, store.Reload(path))
+
+	// The live policy is retuned in place...
+	attempts, _ = live.RetryAttempts()
+	assert.Equal(t, 5, attempts)
+
+	// ...and a fresh GetPolicy builds from the new configuration.
+	fresh, err := GetPolicy[string](store, "p")
+	require.NoError(t, err)
+
+	attempts, ok = fresh.RetryAttempts()
+	require.True(t, ok)
+	assert.Equal(t, 5, attempts)
+}
+
+func TestStoreWatchReloadsOnChange(t *testing.T) {
+	path := writeTempFile(t, retryConfig(3))
+
+	store, err := Load(path)
+	require.NoError(t, err)
+
+	live, err := GetPolicy[string](store, "p")
+	require.NoError(t, err)
+
+	reloads := startWatch(t, store, path)
+
+	overwrite(t, path, retryConfig(12)) // different size: a change even on coarse mtimes
+	require.NoError(t, awaitReload(t, reloads))
+
+	attempts, _ := live.RetryAttempts()
+	assert.Equal(t, 12, attempts)
+}
+
+func TestStoreWatchKeepsConfigOnInvalidFile(t *testing.T) {
+	path := writeTempFile(t, retryConfig(3))
+
+	store, err := Load(path)
+	require.NoError(t, err)
+
+	live, err := GetPolicy[string](store, "p")
+	require.NoError(t, err)
+
+	reloads := startWatch(t, store, path)
+
+	overwrite(t, path, `{"policies":`)
+	require.Error(t, awaitReload(t, reloads))
+
+	attempts, _ := live.RetryAttempts()
+	assert.Equal(t, 3, attempts, "a bad file leaves the previous config in force")
+
+	// The watch survives the failure and applies the next good version.
+	overwrite(t, path, retryConfig(7))
+	require.NoError(t, awaitReload(t, reloads))
+
+	attempts, _ = live.RetryAttempts()
+	assert.Equal(t, 7, attempts)
+}
+
+func TestStoreWatchStopsOnContextDone(t *testing.T) {
+	store, err := Load(writeTempFile(t, retryConfig(3)))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err = store.Watch(ctx, "unused.json", WatchInterval(time.Hour))
+	require.ErrorIs(t, err, context.Canceled)
+}
@@ -0,0 +1,60 @@
+package r8ehttp
+
+import (
+	"expvar"
+
+	"github.com/byte4ever/r8e"
+)
+
+type (
+	// expvarHealth is the JSON form of a registry published by
+	// [PublishExpvar].
+	expvarHealth struct {
+		Policies map[string]expvarPolicy `json:"policies"`
+		Ready    bool                    `json:"ready"`
+	}
+
+	// expvarPolicy is one policy's entry in [expvarHealth].
+	expvarPolicy struct {
+		State       r8e.Condition `json:"state"`
+		Criticality string        `json:"criticality"`
+		Healthy     bool          `json:"healthy"`
+	}
+)
+
+// PublishExpvar publishes the health of reg as the expvar variable name, so
+// dashboards that already scrape /debug/vars pick it up without a dedicated
+// endpoint. The variable is recomputed from [r8e.Registry.CheckReadiness] on
+// every read:
+//
+//	{"policies": {"payments": {"state": "circuit_open",
+//	  "criticality": "critical", "healthy": false}}, "ready": true}
+//
+// Policies are keyed by name; when several share a name the last registered
+// wins. Reading it is safe for concurrent use. Like [expvar.Publish], it panics
+// when name is already published.
+func PublishExpvar(reg *r8e.Registry, name string) {
+	expvar.Publish(name, expvar.Func(func() any {
+		return snapshotExpvar(reg)
+	}))
+}
+
+// snapshotExpvar snapshots the readiness of reg in its expvar form.
+func snapshotExpvar(reg *r8e.Registry) expvarHealth {
+	status := reg.CheckReadiness()
+
+	health := expvarHealth{
+		Ready:    status.Ready,
+		Policies: make(map[string]expvarPolicy, len(status.Policies)),
+	}
+
+	for _, ps := range status.Policies {
+		health.Policies[ps.Name] = expvarPolicy{
+			State:       ps.State,
+			Criticality: ps.Criticality.String(),
+			Healthy:     ps.Healthy,
+		}
+	}
+
+	return health
+}
@@ -0,0 +1,79 @@
+package r8ehttp_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"expvar"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/byte4ever/r8e"
+	"github.com/byte4ever/r8e/r8ehttp"
+)
+
+// expvarPolicy mirrors one policy entry of the published JSON.
+type expvarPolicy struct {
+	State       r8e.Condition `json:"state"`
+	Criticality string        `json:"criticality"`
+	Healthy     bool          `json:"healthy"`
+}
+
+// expvarHealth mirrors the published JSON.
+type expvarHealth struct {
+	Policies map[string]expvarPolicy `json:"policies"`
+	Ready    bool                    `json:"ready"`
+}
+
+func TestPublishExpvar(t *testing.T) {
+	t.Parallel()
+
+	reg := r8e.NewRegistry()
+	payments := r8e.NewPolicy[string]("expvar-payments",
+		r8e.WithRegistry(reg),
+		r8e.WithCircuitBreaker(r8e.FailureThreshold(1), r8e.RecoveryTimeout(time.Hour)),
+		r8e.WithReadinessImpact(),
+	)
+	_ = r8e.NewPolicy[string]("expvar-search", r8e.WithRegistry(reg))
+
+	r8ehttp.PublishExpvar(reg, "r8e-test-health")
+
+	v := expvar.Get("r8e-test-health")
+	require.NotNil(t, v)
+
+	var before expvarHealth
+	require.NoError(t, json.Unmarshal([]byte(v.String()), &before))
+	assert.True(t, before.Ready)
+	assert.True(t, before.Policies["expvar-payments"].Healthy)
+
+	// Drive the breaker open: the critical policy now fails readiness.
+	_, _ = payments.Do(context.Background(), func(_ context.Context) (string, error) {
+		return "", errors.New("boom")
+	})
+
+	var after expvarHealth
+	require.NoError(t, json.Unmarshal([]byte(v.String()), &after), "recomputed on read")
+	assert.False(t, after.Ready)
+	assert.Equal(t, expvarPolicy{
+		State:       r8e.ConditionCircuitOpen,
+		Criticality: "critical",
+		Healthy:     false,
+	}, after.Policies["expvar-payments"])
+	assert.Equal(t, expvarPolicy{
+		State:       r8e.ConditionHealthy,
+		Criticality: "none",
+		Healthy:     true,
+	}, after.Policies["expvar-search"])
+}
+
+func TestPublishExpvarDuplicateNamePanics(t *testing.T) {
+	t.Parallel()
+
+	reg := r8e.NewRegistry()
+	r8ehttp.PublishExpvar(reg, "r8e-test-duplicate")
+
+	assert.Panics(t, func() { r8ehttp.PublishExpvar(reg, "r8e-test-duplicate") })
+}
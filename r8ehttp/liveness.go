@@ -0,0 +1,35 @@
+package r8ehttp
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/byte4ever/r8e"
+)
+
+// LivenessHandler returns an [http.Handler] for the Kubernetes liveness probe.
+// It responds 200 OK as long as the process answers and reg is functional, and
+// 503 Service Unavailable only when reg is unusable (see
+// [r8e.Registry.CheckLiveness]). The body is a JSON-encoded
+// [r8e.LivenessStatus].
+//
+// Unlike [ReadinessHandler] it ignores circuit states and every other policy
+// condition: an open breaker should take the pod out of rotation (readiness),
+// never get it killed and restarted (liveness), which would only add cold
+// starts to a dependency outage.
+func LivenessHandler(reg *r8e.Registry) http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+		status := reg.CheckLiveness()
+
+		writer.Header().Set("Content-Type", "application/json")
+
+		if status.Alive {
+			writer.WriteHeader(http.StatusOK)
+		} else {
+			writer.WriteHeader(http.StatusServiceUnavailable)
+		}
+
+		//nolint:errcheck // best-effort JSON encoding to HTTP response
+		_ = json.NewEncoder(writer).Encode(status)
+	})
+}
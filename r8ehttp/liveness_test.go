@@ -0,0 +1,95 @@
+package r8ehttp_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/byte4ever/r8e"
+	"github.com/byte4ever/r8e/r8ehttp"
+)
+
+// TestLivenessStaysUpWhenReadinessFails opens a readiness-impacting breaker and
+// verifies /readyz reports 503 while /livez keeps answering 200.
+func TestLivenessStaysUpWhenReadinessFails(t *testing.T) {
+	t.Parallel()
+
+	reg := r8e.NewRegistry()
+
+	policy := r8e.NewPolicy[string]("db",
+		r8e.WithRegistry(reg),
+		r8e.WithReadinessImpact(),
+		r8e.WithCircuitBreaker(
+			r8e.FailureThreshold(1),
+			r8e.RecoveryTimeout(time.Hour),
+		),
+	)
+
+	_, _ = policy.Do(context.Background(), func(_ context.Context) (string, error) {
+		return "", errors.New("fail")
+	})
+
+	mux := http.NewServeMux()
+	mux.Handle("/readyz", r8ehttp.ReadinessHandler(reg))
+	mux.Handle("/livez", r8ehttp.LivenessHandler(reg))
+
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	get := func(path string) *http.Response {
+		req, err := http.NewRequestWithContext(
+			context.Background(), http.MethodGet, srv.URL+path, nil,
+		)
+		require.NoError(t, err)
+
+		resp, err := srv.Client().Do(req)
+		require.NoError(t, err)
+
+		return resp
+	}
+
+	ready := get("/readyz")
+	defer ready.Body.Close()
+
+	assert.Equal(t, http.StatusServiceUnavailable, ready.StatusCode)
+
+	live := get("/livez")
+	defer live.Body.Close()
+
+	require.Equal(t, http.StatusOK, live.StatusCode)
+	assert.Equal(t, "application/json", live.Header.Get("Content-Type"))
+
+	var status r8e.LivenessStatus
+	require.NoError(t, json.NewDecoder(live.Body).Decode(&status))
+	assert.True(t, status.Alive)
+	assert.Equal(t, 1, status.Policies)
+}
+
+// TestLivenessHandlerUnusableRegistry verifies a registry that was never
+// initialized reports not alive.
+func TestLivenessHandlerUnusableRegistry(t *testing.T) {
+	t.Parallel()
+
+	for name, reg := range map[string]*r8e.Registry{
+		"nil":        nil,
+		"zero value": {},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			rec := httptest.NewRecorder()
+			r8ehttp.LivenessHandler(reg).ServeHTTP(
+				rec, httptest.NewRequest(http.MethodGet, "/livez", nil),
+			)
+
+			assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+		})
+	}
+}
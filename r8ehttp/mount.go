@@ -0,0 +1,23 @@
+package r8ehttp
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/byte4ever/r8e"
+)
+
+// Mount registers the readiness and liveness probes of reg on mux under
+// prefix: [ReadinessHandler] at prefix+"/readyz" and [LivenessHandler] at
+// prefix+"/livez". A trailing slash on prefix is dropped, and an empty prefix
+// mounts them at the root. Give each subsystem its own [r8e.Registry] and
+// mount it under its own prefix ("/payments", "/inventory") for per-subsystem
+// probes; a registry that [r8e.Registry.Include]s them all serves the
+// aggregate view. Like [http.ServeMux.Handle], it panics if a path is already
+// registered.
+func Mount(mux *http.ServeMux, prefix string, reg *r8e.Registry) {
+	prefix = strings.TrimSuffix(prefix, "/")
+
+	mux.Handle(prefix+"/readyz", ReadinessHandler(reg))
+	mux.Handle(prefix+"/livez", LivenessHandler(reg))
+}
@@ -0,0 +1,97 @@
+package r8ehttp_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/byte4ever/r8e"
+	"github.com/byte4ever/r8e/r8ehttp"
+)
+
+// TestMountPerSubsystem mounts two subsystem registries and their aggregate,
+// and verifies each readiness endpoint reports only its own policies while the
+// aggregate reports both.
+func TestMountPerSubsystem(t *testing.T) {
+	t.Parallel()
+
+	payments, inventory, all := r8e.NewRegistry(), r8e.NewRegistry(), r8e.NewRegistry()
+	all.Include(payments)
+	all.Include(inventory)
+
+	charge := r8e.NewPolicy[string]("charge",
+		r8e.WithRegistry(payments),
+		r8e.WithReadinessImpact(),
+		r8e.WithCircuitBreaker(
+			r8e.FailureThreshold(1),
+			r8e.RecoveryTimeout(time.Hour),
+		),
+	)
+	_ = r8e.NewPolicy[string]("stock", r8e.WithRegistry(inventory))
+
+	_, _ = charge.Do(context.Background(), func(_ context.Context) (string, error) {
+		return "", errors.New("fail")
+	})
+
+	mux := http.NewServeMux()
+	r8ehttp.Mount(mux, "/payments", payments)
+	r8ehttp.Mount(mux, "/inventory/", inventory)
+	r8ehttp.Mount(mux, "", all)
+
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	readiness := func(path string) (int, []string) {
+		req, err := http.NewRequestWithContext(
+			context.Background(), http.MethodGet, srv.URL+path, nil,
+		)
+		require.NoError(t, err)
+
+		resp, err := srv.Client().Do(req)
+		require.NoError(t, err)
+
+		defer resp.Body.Close()
+
+		var status r8e.ReadinessStatus
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&status))
+
+		names := make([]string, 0, len(status.Policies))
+		for _, ps := range status.Policies {
+			names = append(names, ps.Name)
+		}
+
+		return resp.StatusCode, names
+	}
+
+	code, names := readiness("/payments/readyz")
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.Equal(t, []string{"charge"}, names)
+
+	code, names = readiness("/inventory/readyz")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, []string{"stock"}, names)
+
+	code, names = readiness("/readyz")
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.Equal(t, []string{"charge", "stock"}, names)
+
+	for _, path := range []string{"/payments/livez", "/inventory/livez", "/livez"} {
+		req, err := http.NewRequestWithContext(
+			context.Background(), http.MethodGet, srv.URL+path, nil,
+		)
+		require.NoError(t, err)
+
+		resp, err := srv.Client().Do(req)
+		require.NoError(t, err)
+		resp.Body.Close()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode, path)
+	}
+}
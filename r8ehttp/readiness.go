@@ -9,23 +9,127 @@ import (
 	"github.com/byte4ever/r8e"
 )
 
+type (
+	// ReadinessEncoder renders a readiness check as a response body and HTTP
+	// status code. A zero status code keeps the one configured with
+	// [WithStatusCodes].
+	ReadinessEncoder func(status r8e.ReadinessStatus) (body []byte, code int)
+
+	// ReadinessOption configures [ReadinessHandlerWith].
+	//
+	// Pattern: Functional Options — composable optional settings applied to the
+	// private config, keeping the handler signature stable.
+	ReadinessOption func(*readinessConfig)
+
+	// readinessConfig accumulates [ReadinessOption] values.
+	readinessConfig struct {
+		encoder      ReadinessEncoder
+		contentType  string
+		readyCode    int
+		notReadyCode int
+		dependencies bool
+	}
+)
+
+// WithReadinessEncoder replaces the default JSON encoding of
+// [r8e.ReadinessStatus] with enc, e.g. to serve a Kubernetes-style
+// {"status":"ok"} body. A nil enc keeps the default.
+func WithReadinessEncoder(enc ReadinessEncoder) ReadinessOption {
+	return func(cfg *readinessConfig) {
+		if enc != nil {
+			cfg.encoder = enc
+		}
+	}
+}
+
+// WithContentType sets the Content-Type header of the response; the default is
+// application/json. Set it alongside a [WithReadinessEncoder] that does not
+// produce JSON.
+func WithContentType(contentType string) ReadinessOption {
+	return func(cfg *readinessConfig) {
+		cfg.contentType = contentType
+	}
+}
+
+// WithStatusCodes sets the HTTP status codes answered when the registry is
+// ready and not ready; the defaults are 200 OK and 503 Service Unavailable. A
+// non-positive code keeps its default.
+func WithStatusCodes(ready, notReady int) ReadinessOption {
+	return func(cfg *readinessConfig) {
+		if ready > 0 {
+			cfg.readyCode = ready
+		}
+
+		if notReady > 0 {
+			cfg.notReadyCode = notReady
+		}
+	}
+}
+
+// WithDependencyTrees sets whether each policy's status carries the health of
+// its declared dependencies ([r8e.PolicyStatus].Dependencies). They are
+// included by default; drop them to keep the probe response small.
+func WithDependencyTrees(include bool) ReadinessOption {
+	return func(cfg *readinessConfig) {
+		cfg.dependencies = include
+	}
+}
+
 // ReadinessHandler returns an [http.Handler] that reports the readiness of
 // all policies registered with reg. It responds with 200 OK when all critical
 // policies are healthy, and 503 Service Unavailable otherwise. The response
 // body is always a JSON-encoded [r8e.ReadinessStatus].
 func ReadinessHandler(reg *r8e.Registry) http.Handler {
+	return ReadinessHandlerWith(reg)
+}
+
+// ReadinessHandlerWith returns a readiness [http.Handler] like
+// [ReadinessHandler] whose response shape is set by opts: the body encoder,
+// its content type, the status codes, and whether dependency trees are
+// included. Without options it behaves exactly as [ReadinessHandler].
+func ReadinessHandlerWith(reg *r8e.Registry, opts ...ReadinessOption) http.Handler {
+	cfg := readinessConfig{
+		contentType:  "application/json",
+		readyCode:    http.StatusOK,
+		notReadyCode: http.StatusServiceUnavailable,
+		dependencies: true,
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
 	return http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
 		status := reg.CheckReadiness()
+		if !cfg.dependencies {
+			for i := range status.Policies {
+				status.Policies[i].Dependencies = nil
+			}
+		}
 
-		writer.Header().Set("Content-Type", "application/json")
-
+		code := cfg.notReadyCode
 		if status.Ready {
-			writer.WriteHeader(http.StatusOK)
-		} else {
-			writer.WriteHeader(http.StatusServiceUnavailable)
+			code = cfg.readyCode
+		}
+
+		writer.Header().Set("Content-Type", cfg.contentType)
+
+		if cfg.encoder == nil {
+			writer.WriteHeader(code)
+
+			//nolint:errcheck // best-effort JSON encoding to HTTP response
+			_ = json.NewEncoder(writer).Encode(status)
+
+			return
 		}
 
-		//nolint:errcheck // best-effort JSON encoding to HTTP response
-		_ = json.NewEncoder(writer).Encode(status)
+		body, encoded := cfg.encoder(status)
+		if encoded != 0 {
+			code = encoded
+		}
+
+		writer.WriteHeader(code)
+
+		//nolint:errcheck // best-effort write to HTTP response
+		_, _ = writer.Write(body)
 	})
 }
@@ -78,6 +78,7 @@ func TestReadinessHandlerOneCritical(t *testing.T) {
 	var status r8e.ReadinessStatus
 	require.NoError(t, json.NewDecoder(rec.Body).Decode(&status))
 	require.False(t, status.Ready)
+	assert.Equal(t, []string{"api-down: circuit_open"}, status.Reasons)
 }
 
 // TestReadinessHandlerEmptyRegistry verifies that an empty registry
@@ -225,3 +226,107 @@ func BenchmarkReadinessHandler(b *testing.B) {
 		handler.ServeHTTP(rec, req)
 	}
 }
+
+// tripped registers a readiness-gating policy with an open circuit breaker in
+// reg.
+func tripped(t *testing.T, reg *r8e.Registry, name string) {
+	t.Helper()
+
+	policy := r8e.NewPolicy[string](name,
+		r8e.WithRegistry(reg),
+		r8e.WithReadinessImpact(),
+		r8e.WithCircuitBreaker(
+			r8e.FailureThreshold(1),
+			r8e.RecoveryTimeout(time.Hour),
+		),
+	)
+
+	_, _ = policy.Do(context.Background(), func(_ context.Context) (string, error) {
+		return "", errors.New("fail")
+	})
+}
+
+func serveReadyz(handler http.Handler) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+
+	return rec
+}
+
+// kubernetesStyle renders {"status":"ok"} with 200, or {"status":"fail"} with
+// 500, ignoring the handler's configured codes.
+func kubernetesStyle(status r8e.ReadinessStatus) ([]byte, int) {
+	if status.Ready {
+		return []byte(`{"status":"ok"}`), http.StatusOK
+	}
+
+	return []byte(`{"status":"fail"}`), http.StatusInternalServerError
+}
+
+// TestReadinessHandlerWithCustomEncoder verifies that a custom encoder
+// produces both the body and the status code.
+func TestReadinessHandlerWithCustomEncoder(t *testing.T) {
+	t.Parallel()
+
+	reg := r8e.NewRegistry()
+	_ = r8e.NewPolicy[string]("api-up", r8e.WithRegistry(reg), r8e.WithReadinessImpact())
+
+	handler := r8ehttp.ReadinessHandlerWith(reg, r8ehttp.WithReadinessEncoder(kubernetesStyle))
+
+	rec := serveReadyz(handler)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"status":"ok"}`, rec.Body.String())
+
+	tripped(t, reg, "api-down")
+
+	rec = serveReadyz(handler)
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	assert.JSONEq(t, `{"status":"fail"}`, rec.Body.String())
+}
+
+// TestReadinessHandlerWithEncoderZeroCodeKeepsConfigured verifies that an
+// encoder returning a zero code defers to WithStatusCodes.
+func TestReadinessHandlerWithEncoderZeroCodeKeepsConfigured(t *testing.T) {
+	t.Parallel()
+
+	reg := r8e.NewRegistry()
+	tripped(t, reg, "api-down")
+
+	handler := r8ehttp.ReadinessHandlerWith(reg,
+		r8ehttp.WithReadinessEncoder(func(status r8e.ReadinessStatus) ([]byte, int) {
+			return []byte(status.Reasons[0]), 0
+		}),
+		r8ehttp.WithContentType("text/plain; charset=utf-8"),
+		r8ehttp.WithStatusCodes(0, http.StatusTooManyRequests),
+	)
+
+	rec := serveReadyz(handler)
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+	assert.Equal(t, "text/plain; charset=utf-8", rec.Header().Get("Content-Type"))
+	assert.Equal(t, "api-down: circuit_open", rec.Body.String())
+}
+
+// TestReadinessHandlerWithoutDependencyTrees verifies that dependency trees
+// are included by default and dropped on request.
+func TestReadinessHandlerWithoutDependencyTrees(t *testing.T) {
+	t.Parallel()
+
+	reg := r8e.NewRegistry()
+	db := r8e.NewPolicy[string]("db", r8e.WithRegistry(r8e.NewRegistry())) // kept out of reg
+	_ = r8e.NewPolicy[string]("api", r8e.WithRegistry(reg), r8e.DependsOn(db))
+
+	decode := func(handler http.Handler) r8e.ReadinessStatus {
+		var status r8e.ReadinessStatus
+		require.NoError(t, json.NewDecoder(serveReadyz(handler).Body).Decode(&status))
+		require.Len(t, status.Policies, 1)
+
+		return status
+	}
+
+	status := decode(r8ehttp.ReadinessHandlerWith(reg))
+	require.Len(t, status.Policies[0].Dependencies, 1)
+	assert.Equal(t, "db", status.Policies[0].Dependencies[0].Name)
+
+	status = decode(r8ehttp.ReadinessHandlerWith(reg, r8ehttp.WithDependencyTrees(false)))
+	assert.Empty(t, status.Policies[0].Dependencies)
+}
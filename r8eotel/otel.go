@@ -2,7 +2,8 @@
 //
 // Metrics: Register creates observable instruments that report, per policy
 // (labelled by the "policy" attribute), the counters and live gauges from
-// r8e.Registry.Snapshot.
+// r8e.Registry.Snapshot. The circuit-breaker state is also reported as a
+// state set, one series per state labelled by "state", for alert rules.
 //
 // Tracing: Trace wraps an r8e.Policy[T] with trace spans — a root span per
 // Do call (named after the policy) and a child span per fn invocation (initial
@@ -63,9 +64,19 @@ const (
 	circuitRampingGauge  int64 = 3
 )
 
+// circuitStates lists the breaker states reported by the
+// r8e.policy.circuit_breaker_state state-set gauge.
+var circuitStates = []r8e.CircuitState{
+	r8e.CircuitClosed,
+	r8e.CircuitHalfOpen,
+	r8e.CircuitOpen,
+	r8e.CircuitRamping,
+}
+
 // Register creates OpenTelemetry observable instruments on meter and a callback
 // that reports metrics for every policy exposed by reg, labelled by the
-// "policy" attribute. The returned [metric.Registration] can be used to stop
+// "policy" attribute and, for a policy built with r8e.WithLabel, the "label"
+// attribute. The returned [metric.Registration] can be used to stop
 // reporting via its Unregister method.
 //
 //nolint:ireturn // returns the OpenTelemetry metric.Registration by design
@@ -76,6 +87,8 @@ func Register(meter metric.Meter, reg MetricsSource) (metric.Registration, error
 		func(m *r8e.PolicyMetrics) int64 { return m.Retries })
 	builder.counter("r8e.policy.timeouts", "Total timeouts",
 		func(m *r8e.PolicyMetrics) int64 { return m.Timeouts })
+	builder.counter("r8e.policy.soft_timeouts", "Calls still running past the soft timeout",
+		func(m *r8e.PolicyMetrics) int64 { return m.SoftTimeouts })
 	builder.counter("r8e.policy.circuit_opens", "Circuit-breaker open transitions",
 		func(m *r8e.PolicyMetrics) int64 { return m.CircuitOpens })
 	builder.counter("r8e.policy.circuit_closes", "Circuit-breaker close transitions",
@@ -112,6 +125,8 @@ func Register(meter metric.Meter, reg MetricsSource) (metric.Registration, error
 		func(m *r8e.PolicyMetrics) int64 { return m.Throttled })
 	builder.counter("r8e.policy.slo_shed", "Calls shed locally by the SLO burn-rate governor",
 		func(m *r8e.PolicyMetrics) int64 { return m.SLOShed })
+	builder.counter("r8e.policy.load_shed", "Calls shed locally by the latency / in-flight load shedder",
+		func(m *r8e.PolicyMetrics) int64 { return m.LoadShed })
 	builder.counter("r8e.policy.rate_adaptations", "AIMD adjustments to the rate limiter's refill rate",
 		func(m *r8e.PolicyMetrics) int64 { return m.RateAdaptations })
 	builder.counter("r8e.policy.slow_call_rate_exceeded", "Circuit-breaker opens triggered by the slow-call rate",
@@ -161,6 +176,8 @@ func Register(meter metric.Meter, reg MetricsSource) (metric.Registration, error
 		func(m *r8e.PolicyMetrics) float64 { return m.SLOBurnRate })
 	builder.gaugeFloat64("r8e.policy.slo_shed_probability", "SLO governor's current local-shed probability",
 		func(m *r8e.PolicyMetrics) float64 { return m.SLOShedProbability })
+	builder.gaugeFloat64("r8e.policy.load_shed_probability", "Load shedder's current local-shed probability",
+		func(m *r8e.PolicyMetrics) float64 { return m.LoadShedProbability })
 	builder.gaugeFloat64("r8e.policy.rate_limit", "Rate limiter's current refill rate in tokens per second",
 		func(m *r8e.PolicyMetrics) float64 { return m.RateLimit })
 	builder.gaugeFloat64("r8e.policy.slow_call_rate", "Current fraction of slow calls in the circuit-breaker window",
@@ -180,17 +197,18 @@ func Register(meter metric.Meter, reg MetricsSource) (metric.Registration, error
 	builder.gaugeFloat64("r8e.policy.codel_load", "Bulkhead controlled-delay load (standing delay / slough)",
 		func(m *r8e.PolicyMetrics) float64 { return m.CoDelLoad })
 
+	breakerStates := builder.stateSet("r8e.policy.circuit_breaker_state",
+		"1 for the circuit breaker's current state, 0 for the others")
+
 	if builder.err != nil {
-		return nil, builder.err
+		return nil, fmt.Errorf("r8e: create otel instruments: %w", builder.err)
 	}
 
 	registration, err := meter.RegisterCallback(
 		func(_ context.Context, observer metric.Observer) error {
 			snapshot := reg.Snapshot()
 			for i := range snapshot {
-				attrs := metric.WithAttributes(
-					attribute.String("policy", snapshot[i].Name),
-				)
+				attrs := metric.WithAttributes(policyAttributes(&snapshot[i])...)
 				for _, obs := range builder.observations {
 					observer.ObserveInt64(obs.inst, obs.get(&snapshot[i]), attrs)
 				}
@@ -198,6 +216,8 @@ func Register(meter metric.Meter, reg MetricsSource) (metric.Registration, error
 				for _, obs := range builder.observationsF64 {
 					observer.ObserveFloat64(obs.inst, obs.get(&snapshot[i]), attrs)
 				}
+
+				observeCircuitStates(observer, breakerStates, &snapshot[i])
 			}
 
 			return nil
@@ -272,6 +292,25 @@ func (b *instrumentBuilder) gaugeFloat64(
 	b.instruments = append(b.instruments, inst)
 }
 
+// stateSet creates a gauge the callback observes itself, one series per
+// state, rather than through a per-policy getter.
+func (b *instrumentBuilder) stateSet(name, desc string) metric.Int64Observable {
+	if b.err != nil {
+		return nil
+	}
+
+	inst, err := b.meter.Int64ObservableGauge(name, metric.WithDescription(desc))
+	if err != nil {
+		b.err = err
+
+		return nil
+	}
+
+	b.instruments = append(b.instruments, inst)
+
+	return inst
+}
+
 func (b *instrumentBuilder) add(
 	inst metric.Int64Observable,
 	get func(*r8e.PolicyMetrics) int64,
@@ -298,6 +337,43 @@ func circuitStateGauge(m *r8e.PolicyMetrics) int64 {
 	}
 }
 
+// observeCircuitStates reports m's breaker as a state set — one series per
+// state, 1 for the current one and 0 for the rest — so an alert can match
+// state="open" directly. The state is read from the snapshot taken at
+// collection time, so it is current on every scrape rather than only after a
+// transition. A policy without a breaker reports no series.
+func observeCircuitStates(
+	observer metric.Observer,
+	inst metric.Int64Observable,
+	m *r8e.PolicyMetrics,
+) {
+	if m.CircuitState == "" {
+		return
+	}
+
+	for _, state := range circuitStates {
+		var value int64
+		if m.CircuitState == string(state) {
+			value = 1
+		}
+
+		observer.ObserveInt64(inst, value, metric.WithAttributes(
+			append(policyAttributes(m), attribute.String("state", string(state)))...,
+		))
+	}
+}
+
+// policyAttributes returns the attributes identifying a policy's series: its
+// "policy" name, and its "label" when it has one (see r8e.WithLabel).
+func policyAttributes(m *r8e.PolicyMetrics) []attribute.KeyValue {
+	attrs := []attribute.KeyValue{attribute.String("policy", m.Name)}
+	if m.Label != "" {
+		attrs = append(attrs, attribute.String("label", m.Label))
+	}
+
+	return attrs
+}
+
 func boolGauge(pick func(*r8e.PolicyMetrics) bool) func(*r8e.PolicyMetrics) int64 {
 	return func(m *r8e.PolicyMetrics) int64 {
 		if pick(m) {
@@ -200,6 +200,140 @@ func TestRegisterReportsPolicyMetrics(t *testing.T) {
 	assert.Equal(t, int64(0), healthy, "open critical breaker => unhealthy")
 }
 
+// policySnapshot is a MetricsSource over individual policies, for reporting
+// ones that are not in any registry.
+type policySnapshot []r8e.MetricsReporter
+
+func (s policySnapshot) Snapshot() []r8e.PolicyMetrics {
+	out := make([]r8e.PolicyMetrics, len(s))
+	for i, p := range s {
+		out[i] = p.Metrics()
+	}
+
+	return out
+}
+
+func TestRegisterReportsPolicyLabel(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	meter := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)).Meter("test")
+
+	policy := r8e.NewPolicy[string]("",
+		r8e.WithLabel("checkout-charge"),
+		r8e.WithFallback("fb"),
+	)
+
+	registration, err := r8eotel.Register(meter, policySnapshot{policy})
+	require.NoError(t, err)
+
+	defer func() { require.NoError(t, registration.Unregister()) }()
+
+	_, _ = policy.Do(context.Background(), func(context.Context) (string, error) {
+		return "", errors.New("down")
+	})
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+
+	var found bool
+
+	for _, scope := range rm.ScopeMetrics {
+		for _, metric := range scope.Metrics {
+			if metric.Name != "r8e.policy.fallbacks_used" {
+				continue
+			}
+
+			found = true
+			sum, ok := metric.Data.(metricdata.Sum[int64])
+			require.True(t, ok)
+			require.Len(t, sum.DataPoints, 1)
+			assert.Equal(t, int64(1), sum.DataPoints[0].Value)
+
+			label, ok := sum.DataPoints[0].Attributes.Value(attribute.Key("label"))
+			require.True(t, ok, "data point missing label attribute")
+			assert.Equal(t, "checkout-charge", label.AsString())
+		}
+	}
+
+	require.True(t, found, "fallbacks_used not reported")
+
+	for _, m := range r8e.DefaultRegistry().Snapshot() {
+		assert.NotEqual(t, "checkout-charge", m.Label, "labeled policy must not register")
+	}
+}
+
+// circuitStateSet returns the r8e.policy.circuit_breaker_state data points in
+// rm, keyed by policy then state.
+func circuitStateSet(t *testing.T, rm metricdata.ResourceMetrics) map[string]map[string]int64 {
+	t.Helper()
+
+	set := make(map[string]map[string]int64)
+
+	for _, scope := range rm.ScopeMetrics {
+		for _, metric := range scope.Metrics {
+			if metric.Name != "r8e.policy.circuit_breaker_state" {
+				continue
+			}
+
+			gauge, ok := metric.Data.(metricdata.Gauge[int64])
+			require.True(t, ok, "unexpected data type %T", metric.Data)
+
+			for _, point := range gauge.DataPoints {
+				policy, _ := point.Attributes.Value(attribute.Key("policy"))
+				state, _ := point.Attributes.Value(attribute.Key("state"))
+
+				if set[policy.AsString()] == nil {
+					set[policy.AsString()] = make(map[string]int64)
+				}
+
+				set[policy.AsString()][state.AsString()] = point.Value
+			}
+		}
+	}
+
+	return set
+}
+
+func TestRegisterReportsCircuitBreakerStateSet(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	meter := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)).Meter("test")
+
+	reg := r8e.NewRegistry()
+	payments := r8e.NewPolicy[string]("payments",
+		r8e.WithRegistry(reg),
+		r8e.WithCircuitBreaker(r8e.FailureThreshold(1)),
+	)
+	_ = r8e.NewPolicy[string]("inventory",
+		r8e.WithRegistry(reg),
+		r8e.WithCircuitBreaker(),
+	)
+	_ = r8e.NewPolicy[string]("no-breaker", r8e.WithRegistry(reg), r8e.WithBulkhead(2))
+
+	registration, err := r8eotel.Register(meter, reg)
+	require.NoError(t, err)
+
+	defer func() { require.NoError(t, registration.Unregister()) }()
+
+	closed := map[string]int64{"closed": 1, "half_open": 0, "open": 0, "ramping": 0}
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+	assert.Equal(t, map[string]map[string]int64{
+		"payments":  closed,
+		"inventory": closed,
+	}, circuitStateSet(t, rm))
+
+	// The next scrape reads the breaker afresh, with no hook involved.
+	_, _ = payments.Do(context.Background(), func(context.Context) (string, error) {
+		return "", errors.New("down")
+	})
+
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+	assert.Equal(t, map[string]map[string]int64{
+		"payments":  {"closed": 0, "half_open": 0, "open": 1, "ramping": 0},
+		"inventory": closed,
+	}, circuitStateSet(t, rm))
+}
+
 // TestRegisterEmitsAllInstruments guards every instrument name: a typo or a
 // dropped instrument would make the corresponding metric disappear.
 func TestRegisterEmitsAllInstruments(t *testing.T) {
@@ -233,7 +367,7 @@ func TestRegisterEmitsAllInstruments(t *testing.T) {
 	// so a dropped OR an added-but-untested instrument both fail the guard.
 	want := []string{
 		// Counters.
-		"r8e.policy.retries", "r8e.policy.timeouts",
+		"r8e.policy.retries", "r8e.policy.timeouts", "r8e.policy.soft_timeouts",
 		"r8e.policy.circuit_opens", "r8e.policy.circuit_closes",
 		"r8e.policy.circuit_half_opens", "r8e.policy.circuit_ramps",
 		"r8e.policy.rate_limited",
@@ -243,7 +377,7 @@ func TestRegisterEmitsAllInstruments(t *testing.T) {
 		"r8e.policy.fallbacks_used", "r8e.policy.retry_budget_exceeded",
 		"r8e.policy.time_budget_exceeded", "r8e.policy.coalesce_leaders",
 		"r8e.policy.coalesce_followers", "r8e.policy.concurrency_rejected",
-		"r8e.policy.throttled", "r8e.policy.slo_shed",
+		"r8e.policy.throttled", "r8e.policy.slo_shed", "r8e.policy.load_shed",
 		"r8e.policy.rate_adaptations",
 		"r8e.policy.slow_call_rate_exceeded",
 		"r8e.policy.cache_hits", "r8e.policy.cache_misses",
@@ -255,12 +389,13 @@ func TestRegisterEmitsAllInstruments(t *testing.T) {
 		// Gauges.
 		"r8e.policy.bulkhead_in_use", "r8e.policy.bulkhead_capacity",
 		"r8e.policy.bulkhead_queued", "r8e.policy.codel_load",
-		"r8e.policy.circuit_state",
+		"r8e.policy.circuit_state", "r8e.policy.circuit_breaker_state",
 		"r8e.policy.healthy", "r8e.policy.saturated",
 		"r8e.policy.coalesce_in_flight", "r8e.policy.concurrency_limit",
 		"r8e.policy.concurrency_in_flight", "r8e.policy.retry_budget_tokens",
 		"r8e.policy.throttle_probability",
 		"r8e.policy.slo_burn_rate", "r8e.policy.slo_shed_probability",
+		"r8e.policy.load_shed_probability",
 		"r8e.policy.rate_limit",
 		"r8e.policy.slow_call_rate", "r8e.policy.ramp_recovery_fraction",
 		"r8e.policy.concurrency_budget_in_use",
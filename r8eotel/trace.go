@@ -41,11 +41,13 @@ func Trace[T any](policy *r8e.Policy[T], tp trace.TracerProvider) *TracedPolicy[
 }
 
 // Do executes fn through the wrapped policy. It records the overall call as a
-// root span (named after the policy) and each fn invocation as a child span so
-// retries and hedge forks appear as individual, timed children in the trace.
+// root span (named after the policy, or after its label when it is anonymous)
+// and each fn invocation as a child span so retries and hedge forks appear as
+// individual, timed children in the trace.
 //
 // The root span carries:
 //   - r8e.policy   — the policy name
+//   - r8e.label    — the policy's label, when it has one (see r8e.WithLabel)
 //   - r8e.attempts — number of fn invocations counted before Do returns. With
 //     hedge policies the losing goroutine may call fn after Do returns; its
 //     invocation is not reflected in this counter, though its child span is
@@ -61,10 +63,16 @@ func (p *TracedPolicy[T]) Do(
 	ctx context.Context,
 	fn func(context.Context) (T, error),
 ) (T, error) {
-	name := p.policy.Name()
-	childName := name + "/attempt"
+	name, label := p.policy.Name(), p.policy.Label()
 
-	spanCtx, span := p.tracer.Start(ctx, name)
+	spanName := name
+	if spanName == "" {
+		spanName = label
+	}
+
+	childName := spanName + "/attempt"
+
+	spanCtx, span := p.tracer.Start(ctx, spanName)
 	defer span.End()
 
 	var attempts atomic.Int64
@@ -94,6 +102,10 @@ func (p *TracedPolicy[T]) Do(
 		attribute.Int64("r8e.attempts", attempts.Load()),
 	)
 
+	if label != "" {
+		span.SetAttributes(attribute.String("r8e.label", label))
+	}
+
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
@@ -142,6 +154,8 @@ func rejectionReason(err error) string {
 		return "throttled"
 	case errors.Is(err, r8e.ErrSLOShed):
 		return "slo_shed"
+	case errors.Is(err, r8e.ErrLoadShed):
+		return "load_shed"
 	case errors.Is(err, r8e.ErrTimeout):
 		return "timeout"
 	case errors.Is(err, r8e.ErrTimeBudgetExceeded):
@@ -199,6 +199,26 @@ func TestTracedPolicyDo_SpanNamesMatchPolicyName(t *testing.T) {
 	assert.Equal(t, "my-custom-name", spanNames(spans)[1])
 }
 
+func TestTracedPolicyDo_AnonymousPolicyNamedAfterLabel(t *testing.T) {
+	t.Parallel()
+
+	tp, exp := recorder()
+	policy := r8e.NewPolicy[int]("", r8e.WithLabel("checkout-charge"))
+	traced := r8eotel.Trace(policy, tp)
+
+	_, _ = traced.Do(context.Background(), func(_ context.Context) (int, error) {
+		return 42, nil
+	})
+
+	spans := exp.GetSpans()
+	require.Len(t, spans, 2)
+
+	assert.Equal(t, "checkout-charge/attempt", spanNames(spans)[0])
+	assert.Equal(t, "checkout-charge", spanNames(spans)[1])
+	assert.Equal(t, "checkout-charge", strAttr(spans[1], "r8e.label"))
+	assert.Empty(t, strAttr(spans[1], "r8e.policy"))
+}
+
 func TestTracedPolicyDo_ContextPropagation(t *testing.T) {
 	t.Parallel()
 
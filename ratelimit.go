@@ -16,7 +16,10 @@ import (
 type (
 	rateLimitConfig struct {
 		aimd     *aimdConfig
+		burst    int           // bucket capacity in tokens; 0 means one second's worth of rate
+		window   time.Duration // sliding-window span; 0 unless RateLimitSlidingWindow
 		blocking bool
+		leaky    bool
 	}
 
 	// RateLimitOption configures rate limiter behavior.
@@ -46,6 +49,62 @@ type (
 		capacity atomic.Int64
 		tokens   atomic.Int64
 		lastNano atomic.Int64
+		rejected atomic.Int64 // calls refused with ErrRateLimited
+
+		// nextSlot is the earliest unixnano the next call may pass in leaky-bucket
+		// mode (see RateLimitLeaky); unused by the token bucket.
+		nextSlot atomic.Int64
+
+		// window counts the calls admitted in sliding-window mode (see
+		// RateLimitSlidingWindow); nil otherwise.
+		window *rateWindow
+	}
+
+	// rateWindow is the sliding-window counter behind [RateLimitSlidingWindow]:
+	// a ring of epoch-stamped sub-buckets, each counting the calls admitted
+	// during one slice of the window, so memory stays fixed however high the
+	// rate. The trailing count sums every sub-bucket that overlaps the window
+	// and drops one slice at a time as the clock moves on. The ring holds twice
+	// the slices a window spans so calls booked ahead by
+	// [RateLimiter.ReserveN] — up to one window into the future — never share
+	// a slot with a slice still counted. One mutex guards the ring: admitting
+	// a call must read the sum and increment a bucket as one step.
+	rateWindow struct {
+		buckets     [2 * (rateWindowBuckets + 1)]rateWindowBucket
+		bucketNanos int64
+		mu          sync.Mutex
+	}
+
+	// rateWindowBucket is one slice of a [rateWindow]: the calls admitted
+	// during the epoch it is stamped with.
+	rateWindowBucket struct {
+		epoch int64
+		count int64
+	}
+
+	// RLStats is a point-in-time snapshot of a [RateLimiter]'s token bucket.
+	// Each field is read from its own atomic cell, so the snapshot is race-free
+	// but not a single linearizable view under concurrent acquisition.
+	RLStats struct {
+		// Available is the number of whole or fractional tokens in the bucket.
+		Available float64
+		// Capacity is the bucket size in tokens: the [RateLimitBurst] when set,
+		// else one second's worth of Rate.
+		Capacity float64
+		// Rate is the current refill rate in tokens per second (the live
+		// adapted rate under AIMD).
+		Rate float64
+		// Rejections is how many calls were refused with [ErrRateLimited]
+		// since the limiter was built.
+		Rejections int64
+	}
+
+	// Reservation is capacity claimed ahead of time by [RateLimiter.ReserveN]:
+	// the tokens are already taken from the limiter, and the caller should
+	// wait [Reservation.Delay] before making the calls they pay for.
+	Reservation struct {
+		clock   Clock
+		readyAt time.Time
 	}
 
 	// atomicFloat64 is a lock-free float64 cell, storing the value as its
@@ -111,6 +170,12 @@ const (
 	defaultAIMDInterval  = time.Second
 	defaultAIMDRateFloor = 10 // minRate default = ceiling / this
 	defaultAIMDStepRatio = 20 // additive step default = ceiling / this
+
+	// rateWindowBuckets is the number of slices a sliding rate window is
+	// divided into. The count sums one more slice than that, the one the
+	// window's start falls in, so a call leaves the count at most one slice
+	// late and never early.
+	rateWindowBuckets = 10
 )
 
 // Load returns the stored value.
@@ -131,6 +196,58 @@ func RateLimitBlocking() RateLimitOption {
 	}
 }
 
+// RateLimitBurst sets the token bucket's capacity independently of the refill
+// rate: WithRateLimit(10, RateLimitBurst(50)) sustains 10 calls per second but
+// lets an idle limiter absorb a burst of 50. Without it the capacity is one
+// second's worth of rate, and it follows the rate on [RateLimiter.Reconfigure]
+// and [AIMD] adjustments; with it the capacity stays fixed while the rate moves.
+// A non-positive burst keeps the default. A leaky bucket (see [RateLimitLeaky])
+// has no burst, so the option has no effect there.
+func RateLimitBurst(burst int) RateLimitOption {
+	return func(cfg *rateLimitConfig) {
+		cfg.burst = burst
+	}
+}
+
+// RateLimitLeaky switches the limiter from a token bucket to a leaky bucket:
+// calls are paced evenly, at least 1/rate apart, with no burst. The token
+// bucket lets an idle limiter absorb a burst of up to one second's worth of
+// calls; a leaky bucket never does, which suits smoothing traffic to a fragile
+// partner API. A call arriving before its slot is rejected with
+// [ErrRateLimited], or with [RateLimitBlocking] waits for the slot. Spacing is
+// measured on the limiter's [Clock] and follows the live rate, so
+// [RateLimiter.Reconfigure] and [AIMD] adjust it.
+func RateLimitLeaky() RateLimitOption {
+	return func(cfg *rateLimitConfig) {
+		cfg.leaky = true
+		cfg.window = 0
+	}
+}
+
+// RateLimitSlidingWindow switches the limiter from a token bucket to a
+// sliding-window counter: at most rate × window calls pass in any trailing
+// window, e.g. WithRateLimit(100, RateLimitSlidingWindow(time.Second)) for a
+// strict "no more than 100 per rolling second" contract a token bucket's burst
+// would break. The window is counted in ten fixed slices, so memory stays
+// bounded at any rate; calls leave the count one slice at a time as the
+// [Clock] moves on, at most a tenth of the window late, never early. A call
+// over the limit is rejected with [ErrRateLimited], or with
+// [RateLimitBlocking] waits for a slice to age out. The limit follows the live
+// rate ([RateLimiter.Reconfigure], [AIMD]); a positive rate admits at least one
+// call per window. It replaces [RateLimitLeaky] (the last of the two given
+// wins) and ignores [RateLimitBurst]; a non-positive window keeps the token
+// bucket.
+func RateLimitSlidingWindow(window time.Duration) RateLimitOption {
+	return func(cfg *rateLimitConfig) {
+		if window <= 0 {
+			return
+		}
+
+		cfg.window = window
+		cfg.leaky = false
+	}
+}
+
 // AIMD enables additive-increase / multiplicative-decrease adaptation of the
 // rate limiter's refill rate, turning the configured rate into a starting and
 // ceiling value rather than a fixed one. After each call the policy feeds the
@@ -271,6 +388,9 @@ func (c *aimdConfig) resolve(base float64) {
 }
 
 // NewRateLimiter creates a rate limiter that allows rate tokens per second.
+// The bucket starts full, holding one second's worth of tokens or the
+// [RateLimitBurst] when set. A non-positive rate disables limiting: every call
+// passes and the limiter never reports saturated (see [RateLimiter.Disabled]).
 func NewRateLimiter(
 	rate float64,
 	clock Clock,
@@ -282,14 +402,14 @@ func NewRateLimiter(
 		o(&cfg)
 	}
 
-	capacity := int64(rate * float64(fixedPointScale))
-
 	rl := &RateLimiter{
 		clock: clock,
 		hooks: hooks,
 		cfg:   cfg,
 	}
 
+	capacity := rl.capacityFor(rate)
+
 	rl.rate.Store(rate)
 	rl.capacity.Store(capacity)
 	// Start with a full bucket.
@@ -301,6 +421,12 @@ func NewRateLimiter(
 		rl.aimd = newAIMDState(cfg.aimd, rate, clock.Now().UnixNano())
 	}
 
+	if cfg.window > 0 {
+		rl.window = &rateWindow{
+			bucketNanos: max(int64(cfg.window)/rateWindowBuckets, 1),
+		}
+	}
+
 	return rl
 }
 
@@ -323,8 +449,10 @@ func newAIMDState(cfg *aimdConfig, baseRate float64, nowNano int64) *aimdState {
 }
 
 // Reconfigure changes the token-refill rate (tokens per second) at runtime.
-// The bucket capacity is recomputed and the current token count is clamped to
-// the new capacity. Safe for concurrent use with Allow.
+// The bucket capacity is recomputed — unless fixed by [RateLimitBurst] — and the
+// current token count is clamped to the new capacity. A non-positive rate
+// disables limiting until a positive one is set. Safe for concurrent use with
+// Allow.
 func (rl *RateLimiter) Reconfigure(rate float64) {
 	rl.storeRate(rate)
 }
@@ -337,7 +465,7 @@ func (rl *RateLimiter) Reconfigure(rate float64) {
 // path observes the change without coordination; callers that need adjustments
 // serialised (Reconfigure, the AIMD controller) provide their own ordering.
 func (rl *RateLimiter) storeRate(rate float64) {
-	newCapacity := int64(rate * float64(fixedPointScale))
+	newCapacity := rl.capacityFor(rate)
 
 	rl.rate.Store(rate)
 	rl.capacity.Store(newCapacity)
@@ -355,6 +483,16 @@ func (rl *RateLimiter) storeRate(rate float64) {
 	}
 }
 
+// capacityFor returns the bucket capacity, in fixed-point tokens, for the given
+// refill rate: the configured burst when set, else one second's worth of rate.
+func (rl *RateLimiter) capacityFor(rate float64) int64 {
+	if rl.cfg.burst > 0 {
+		return int64(rl.cfg.burst) * fixedPointScale
+	}
+
+	return int64(rate * float64(fixedPointScale))
+}
+
 // refill adds tokens based on elapsed time since the last refill. It uses a
 // CAS loop to atomically update both the token count and the last-refill
 // timestamp, ensuring lock-free correctness under concurrent access.
@@ -404,18 +542,19 @@ func (rl *RateLimiter) refill() {
 	}
 }
 
-// tryAcquire attempts to decrement one token using a CAS loop.
-// Returns true if a token was successfully acquired.
-func (rl *RateLimiter) tryAcquire() bool {
-	oneToken := fixedPointScale
+// tryAcquire attempts to decrement n tokens at once using a CAS loop, so a
+// concurrent caller can never take part of them. Returns true if all n were
+// acquired.
+func (rl *RateLimiter) tryAcquire(n int64) bool {
+	want := n * fixedPointScale
 
 	for {
 		current := rl.tokens.Load()
-		if current < oneToken {
+		if current < want {
 			return false
 		}
 
-		if rl.tokens.CompareAndSwap(current, current-oneToken) {
+		if rl.tokens.CompareAndSwap(current, current-want) {
 			return true
 		}
 	}
@@ -423,35 +562,52 @@ func (rl *RateLimiter) tryAcquire() bool {
 
 // Allow attempts to acquire a token. In reject mode (default), returns
 // ErrRateLimited if no token is available. In blocking mode, waits for a token
-// (respects ctx cancellation).
+// (respects ctx cancellation). An already-done ctx returns ctx.Err() without
+// consuming a token, in either mode.
 func (rl *RateLimiter) Allow(ctx context.Context) error {
-	// Refill based on elapsed time, then try to acquire.
-	rl.refill()
+	return rl.AllowN(ctx, 1)
+}
+
+// AllowN is [RateLimiter.Allow] for n tokens taken atomically — a batch of 10
+// messages costs 10 tokens — so callers looping Allow cannot interleave with
+// it. It takes all n or none: in reject mode it returns ErrRateLimited unless n
+// tokens are available now, in blocking mode it waits until they are. A
+// request that can never be met — n above the bucket capacity, or above the
+// limit of a sliding window — is rejected at once in either mode rather than
+// waiting forever. In leaky-bucket mode the n calls take n consecutive slots.
+// A non-positive n always succeeds.
+func (rl *RateLimiter) AllowN(ctx context.Context, n int) error {
+	// An already-done context never consumes a token.
+	if err := ctx.Err(); err != nil {
+		return err //nolint:wrapcheck // preserving context error identity
+	}
 
-	if rl.tryAcquire() {
+	if n <= 0 || rl.acquire(int64(n)) {
 		return nil
 	}
 
-	// No token available.
+	// Not enough tokens available.
 	if !rl.cfg.blocking {
-		rl.hooks.emitRateLimited()
-		return ErrRateLimited
+		return rl.reject()
 	}
 
-	// Blocking mode: wait for a token, respecting context cancellation.
+	// Blocking mode: wait for the tokens, respecting context cancellation.
 	for {
 		// Check context before sleeping.
 		if err := ctx.Err(); err != nil {
 			return err //nolint:wrapcheck // preserving context error identity
 		}
 
-		// Sleep briefly, then retry.
-		timer := rl.clock.NewTimer(time.Millisecond)
+		// The capacity follows Reconfigure, so re-check it on every round.
+		if !rl.fits(int64(n)) {
+			return rl.reject()
+		}
+
+		// Sleep until the tokens may be available, then retry.
+		timer := rl.clock.NewTimer(rl.retryWait())
 		select {
 		case <-timer.C():
-			rl.refill()
-
-			if rl.tryAcquire() {
+			if rl.acquire(int64(n)) {
 				return nil
 			}
 		case <-ctx.Done():
@@ -462,17 +618,380 @@ func (rl *RateLimiter) Allow(ctx context.Context) error {
 	}
 }
 
-// Saturated returns true if the bucket is empty (no tokens available).
+// reject counts a refused call, emits OnRateLimited, and returns
+// ErrRateLimited.
+func (rl *RateLimiter) reject() error {
+	rl.rejected.Add(1)
+	rl.hooks.emitRateLimited()
+
+	return ErrRateLimited
+}
+
+// Disabled reports whether the limiter is switched off by a non-positive rate,
+// letting every call through. A tiny positive rate still throttles.
+func (rl *RateLimiter) Disabled() bool {
+	return rl.rate.Load() <= 0
+}
+
+// acquire takes n tokens — or, in leaky-bucket mode, the next n slots, and in
+// sliding-window mode n places in the window — and reports whether it
+// succeeded. A disabled limiter always succeeds.
+func (rl *RateLimiter) acquire(n int64) bool {
+	if rl.Disabled() {
+		return true
+	}
+
+	if rl.cfg.leaky {
+		return rl.tryAcquireSlot(n)
+	}
+
+	if rl.window != nil {
+		return rl.window.tryAcquire(rl.clock.Now().UnixNano(), n, rl.windowLimit())
+	}
+
+	// Refill based on elapsed time, then try to acquire.
+	rl.refill()
+
+	return rl.tryAcquire(n)
+}
+
+// fits reports whether n tokens can ever be taken at once: no more than the
+// bucket capacity, or the limit of a sliding window. A leaky bucket spreads n
+// calls over n slots, and a disabled limiter takes none, so any n fits.
+func (rl *RateLimiter) fits(n int64) bool {
+	if rl.cfg.leaky || rl.Disabled() {
+		return true
+	}
+
+	if rl.window != nil {
+		return n <= rl.windowLimit()
+	}
+
+	return n*fixedPointScale <= rl.capacity.Load()
+}
+
+// ReserveN claims n tokens now and reports how long the caller must wait
+// before using them, instead of failing when they are not yet available. The
+// tokens are taken at once — the bucket goes into debt that refill pays back —
+// so a reservation holds its place ahead of later callers; the caller should
+// wait [Reservation.Delay] and then proceed. In leaky-bucket mode the
+// reservation books the next n slots; in sliding-window mode, the first slice
+// within one window ahead where n more calls fit. ok is false, and nothing is
+// taken, when n can never be met (see [RateLimiter.AllowN]) or when a sliding
+// window is booked more than a window ahead. A non-positive n, or a disabled
+// limiter, is ready at once.
+// ReserveN never blocks and never counts as a rejection.
+func (rl *RateLimiter) ReserveN(n int) (Reservation, bool) {
+	now := rl.clock.Now()
+	ready := func(at time.Time) (Reservation, bool) {
+		return Reservation{clock: rl.clock, readyAt: at}, true
+	}
+
+	switch {
+	case n <= 0, rl.Disabled():
+		return ready(now)
+	case !rl.fits(int64(n)):
+		return Reservation{}, false
+	case rl.cfg.leaky:
+		at, ok := rl.reserveSlots(int64(n), now.UnixNano())
+		if !ok {
+			return Reservation{}, false
+		}
+
+		return ready(time.Unix(0, at))
+	case rl.window != nil:
+		at, ok := rl.window.reserve(now.UnixNano(), int64(n), rl.windowLimit())
+		if !ok {
+			return Reservation{}, false
+		}
+
+		return ready(time.Unix(0, at))
+	}
+
+	rl.refill()
+
+	want := int64(n) * fixedPointScale
+
+	for {
+		current := rl.tokens.Load()
+		deficit := want - current
+
+		var wait time.Duration
+
+		if deficit > 0 {
+			rate := rl.rate.Load()
+			if rate <= 0 {
+				return Reservation{}, false
+			}
+
+			// deficit is in fixed-point tokens (1e9 per token), so deficit/rate
+			// is already in nanoseconds.
+			wait = time.Duration(math.Ceil(float64(deficit) / rate))
+		}
+
+		if rl.tokens.CompareAndSwap(current, current-want) {
+			return ready(now.Add(wait))
+		}
+	}
+}
+
+// Delay returns how long to wait before making the reserved calls: zero once
+// the reservation is ready. It is measured on the limiter's [Clock], so it
+// shrinks as time passes.
+func (r Reservation) Delay() time.Duration {
+	if r.clock == nil {
+		return 0
+	}
+
+	return max(r.readyAt.Sub(r.clock.Now()), 0)
+}
+
+// retryWait is how long a blocked caller sleeps before trying again: until the
+// next slot in leaky-bucket mode, until the next slice ages out of a sliding
+// window, a short poll for the token bucket.
+func (rl *RateLimiter) retryWait() time.Duration {
+	if rl.cfg.leaky {
+		return max(time.Duration(rl.nextSlot.Load()-rl.clock.Now().UnixNano()), 0)
+	}
+
+	if rl.window != nil {
+		return rl.window.untilNextSlice(rl.clock.Now().UnixNano())
+	}
+
+	return time.Millisecond
+}
+
+// slotInterval is the leaky-bucket spacing between calls, 1/rate, in
+// nanoseconds. ok is false for a non-positive rate, which admits nothing.
+func (rl *RateLimiter) slotInterval() (interval int64, ok bool) {
+	rate := rl.rate.Load()
+	if rate <= 0 {
+		return 0, false
+	}
+
+	return int64(float64(time.Second) / rate), true
+}
+
+// tryAcquireSlot claims the next n leaky-bucket slots with a CAS on nextSlot.
+// A call at or after the slot passes and pushes the slot n intervals past now
+// — not past the old slot — so idle time never banks credit for a burst.
+func (rl *RateLimiter) tryAcquireSlot(n int64) bool {
+	interval, ok := rl.slotInterval()
+	if !ok {
+		return false
+	}
+
+	for {
+		nowNano := rl.clock.Now().UnixNano()
+
+		next := rl.nextSlot.Load()
+		if nowNano < next {
+			return false
+		}
+
+		if rl.nextSlot.CompareAndSwap(next, nowNano+n*interval) {
+			return true
+		}
+	}
+}
+
+// reserveSlots books the next n leaky-bucket slots whenever they come, and
+// returns the unixnano of the first. ok is false for a non-positive rate.
+func (rl *RateLimiter) reserveSlots(n, nowNano int64) (start int64, ok bool) {
+	interval, ok := rl.slotInterval()
+	if !ok {
+		return 0, false
+	}
+
+	for {
+		next := rl.nextSlot.Load()
+		start = max(next, nowNano)
+
+		if rl.nextSlot.CompareAndSwap(next, start+n*interval) {
+			return start, true
+		}
+	}
+}
+
+// windowLimit is the most calls a sliding window admits: rate × window, at
+// least one for a positive rate, none otherwise.
+func (rl *RateLimiter) windowLimit() int64 {
+	rate := rl.rate.Load()
+	if rate <= 0 {
+		return 0
+	}
+
+	return max(int64(rate*rl.cfg.window.Seconds()), 1)
+}
+
+// tryAcquire admits n calls at nowNano when no more than limit-n calls were
+// admitted in the trailing window, counting them in the current slice.
+func (w *rateWindow) tryAcquire(nowNano, n, limit int64) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	current := nowNano / w.bucketNanos
+	if w.countAtLocked(current)+n > limit {
+		return false
+	}
+
+	w.addLocked(current, n)
+
+	return true
+}
+
+// reserve books n calls in the first slice, from the current one up to a
+// window ahead, whose window has room for them, and returns the unixnano the
+// slice starts (nowNano for the current one). ok is false when no slice in
+// that range has room.
+func (w *rateWindow) reserve(nowNano, n, limit int64) (at int64, ok bool) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	current := nowNano / w.bucketNanos
+
+	for epoch := current; epoch <= current+rateWindowBuckets+1; epoch++ {
+		if w.countAtLocked(epoch)+n > limit {
+			continue
+		}
+
+		w.addLocked(epoch, n)
+
+		if epoch == current {
+			return nowNano, true
+		}
+
+		return epoch * w.bucketNanos, true
+	}
+
+	return 0, false
+}
+
+// addLocked counts n calls in the slice stamped epoch, recycling its ring slot
+// when it still holds an older slice. Must be called with w.mu held.
+func (w *rateWindow) addLocked(epoch, n int64) {
+	bucket := &w.buckets[w.slot(epoch)]
+	if bucket.epoch != epoch {
+		*bucket = rateWindowBucket{epoch: epoch}
+	}
+
+	bucket.count += n
+}
+
+// count returns the calls admitted in the trailing window at nowNano.
+func (w *rateWindow) count(nowNano int64) int64 {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return w.countAtLocked(nowNano / w.bucketNanos)
+}
+
+// countAtLocked sums the slices from the one the window ending in slice
+// current starts in onward. Slices stamped later than current — calls booked
+// ahead by reserve, or counted before a clock stepped backward — are included,
+// so room is never granted twice; older slices are skipped. Must be called
+// with w.mu held.
+func (w *rateWindow) countAtLocked(current int64) int64 {
+	oldest := current - rateWindowBuckets
+
+	var total int64
+
+	for i := range w.buckets {
+		bucket := &w.buckets[i]
+		if bucket.epoch >= oldest {
+			total += bucket.count
+		}
+	}
+
+	return total
+}
+
+// slot maps an epoch to its ring index.
+func (w *rateWindow) slot(epoch int64) int {
+	size := int64(len(w.buckets))
+
+	return int((epoch%size + size) % size)
+}
+
+// untilNextSlice is how long until the oldest counted slice ages out.
+func (w *rateWindow) untilNextSlice(nowNano int64) time.Duration {
+	return time.Duration(w.bucketNanos - nowNano%w.bucketNanos)
+}
+
+// slotFree reports whether a leaky-bucket call would pass now.
+func (rl *RateLimiter) slotFree() bool {
+	return rl.clock.Now().UnixNano() >= rl.nextSlot.Load()
+}
+
+// Saturated returns true if the bucket is empty (no tokens available) — in
+// leaky-bucket mode, if the next slot has not yet come, and in sliding-window
+// mode, if the window is full. A disabled limiter is never saturated.
 //
 // It is not side-effect-free: like Allow it first refills the bucket for
 // elapsed time (an atomic CAS update), so calling it from a health probe
 // advances the limiter's refill clock. This is safe for concurrent use but
 // means HealthStatus is an observer that also nudges refill timing.
 func (rl *RateLimiter) Saturated() bool {
+	if rl.Disabled() {
+		return false
+	}
+
+	if rl.cfg.leaky {
+		return !rl.slotFree()
+	}
+
+	if rl.window != nil {
+		return rl.window.count(rl.clock.Now().UnixNano()) >= rl.windowLimit()
+	}
+
 	rl.refill()
+
 	return rl.tokens.Load() < fixedPointScale
 }
 
+// Stats returns a snapshot of the bucket: available tokens, capacity, refill
+// rate, and the rejection count. Like [RateLimiter.Saturated] it first refills
+// the bucket for elapsed time, so Available is current. A leaky bucket holds a
+// single slot: Capacity is 1 and Available is 1 when the next call would pass,
+// else 0. A sliding window reports its limit as Capacity and the calls it
+// would still admit as Available.
+func (rl *RateLimiter) Stats() RLStats {
+	if rl.cfg.leaky {
+		var available float64
+		if rl.slotFree() {
+			available = 1
+		}
+
+		return RLStats{
+			Available:  available,
+			Capacity:   1,
+			Rate:       rl.rate.Load(),
+			Rejections: rl.rejected.Load(),
+		}
+	}
+
+	if rl.window != nil {
+		limit := rl.windowLimit()
+
+		return RLStats{
+			Available:  float64(max(limit-rl.window.count(rl.clock.Now().UnixNano()), 0)),
+			Capacity:   float64(limit),
+			Rate:       rl.rate.Load(),
+			Rejections: rl.rejected.Load(),
+		}
+	}
+
+	rl.refill()
+
+	return RLStats{
+		// A reservation can leave the bucket in debt; report that as empty.
+		Available:  float64(max(rl.tokens.Load(), 0)) / float64(fixedPointScale),
+		Capacity:   float64(rl.capacity.Load()) / float64(fixedPointScale),
+		Rate:       rl.rate.Load(),
+		Rejections: rl.rejected.Load(),
+	}
+}
+
 // CurrentRate returns the limiter's current refill rate in tokens per second.
 // Without AIMD this is the configured (or last Reconfigured) rate; with AIMD it
 // is the live adapted rate, moving within [AIMDMinRate, AIMDMaxRate].
@@ -485,12 +1004,13 @@ func (rl *RateLimiter) CurrentRate() float64 {
 // multiplicatively; any other outcome increases it additively; the rate stays
 // within [AIMDMinRate, AIMDMaxRate]. At most one adjustment is applied per
 // [AIMDInterval], so a burst of overload signals backs the rate off once rather
-// than repeatedly. It is a no-op on a limiter without AIMD and is safe for
-// concurrent use. Pair it with [RateLimiter.Allow] — the policy calls it once per
-// user call, after the inner work returns.
+// than repeatedly. It is a no-op on a limiter without AIMD or a disabled one
+// (a rate of zero never adapts) and is safe for concurrent use. Pair it with
+// [RateLimiter.Allow] — the policy calls it once per user call, after the inner
+// work returns.
 func (rl *RateLimiter) RecordOutcome(err error) {
 	ctrl := rl.aimd
-	if ctrl == nil {
+	if ctrl == nil || rl.Disabled() {
 		return
 	}
 
@@ -92,6 +92,20 @@ func TestRateLimiterAllowMultipleWithinLimit(t *testing.T) {
 	}
 }
 
+func TestRateLimiterAllowCancelledContextKeepsToken(t *testing.T) {
+	t.Parallel()
+
+	clk := newRateLimitClock(time.Now())
+	rl := NewRateLimiter(1, clk, &Hooks{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	require.ErrorIs(t, rl.Allow(ctx), context.Canceled)
+	// The single token is still there for a live caller.
+	require.NoError(t, rl.Allow(context.Background()))
+}
+
 // ---------------------------------------------------------------------------
 // Tests: Exceed limit in reject mode returns ErrRateLimited
 // ---------------------------------------------------------------------------
@@ -1133,6 +1147,27 @@ func TestReconfigureAIMDWithoutAIMDEnabled(t *testing.T) {
 	require.ErrorIs(t, err, ErrAIMDWithoutRateLimit)
 }
 
+func TestPolicyRateLimiterRecordsPanicOutcome(t *testing.T) {
+	t.Parallel()
+
+	var seen []error
+
+	p := NewPolicy[string]("rl-panic",
+		WithRateLimit(100, AIMD(AIMDClassifier(func(err error) bool {
+			seen = append(seen, err)
+			return false
+		}))))
+
+	require.PanicsWithValue(t, "boom", func() {
+		_, _ = p.Do(context.Background(), func(_ context.Context) (string, error) {
+			panic("boom")
+		})
+	})
+
+	require.Len(t, seen, 1, "a panicking call is still fed back to AIMD")
+	require.ErrorIs(t, seen[0], ErrPanic)
+}
+
 // ---------------------------------------------------------------------------
 // Benchmarks
 // ---------------------------------------------------------------------------
@@ -1152,3 +1187,641 @@ func BenchmarkRateLimiterAllow(b *testing.B) {
 		}
 	})
 }
+
+// ---------------------------------------------------------------------------
+// Tests: Stats
+// ---------------------------------------------------------------------------
+
+func TestRateLimiterStats(t *testing.T) {
+	t.Parallel()
+
+	clk := newRateLimitClock(time.Now())
+	rl := NewRateLimiter(5, clk, &Hooks{})
+
+	stats := rl.Stats()
+	require.InDelta(t, 5.0, stats.Available, 1e-9)
+	require.InDelta(t, 5.0, stats.Capacity, 1e-9)
+	require.InDelta(t, 5.0, stats.Rate, 1e-9)
+	require.Zero(t, stats.Rejections)
+
+	for range 7 {
+		_ = rl.Allow(context.Background())
+	}
+
+	stats = rl.Stats()
+	require.InDelta(t, 0, stats.Available, 1e-9)
+	require.Equal(t, int64(2), stats.Rejections)
+
+	clk.advance(300 * time.Millisecond) // 1.5 tokens refill
+
+	require.InDelta(t, 1.5, rl.Stats().Available, 1e-9)
+}
+
+func TestPolicyRateLimiterStats(t *testing.T) {
+	t.Parallel()
+
+	p := NewPolicy[string]("",
+		WithClock(newRateLimitClock(time.Now())),
+		WithRateLimit(3),
+	)
+
+	for range 3 {
+		_, err := p.Do(context.Background(), func(_ context.Context) (string, error) {
+			return "ok", nil
+		})
+		require.NoError(t, err)
+	}
+
+	stats, ok := p.RateLimiterStats()
+	require.True(t, ok)
+	require.InDelta(t, 0, stats.Available, 1e-9)
+
+	_, ok = NewPolicy[string]("").RateLimiterStats()
+	require.False(t, ok)
+}
+
+// ---------------------------------------------------------------------------
+// Tests: Leaky-bucket mode
+// ---------------------------------------------------------------------------
+
+func TestRateLimiterLeakyRejectsBackToBack(t *testing.T) {
+	t.Parallel()
+
+	clk := newRateLimitClock(time.Now())
+
+	// A token bucket at the same rate would pass a burst of 10.
+	bucket := NewRateLimiter(10, clk, &Hooks{})
+	require.NoError(t, bucket.Allow(context.Background()))
+	require.NoError(t, bucket.Allow(context.Background()))
+
+	leaky := NewRateLimiter(10, clk, &Hooks{}, RateLimitLeaky())
+	require.NoError(t, leaky.Allow(context.Background()))
+	require.ErrorIs(t, leaky.Allow(context.Background()), ErrRateLimited)
+}
+
+func TestRateLimiterLeakySpacesCallsEvenly(t *testing.T) {
+	t.Parallel()
+
+	clk := newRateLimitClock(time.Now())
+	rl := NewRateLimiter(10, clk, &Hooks{}, RateLimitLeaky()) // one call per 100ms
+
+	require.NoError(t, rl.Allow(context.Background()))
+
+	clk.advance(99 * time.Millisecond)
+	require.ErrorIs(t, rl.Allow(context.Background()), ErrRateLimited)
+
+	clk.advance(time.Millisecond)
+	require.NoError(t, rl.Allow(context.Background()))
+
+	// Idle time banks no credit: after a long pause only one call passes.
+	clk.advance(10 * time.Second)
+	require.NoError(t, rl.Allow(context.Background()))
+	require.ErrorIs(t, rl.Allow(context.Background()), ErrRateLimited)
+	require.Equal(t, int64(2), rl.Stats().Rejections)
+}
+
+func TestRateLimiterLeakyBlockingWaitsForSlot(t *testing.T) {
+	t.Parallel()
+
+	start := time.Now()
+	clk := &elapsedTestClock{now: start} // timers advance the clock and fire
+	rl := NewRateLimiter(4, clk, &Hooks{}, RateLimitLeaky(), RateLimitBlocking())
+
+	require.NoError(t, rl.Allow(context.Background()))
+	require.NoError(t, rl.Allow(context.Background()))
+	require.NoError(t, rl.Allow(context.Background()))
+
+	// Each blocked call slept exactly until its slot, 250ms apart.
+	require.Equal(t, 500*time.Millisecond, clk.Now().Sub(start))
+}
+
+func TestRateLimiterLeakySaturatedAndStats(t *testing.T) {
+	t.Parallel()
+
+	clk := newRateLimitClock(time.Now())
+	rl := NewRateLimiter(2, clk, &Hooks{}, RateLimitLeaky())
+
+	require.False(t, rl.Saturated())
+	require.InDelta(t, 1.0, rl.Stats().Available, 1e-9)
+
+	require.NoError(t, rl.Allow(context.Background()))
+
+	require.True(t, rl.Saturated())
+	stats := rl.Stats()
+	require.InDelta(t, 0.0, stats.Available, 1e-9)
+	require.InDelta(t, 1.0, stats.Capacity, 1e-9)
+	require.InDelta(t, 2.0, stats.Rate, 1e-9)
+
+	clk.advance(500 * time.Millisecond)
+	require.False(t, rl.Saturated())
+}
+
+func TestRateLimiterLeakyFollowsReconfiguredRate(t *testing.T) {
+	t.Parallel()
+
+	clk := newRateLimitClock(time.Now())
+	rl := NewRateLimiter(1, clk, &Hooks{}, RateLimitLeaky())
+
+	rl.Reconfigure(100) // 10ms spacing from the next slot on
+
+	require.NoError(t, rl.Allow(context.Background()))
+	clk.advance(10 * time.Millisecond)
+	require.NoError(t, rl.Allow(context.Background()))
+}
+
+// ---------------------------------------------------------------------------
+// Tests: Sliding-window mode
+// ---------------------------------------------------------------------------
+
+// windowStart is a start time on a sub-bucket boundary, so the tests below
+// can reason in whole tenths of the window.
+func windowStart() time.Time {
+	return time.Unix(1_000_000, 0)
+}
+
+func TestRateLimiterSlidingWindowRejectsAtLimit(t *testing.T) {
+	t.Parallel()
+
+	clk := newRateLimitClock(windowStart())
+	rl := NewRateLimiter(10, clk, &Hooks{}, RateLimitSlidingWindow(time.Second))
+
+	for i := range 10 {
+		require.NoError(t, rl.Allow(context.Background()), "call %d", i+1)
+	}
+
+	require.ErrorIs(t, rl.Allow(context.Background()), ErrRateLimited)
+	require.True(t, rl.Saturated())
+
+	// A full window later the calls have not aged out yet: the count is late
+	// by up to one sub-bucket, never early.
+	clk.advance(time.Second)
+	require.ErrorIs(t, rl.Allow(context.Background()), ErrRateLimited)
+
+	clk.advance(100 * time.Millisecond)
+	require.NoError(t, rl.Allow(context.Background()))
+}
+
+func TestRateLimiterSlidingWindowFreesGradually(t *testing.T) {
+	t.Parallel()
+
+	clk := newRateLimitClock(windowStart())
+	rl := NewRateLimiter(10, clk, &Hooks{}, RateLimitSlidingWindow(time.Second))
+
+	// Half the window's calls at the start, half midway through.
+	for range 5 {
+		require.NoError(t, rl.Allow(context.Background()))
+	}
+
+	clk.advance(500 * time.Millisecond)
+
+	for range 5 {
+		require.NoError(t, rl.Allow(context.Background()))
+	}
+
+	require.InDelta(t, 0.0, rl.Stats().Available, 1e-9)
+
+	// Crossing the boundary of the first batch frees only that batch.
+	clk.advance(600 * time.Millisecond)
+	require.InDelta(t, 5.0, rl.Stats().Available, 1e-9)
+
+	// The second batch ages out half a window later.
+	clk.advance(400 * time.Millisecond)
+	require.InDelta(t, 5.0, rl.Stats().Available, 1e-9)
+
+	clk.advance(100 * time.Millisecond)
+	require.InDelta(t, 10.0, rl.Stats().Available, 1e-9)
+}
+
+func TestRateLimiterSlidingWindowNoBurstAcrossBoundary(t *testing.T) {
+	t.Parallel()
+
+	clk := newRateLimitClock(windowStart())
+	rl := NewRateLimiter(10, clk, &Hooks{}, RateLimitSlidingWindow(time.Second))
+
+	// Fill the window at its very end; a fixed window would reset right after.
+	clk.advance(900 * time.Millisecond)
+
+	for range 10 {
+		require.NoError(t, rl.Allow(context.Background()))
+	}
+
+	clk.advance(200 * time.Millisecond)
+	require.ErrorIs(t, rl.Allow(context.Background()), ErrRateLimited)
+}
+
+func TestRateLimiterSlidingWindowBlockingWaitsForSlice(t *testing.T) {
+	t.Parallel()
+
+	start := windowStart()
+	clk := &elapsedTestClock{now: start} // timers advance the clock and fire
+	rl := NewRateLimiter(2, clk, &Hooks{},
+		RateLimitSlidingWindow(time.Second), RateLimitBlocking())
+
+	require.NoError(t, rl.Allow(context.Background()))
+	require.NoError(t, rl.Allow(context.Background()))
+	require.NoError(t, rl.Allow(context.Background()))
+
+	// The third call waited for the first slice to leave the window.
+	require.Equal(t, 1100*time.Millisecond, clk.Now().Sub(start))
+}
+
+func TestRateLimiterSlidingWindowStatsAndRate(t *testing.T) {
+	t.Parallel()
+
+	clk := newRateLimitClock(windowStart())
+	rl := NewRateLimiter(0.5, clk, &Hooks{}, RateLimitSlidingWindow(time.Second))
+
+	// A positive rate admits at least one call per window.
+	stats := rl.Stats()
+	require.InDelta(t, 1.0, stats.Capacity, 1e-9)
+	require.InDelta(t, 1.0, stats.Available, 1e-9)
+
+	rl.Reconfigure(20)
+	require.InDelta(t, 20.0, rl.Stats().Capacity, 1e-9)
+
+	require.NoError(t, rl.Allow(context.Background()))
+	require.InDelta(t, 19.0, rl.Stats().Available, 1e-9)
+	require.False(t, rl.Saturated())
+}
+
+func TestRateLimiterSlidingWindowOptionPrecedence(t *testing.T) {
+	t.Parallel()
+
+	clk := newRateLimitClock(windowStart())
+
+	// The last of RateLimitLeaky and RateLimitSlidingWindow wins.
+	window := NewRateLimiter(10, clk, &Hooks{},
+		RateLimitLeaky(), RateLimitSlidingWindow(time.Second))
+	require.NoError(t, window.Allow(context.Background()))
+	require.NoError(t, window.Allow(context.Background()))
+
+	leaky := NewRateLimiter(10, clk, &Hooks{},
+		RateLimitSlidingWindow(time.Second), RateLimitLeaky())
+	require.NoError(t, leaky.Allow(context.Background()))
+	require.ErrorIs(t, leaky.Allow(context.Background()), ErrRateLimited)
+
+	// A non-positive window keeps the token bucket.
+	bucket := NewRateLimiter(10, clk, &Hooks{}, RateLimitSlidingWindow(0))
+	require.InDelta(t, 10.0, bucket.Stats().Capacity, 1e-9)
+	require.Nil(t, bucket.window)
+}
+
+// ---------------------------------------------------------------------------
+// Tests: Burst decoupled from rate
+// ---------------------------------------------------------------------------
+
+func TestRateLimiterBurstDrainsFullCapacity(t *testing.T) {
+	t.Parallel()
+
+	clk := newRateLimitClock(time.Now())
+	rl := NewRateLimiter(10, clk, &Hooks{}, RateLimitBurst(50))
+
+	for i := range 50 {
+		require.NoError(t, rl.Allow(context.Background()), "call %d", i+1)
+	}
+
+	require.ErrorIs(t, rl.Allow(context.Background()), ErrRateLimited)
+	require.True(t, rl.Saturated())
+}
+
+func TestRateLimiterBurstRefillsAtRateUpToCap(t *testing.T) {
+	t.Parallel()
+
+	clk := newRateLimitClock(time.Now())
+	rl := NewRateLimiter(10, clk, &Hooks{}, RateLimitBurst(50))
+
+	for range 50 {
+		require.NoError(t, rl.Allow(context.Background()))
+	}
+
+	// Refill is driven by the rate, not the burst: 10 tokens per second.
+	clk.advance(time.Second)
+	require.InDelta(t, 10.0, rl.Stats().Available, 1e-9)
+	require.False(t, rl.Saturated())
+
+	clk.advance(2 * time.Second)
+	require.InDelta(t, 30.0, rl.Stats().Available, 1e-9)
+
+	// Well past a full refill, the bucket is capped at the burst.
+	clk.advance(10 * time.Second)
+
+	stats := rl.Stats()
+	require.InDelta(t, 50.0, stats.Available, 1e-9)
+	require.InDelta(t, 50.0, stats.Capacity, 1e-9)
+	require.InDelta(t, 10.0, stats.Rate, 1e-9)
+}
+
+func TestRateLimiterBurstSurvivesReconfigure(t *testing.T) {
+	t.Parallel()
+
+	clk := newRateLimitClock(time.Now())
+	rl := NewRateLimiter(10, clk, &Hooks{}, RateLimitBurst(50))
+
+	rl.Reconfigure(2)
+
+	stats := rl.Stats()
+	require.InDelta(t, 50.0, stats.Capacity, 1e-9)
+	require.InDelta(t, 50.0, stats.Available, 1e-9)
+	require.InDelta(t, 2.0, stats.Rate, 1e-9)
+}
+
+func TestRateLimiterNonPositiveBurstKeepsDefault(t *testing.T) {
+	t.Parallel()
+
+	clk := newRateLimitClock(time.Now())
+	rl := NewRateLimiter(5, clk, &Hooks{}, RateLimitBurst(0))
+
+	require.InDelta(t, 5.0, rl.Stats().Capacity, 1e-9)
+
+	rl.Reconfigure(8)
+	require.InDelta(t, 8.0, rl.Stats().Capacity, 1e-9)
+}
+
+func TestPolicyRateLimitBurst(t *testing.T) {
+	t.Parallel()
+
+	p := NewPolicy[string]("",
+		WithClock(newRateLimitClock(time.Now())),
+		WithRateLimit(1, RateLimitBurst(3)),
+	)
+
+	fn := func(_ context.Context) (string, error) { return "ok", nil }
+
+	for range 3 {
+		_, err := p.Do(context.Background(), fn)
+		require.NoError(t, err)
+	}
+
+	_, err := p.Do(context.Background(), fn)
+	require.ErrorIs(t, err, ErrRateLimited)
+}
+
+func TestPolicyRateLimitZeroDisables(t *testing.T) {
+	t.Parallel()
+
+	for _, rate := range []float64{0, -5} {
+		p := NewPolicy[string]("",
+			WithClock(newRateLimitClock(time.Now())),
+			WithRateLimit(rate),
+		)
+
+		fn := func(_ context.Context) (string, error) { return "ok", nil }
+
+		for range 1000 {
+			_, err := p.Do(context.Background(), fn)
+			require.NoError(t, err, "rate %v", rate)
+		}
+
+		require.True(t, p.rateLimiter.Disabled())
+
+		status := p.HealthStatus()
+		require.NotContains(t, status.Conditions, ConditionRateLimited)
+		require.Equal(t, ConditionHealthy, status.State)
+	}
+}
+
+func TestPolicyRateLimitTinyRateStillThrottles(t *testing.T) {
+	t.Parallel()
+
+	p := NewPolicy[string]("",
+		WithClock(newRateLimitClock(time.Now())),
+		WithRateLimit(1, RateLimitBurst(1)),
+	)
+
+	fn := func(_ context.Context) (string, error) { return "ok", nil }
+
+	_, err := p.Do(context.Background(), fn)
+	require.NoError(t, err)
+
+	_, err = p.Do(context.Background(), fn)
+	require.ErrorIs(t, err, ErrRateLimited)
+	require.False(t, p.rateLimiter.Disabled())
+	require.Contains(t, p.HealthStatus().Conditions, ConditionRateLimited)
+}
+
+func TestRateLimiterReconfigureZeroDisablesThenReenables(t *testing.T) {
+	t.Parallel()
+
+	clk := newRateLimitClock(time.Now())
+	rl := NewRateLimiter(1, clk, &Hooks{})
+
+	require.NoError(t, rl.Allow(context.Background()))
+	require.ErrorIs(t, rl.Allow(context.Background()), ErrRateLimited)
+
+	rl.Reconfigure(0)
+
+	for range 10 {
+		require.NoError(t, rl.AllowN(context.Background(), 5))
+	}
+
+	require.False(t, rl.Saturated())
+
+	res, ok := rl.ReserveN(100)
+	require.True(t, ok)
+	require.Zero(t, res.Delay())
+
+	rl.Reconfigure(1)
+	require.ErrorIs(t, rl.Allow(context.Background()), ErrRateLimited,
+		"re-enabled with the bucket as it was left")
+}
+
+// ---------------------------------------------------------------------------
+// Tests: AllowN / ReserveN
+// ---------------------------------------------------------------------------
+
+func TestRateLimiterAllowNTakesTokensAtomically(t *testing.T) {
+	t.Parallel()
+
+	clk := newRateLimitClock(time.Now())
+	rl := NewRateLimiter(10, clk, &Hooks{}, RateLimitBurst(8))
+
+	require.NoError(t, rl.AllowN(context.Background(), 3))
+	require.NoError(t, rl.AllowN(context.Background(), 3))
+
+	// Two tokens left: a batch of three is rejected whole, taking none.
+	require.ErrorIs(t, rl.AllowN(context.Background(), 3), ErrRateLimited)
+	require.InDelta(t, 2.0, rl.Stats().Available, 1e-9)
+	require.Equal(t, int64(1), rl.Stats().Rejections)
+
+	require.NoError(t, rl.AllowN(context.Background(), 2))
+	require.True(t, rl.Saturated())
+}
+
+func TestRateLimiterAllowNNonPositive(t *testing.T) {
+	t.Parallel()
+
+	rl := NewRateLimiter(1, newRateLimitClock(time.Now()), &Hooks{})
+	require.NoError(t, rl.Allow(context.Background()))
+
+	require.NoError(t, rl.AllowN(context.Background(), 0))
+	require.NoError(t, rl.AllowN(context.Background(), -1))
+}
+
+func TestRateLimiterAllowNAboveCapacityNeverWaits(t *testing.T) {
+	t.Parallel()
+
+	var rejected atomic.Int64
+
+	hooks := &Hooks{OnRateLimited: func() { rejected.Add(1) }}
+	rl := NewRateLimiter(5, newRateLimitClock(time.Now()), hooks, RateLimitBlocking())
+
+	require.ErrorIs(t, rl.AllowN(context.Background(), 6), ErrRateLimited)
+	require.Equal(t, int64(1), rejected.Load())
+	require.InDelta(t, 5.0, rl.Stats().Available, 1e-9, "nothing taken")
+}
+
+func TestRateLimiterAllowNBlockingWaitsForAllTokens(t *testing.T) {
+	t.Parallel()
+
+	clk := newRateLimitClock(time.Now())
+	rl := NewRateLimiter(4, clk, &Hooks{}, RateLimitBlocking())
+	require.NoError(t, rl.AllowN(context.Background(), 4))
+
+	done := make(chan error, 1)
+	go func() { done <- rl.AllowN(context.Background(), 3) }()
+
+	// One token is not enough for the waiting batch.
+	clk.advance(250 * time.Millisecond)
+	select {
+	case err := <-done:
+		t.Fatalf("AllowN returned %v with a single token", err)
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	clk.advance(500 * time.Millisecond)
+	require.NoError(t, <-done)
+	require.InDelta(t, 0.0, rl.Stats().Available, 1e-9)
+}
+
+func TestRateLimiterAllowNLeakyTakesConsecutiveSlots(t *testing.T) {
+	t.Parallel()
+
+	clk := newRateLimitClock(time.Now())
+	rl := NewRateLimiter(10, clk, &Hooks{}, RateLimitLeaky())
+
+	require.NoError(t, rl.AllowN(context.Background(), 3))
+
+	// Three slots of 100ms each were taken.
+	clk.advance(250 * time.Millisecond)
+	require.ErrorIs(t, rl.Allow(context.Background()), ErrRateLimited)
+
+	clk.advance(50 * time.Millisecond)
+	require.NoError(t, rl.Allow(context.Background()))
+}
+
+func TestRateLimiterAllowNSlidingWindow(t *testing.T) {
+	t.Parallel()
+
+	clk := newRateLimitClock(windowStart())
+	rl := NewRateLimiter(5, clk, &Hooks{}, RateLimitSlidingWindow(time.Second))
+
+	require.NoError(t, rl.AllowN(context.Background(), 3))
+	require.ErrorIs(t, rl.AllowN(context.Background(), 3), ErrRateLimited)
+	require.NoError(t, rl.AllowN(context.Background(), 2))
+
+	require.ErrorIs(t, rl.AllowN(context.Background(), 6), ErrRateLimited, "above the window limit")
+}
+
+func TestRateLimiterReserveN(t *testing.T) {
+	t.Parallel()
+
+	clk := newRateLimitClock(time.Now())
+	rl := NewRateLimiter(10, clk, &Hooks{})
+
+	r, ok := rl.ReserveN(6)
+	require.True(t, ok)
+	require.Equal(t, time.Duration(0), r.Delay(), "six of ten tokens are there")
+
+	// Four tokens left: six more put the bucket two tokens, 200ms, in debt.
+	r, ok = rl.ReserveN(6)
+	require.True(t, ok)
+	require.Equal(t, 200*time.Millisecond, r.Delay())
+
+	// The reservation holds its place: a later caller waits behind it.
+	require.ErrorIs(t, rl.Allow(context.Background()), ErrRateLimited)
+	require.True(t, rl.Saturated())
+	require.InDelta(t, 0.0, rl.Stats().Available, 1e-9)
+
+	clk.advance(150 * time.Millisecond)
+	require.Equal(t, 50*time.Millisecond, r.Delay())
+
+	clk.advance(50 * time.Millisecond)
+	require.Equal(t, time.Duration(0), r.Delay())
+	require.ErrorIs(t, rl.Allow(context.Background()), ErrRateLimited, "the debt is only just paid")
+
+	clk.advance(100 * time.Millisecond)
+	require.NoError(t, rl.Allow(context.Background()))
+	require.Equal(t, int64(2), rl.Stats().Rejections, "only the two Allow calls; reservations never are")
+}
+
+func TestRateLimiterReserveNUnsatisfiable(t *testing.T) {
+	t.Parallel()
+
+	rl := NewRateLimiter(5, newRateLimitClock(time.Now()), &Hooks{})
+
+	_, ok := rl.ReserveN(6)
+	require.False(t, ok)
+	require.InDelta(t, 5.0, rl.Stats().Available, 1e-9, "nothing taken")
+
+	r, ok := rl.ReserveN(0)
+	require.True(t, ok)
+	require.Equal(t, time.Duration(0), r.Delay())
+
+	require.Equal(t, time.Duration(0), Reservation{}.Delay())
+}
+
+func TestRateLimiterReserveNLeaky(t *testing.T) {
+	t.Parallel()
+
+	clk := newRateLimitClock(time.Now())
+	rl := NewRateLimiter(10, clk, &Hooks{}, RateLimitLeaky())
+
+	first, ok := rl.ReserveN(2)
+	require.True(t, ok)
+	require.Equal(t, time.Duration(0), first.Delay())
+
+	second, ok := rl.ReserveN(1)
+	require.True(t, ok)
+	require.Equal(t, 200*time.Millisecond, second.Delay(), "after the two booked slots")
+}
+
+func TestRateLimiterReserveNSlidingWindow(t *testing.T) {
+	t.Parallel()
+
+	clk := newRateLimitClock(windowStart())
+	rl := NewRateLimiter(4, clk, &Hooks{}, RateLimitSlidingWindow(time.Second))
+
+	require.NoError(t, rl.AllowN(context.Background(), 4))
+
+	// The window is full; the calls leave it one second later, in the slice
+	// that starts 1.1s on (the count runs a slice late).
+	r, ok := rl.ReserveN(2)
+	require.True(t, ok)
+	require.Equal(t, 1100*time.Millisecond, r.Delay())
+
+	// The booked calls count against later callers too.
+	clk.advance(1100 * time.Millisecond)
+	require.NoError(t, rl.AllowN(context.Background(), 2))
+	require.ErrorIs(t, rl.Allow(context.Background()), ErrRateLimited)
+}
+
+func TestPolicyRateLimitN(t *testing.T) {
+	t.Parallel()
+
+	p := NewPolicy[string]("",
+		WithClock(newRateLimitClock(time.Now())),
+		WithRateLimitN(10, 4),
+	)
+
+	fn := func(_ context.Context) (string, error) { return "ok", nil }
+
+	for range 2 {
+		_, err := p.Do(context.Background(), fn)
+		require.NoError(t, err)
+	}
+
+	// Two tokens left, fewer than one call's cost.
+	_, err := p.Do(context.Background(), fn)
+	require.ErrorIs(t, err, ErrRateLimited)
+	require.InDelta(t, 2.0, p.rateLimiter.Stats().Available, 1e-9)
+}
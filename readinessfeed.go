@@ -0,0 +1,153 @@
+package r8e
+
+import (
+	"maps"
+	"slices"
+	"sync"
+)
+
+// Pattern: Observer — readiness subscribers are told when a registered
+// policy's health transition changes the registry's readiness verdict, instead
+// of polling CheckReadiness.
+
+type (
+	// readinessSubscribers is the set of live [Registry.Subscribe] feeds.
+	readinessSubscribers struct {
+		subs map[*readinessSub]struct{}
+		// parents are the registries that include this one
+		// ([Registry.Include]); they are notified in turn.
+		parents []*Registry
+		mu      sync.Mutex
+	}
+
+	// readinessSub is one [Registry.Subscribe] feed. Its goroutine recomputes
+	// readiness on each signal and offers it on the unbuffered out while the
+	// verdict differs from the last one delivered.
+	readinessSub struct {
+		// signal holds at most one pending recomputation, so a burst of
+		// transitions collapses into one.
+		signal chan struct{}
+		out    chan ReadinessStatus
+		stop   chan struct{}
+		done   chan struct{}
+		once   sync.Once
+	}
+)
+
+// Subscribe returns a channel that receives a new [ReadinessStatus] whenever a
+// health transition of a registered policy changes the readiness verdict —
+// Ready or its Reasons — together with a function that stops the feed.
+//
+// The verdict at the time of the call is the baseline and is not delivered;
+// read it with [Registry.CheckReadiness]. Transitions are debounced: a status
+// waiting to be received is replaced by the next recomputation, and one whose
+// verdict matches the last status delivered is dropped, so a slow reader sees
+// the latest verdict rather than every intermediate step. Circuit breaker
+// transitions, maintenance toggles ([Policy.SetMaintenance]) and registrations
+// ([Registry.Register], [Registry.Unregister], [Registry.Reset]) trigger a
+// recomputation.
+//
+// The stop function ends delivery and closes the channel before returning; it
+// is safe to call more than once.
+func (r *Registry) Subscribe() (<-chan ReadinessStatus, func()) {
+	sub := &readinessSub{
+		signal: make(chan struct{}, 1),
+		out:    make(chan ReadinessStatus),
+		stop:   make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+
+	r.readiness.mu.Lock()
+	if r.readiness.subs == nil {
+		r.readiness.subs = make(map[*readinessSub]struct{})
+	}
+
+	r.readiness.subs[sub] = struct{}{}
+	r.readiness.mu.Unlock()
+
+	go sub.run(r, r.CheckReadiness())
+
+	return sub.out, func() {
+		sub.once.Do(func() {
+			r.readiness.mu.Lock()
+			delete(r.readiness.subs, sub)
+			r.readiness.mu.Unlock()
+
+			close(sub.stop)
+		})
+
+		<-sub.done
+	}
+}
+
+// notifyReadiness asks every subscriber, and those of the registries including
+// r, to recompute readiness. It never blocks: a subscriber with a
+// recomputation already pending is skipped.
+func (r *Registry) notifyReadiness() {
+	r.readiness.mu.Lock()
+	subs := slices.Collect(maps.Keys(r.readiness.subs))
+	parents := slices.Clone(r.readiness.parents)
+	r.readiness.mu.Unlock()
+
+	for _, parent := range parents {
+		parent.notifyReadiness()
+	}
+
+	for _, sub := range subs {
+		select {
+		case sub.signal <- struct{}{}:
+		default: // a recomputation is already pending and will see this change
+		}
+	}
+}
+
+// readinessStateChangeHook chains a registry notification after next, the
+// policy's own OnCircuitStateChange hook, so a breaker transition reaches the
+// readiness subscribers.
+func (r *Registry) readinessStateChangeHook(
+	next func(from, to CircuitState),
+) func(from, to CircuitState) {
+	return func(from, to CircuitState) {
+		if next != nil {
+			next(from, to)
+		}
+
+		r.notifyReadiness()
+	}
+}
+
+// run delivers a status each time a signal changes the verdict from last, until
+// stop is closed; it then closes out. A status not yet received is replaced by
+// a later recomputation, and dropped if the verdict has meanwhile returned to
+// last.
+func (s *readinessSub) run(r *Registry, last ReadinessStatus) {
+	defer close(s.done)
+	defer close(s.out)
+
+	var (
+		pending ReadinessStatus
+		out     chan<- ReadinessStatus // nil (never ready) while nothing is pending
+	)
+
+	for {
+		select {
+		case <-s.stop:
+			return
+		case out <- pending:
+			last = pending
+			out = nil
+		case <-s.signal:
+			pending = r.CheckReadiness()
+			out = s.out
+
+			if sameReadiness(last, pending) {
+				out = nil
+			}
+		}
+	}
+}
+
+// sameReadiness reports whether a and b carry the same verdict.
+func sameReadiness(a, b ReadinessStatus) bool {
+	return a.Ready == b.Ready && slices.Equal(a.Reasons, b.Reasons)
+}
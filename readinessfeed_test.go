@@ -0,0 +1,125 @@
+package r8e
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// nextReadiness receives the next status from feed, failing the test if none
+// arrives within a second.
+func nextReadiness(t *testing.T, feed <-chan ReadinessStatus) ReadinessStatus {
+	t.Helper()
+
+	select {
+	case status, ok := <-feed:
+		require.True(t, ok, "feed closed unexpectedly")
+
+		return status
+	case <-time.After(time.Second):
+		require.FailNow(t, "no readiness status delivered")
+
+		return ReadinessStatus{}
+	}
+}
+
+func TestRegistrySubscribeDeliversTransitions(t *testing.T) {
+	t.Parallel()
+
+	reg := NewRegistry()
+	p := NewPolicy[string]("payments",
+		WithClock(&stubClock{now: time.Now()}),
+		WithRegistry(reg),
+		WithReadinessImpact(),
+		WithCircuitBreaker(FailureThreshold(1), RecoveryTimeout(time.Hour)),
+	)
+
+	feed, stop := reg.Subscribe()
+	defer stop()
+
+	openCircuit(t, p)
+
+	status := nextReadiness(t, feed)
+	assert.False(t, status.Ready)
+	assert.Equal(t, []string{"payments: circuit_open"}, status.Reasons)
+
+	p.SetMaintenance(true)
+
+	status = nextReadiness(t, feed)
+	assert.True(t, status.Ready)
+	assert.Empty(t, status.Reasons)
+}
+
+func TestRegistrySubscribeSeesIncludedTransitions(t *testing.T) {
+	t.Parallel()
+
+	parent, child := NewRegistry(), NewRegistry()
+	parent.Include(child)
+
+	p := NewPolicy[string]("payments",
+		WithClock(&stubClock{now: time.Now()}),
+		WithRegistry(child),
+		WithReadinessImpact(),
+		WithCircuitBreaker(FailureThreshold(1), RecoveryTimeout(time.Hour)),
+	)
+
+	feed, stop := parent.Subscribe()
+	defer stop()
+
+	openCircuit(t, p)
+
+	status := nextReadiness(t, feed)
+	assert.False(t, status.Ready)
+	assert.Equal(t, []string{"payments: circuit_open"}, status.Reasons)
+}
+
+func TestRegistrySubscribeSkipsUnchangedVerdict(t *testing.T) {
+	t.Parallel()
+
+	reg := NewRegistry()
+	ungated := NewPolicy[string]("ungated",
+		WithClock(&stubClock{now: time.Now()}),
+		WithRegistry(reg),
+		WithCircuitBreaker(FailureThreshold(1), RecoveryTimeout(time.Hour)),
+	)
+	gated := NewPolicy[string]("gated",
+		WithClock(&stubClock{now: time.Now()}),
+		WithRegistry(reg),
+		WithReadinessImpact(),
+		WithCircuitBreaker(FailureThreshold(1), RecoveryTimeout(time.Hour)),
+	)
+
+	feed, stop := reg.Subscribe()
+	defer stop()
+
+	// The un-gated breaker opening leaves Ready true: nothing is delivered,
+	// so the first status seen is the gated breaker's.
+	openCircuit(t, ungated)
+	openCircuit(t, gated)
+
+	status := nextReadiness(t, feed)
+	assert.False(t, status.Ready)
+	assert.Equal(t, []string{"gated: circuit_open"}, status.Reasons)
+}
+
+func TestRegistrySubscribeStopClosesFeed(t *testing.T) {
+	t.Parallel()
+
+	reg := NewRegistry()
+	feed, stop := reg.Subscribe()
+
+	stop()
+	stop() // idempotent
+
+	_, ok := <-feed
+	assert.False(t, ok, "stop must close the feed")
+
+	// A transition after stop reaches no one and must not block.
+	reg.Register(NewPolicy[string]("late",
+		WithRegistry(NewRegistry()),
+		WithReadinessImpact(),
+	))
+	reg.Reset()
+}
@@ -2,6 +2,7 @@ package r8e
 
 import (
 	"context"
+	"errors"
 	"sync"
 	"time"
 )
@@ -104,6 +105,9 @@ type (
 		staleTTL   time.Duration
 		negTTL     time.Duration
 		refreshTTL time.Duration
+		// insideBreaker moves a [WithCache] layer inside the circuit breaker
+		// (see [CacheInsideBreaker]); standalone caches ignore it.
+		insideBreaker bool
 	}
 
 	// forceRefreshKey is the private context key under which [ForceRefresh] marks
@@ -137,7 +141,11 @@ const (
 // staleTTL beyond its fresh TTL as a fallback. A call arriving in that stale
 // window re-executes to refresh the value, but if the execution fails the stale
 // value is served (and OnStaleServed fires) instead of returning the error.
-// A non-positive staleTTL leaves stale-if-error disabled.
+// In a [Policy] the cache sits outside the breaker, rate limiter, and bulkhead,
+// so their rejections ([ErrCircuitOpen], [ErrRateLimited], [ErrBulkheadFull],
+// ...) serve stale exactly like a downstream failure — unless
+// [CacheInsideBreaker] moves it behind the breaker. A non-positive staleTTL
+// leaves stale-if-error disabled.
 func StaleIfError(staleTTL time.Duration) CacheOption {
 	return func(o *cacheOptions) {
 		o.staleTTL = staleTTL
@@ -182,6 +190,23 @@ func RefreshAhead(refreshTTL time.Duration) CacheOption {
 	}
 }
 
+// CacheInsideBreaker places a [WithCache] layer just inside the circuit breaker
+// instead of outside it. By default the cache is checked before the breaker, so
+// an open breaker still serves fresh hits and (with [StaleIfError]) stale
+// values. Inside the breaker, an open circuit fast-fails with [ErrCircuitOpen]
+// before the cache is even consulted, sparing the cache lookups during an
+// overload.
+//
+// The breaker then sees the cache's result rather than the downstream's: a hit,
+// or a stale value served over a failure, records as a success, so only
+// failures the cache cannot cover move the breaker toward open. The option has
+// no effect on a standalone [NewReadThroughCache].
+func CacheInsideBreaker() CacheOption {
+	return func(o *cacheOptions) {
+		o.insideBreaker = true
+	}
+}
+
 // CacheClock sets the [Clock] a [ReadThroughCache] uses to measure freshness.
 // It defaults to [RealClock]; a nil clock is ignored. Within a [Policy] the
 // policy's clock (see [WithClock]) is injected automatically, so this is for
@@ -209,8 +234,10 @@ func CacheHooks(h *Hooks) CacheOption {
 // NegativeCache enables negative caching: when an execution fails and no stale
 // value is available to serve, the error is cached for negTTL so subsequent
 // calls for the same key fast-fail with the recorded error instead of
-// re-executing. Keep negTTL short — it suppresses recovery for that long. A
-// non-positive negTTL leaves negative caching disabled.
+// re-executing. Keep negTTL short — it suppresses recovery for that long.
+// Rejections by a policy's own admission layers (an open breaker, a rate
+// limiter, a full bulkhead, a load shedder) are not cached: they describe the
+// policy, not the key. A non-positive negTTL leaves negative caching disabled.
 func NegativeCache(negTTL time.Duration) CacheOption {
 	return func(o *cacheOptions) {
 		o.negTTL = negTTL
@@ -284,6 +311,7 @@ func (rc *ReadThroughCache[T]) Do(
 
 	var (
 		staleValue T
+		staleAt    time.Time
 		haveStale  bool
 	)
 
@@ -291,21 +319,24 @@ func (rc *ReadThroughCache[T]) Do(
 		switch entry, state := rc.classify(key); state {
 		case entryNegativeHit: // valid negative entry: fast-fail
 			rc.hooks.emitCacheHit()
+			markTrace(ctx, traceCacheHit)
 
 			var zero T
 
 			return zero, entry.err //nolint:wrapcheck // recorded error replayed as-is
 		case entryFresh: // fresh success: short-circuit
 			rc.hooks.emitCacheHit()
+			markTrace(ctx, traceCacheHit)
 
 			return entry.value, nil
 		case entryRefreshAhead: // fresh but ageing: serve now, refresh in background
 			rc.hooks.emitCacheHit()
+			markTrace(ctx, traceCacheHit)
 			rc.triggerRefresh(ctx, key, next)
 
 			return entry.value, nil
 		case entryStale: // stale success: revalidate with stale fallback
-			staleValue, haveStale = entry.value, true
+			staleValue, staleAt, haveStale = entry.value, entry.storedAt, true
 		default: // entryMiss: no usable entry — fall through to execute and populate
 		}
 	}
@@ -320,11 +351,12 @@ func (rc *ReadThroughCache[T]) Do(
 
 		return result, nil
 	case haveStale:
-		rc.hooks.emitStaleServed()
+		rc.hooks.emitStaleServed(rc.clock.Since(staleAt))
+		markTrace(ctx, traceDegraded|traceStale)
 
 		return staleValue, nil
 	default:
-		if rc.negTTL > 0 {
+		if rc.negTTL > 0 && !isAdmissionRejection(err) {
 			rc.storeNegative(key, err)
 		}
 
@@ -381,6 +413,25 @@ func (rc *ReadThroughCache[T]) store(key string, result T) {
 	rc.hooks.emitCacheStored()
 }
 
+// isAdmissionRejection reports whether err is a policy's own admission layer
+// turning the call away — breaker, rate limiter, bulkhead, or a load shedder —
+// rather than the downstream failing. Such a rejection still falls back to a
+// stale value, but says nothing about the key, so it is never negatively
+// cached: replaying it would keep failing the key after the layer recovers.
+func isAdmissionRejection(err error) bool {
+	for _, rejection := range [...]error{
+		ErrCircuitOpen, ErrCircuitRamping, ErrRateLimited, ErrBulkheadFull,
+		ErrBulkheadTimeout, ErrCoDelShed, ErrConcurrencyLimited, ErrThrottled,
+		ErrSLOShed, ErrLoadShed,
+	} {
+		if errors.Is(err, rejection) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // storeNegative caches a failed execution for the negative TTL so subsequent
 // calls for key fast-fail with err. Only reached when negative caching is
 // enabled and no stale value was available.
@@ -73,6 +73,7 @@ func (c *memCache[V]) lastTTL(key string) time.Duration {
 // fired without caring about ordering.
 type cacheCounters struct {
 	hits, misses, stores, stale, refreshed atomic.Int64
+	staleAge                               atomic.Int64
 }
 
 func (c *cacheCounters) hooks() *Hooks {
@@ -81,6 +82,7 @@ func (c *cacheCounters) hooks() *Hooks {
 		OnCacheMiss:      func() { c.misses.Add(1) },
 		OnCacheStored:    func() { c.stores.Add(1) },
 		OnStaleServed:    func() { c.stale.Add(1) },
+		OnStaleServedAge: func(age time.Duration) { c.staleAge.Store(int64(age)) },
 		OnCacheRefreshed: func() { c.refreshed.Add(1) },
 	}
 }
@@ -226,6 +228,7 @@ func TestReadThroughStaleServedOnError(t *testing.T) {
 	assert.Equal(t, int64(2), calls.Load(), "stale revalidation executes next")
 	assert.Equal(t, int64(2), ctr.misses.Load(), "stale revalidation counts as a miss")
 	assert.Equal(t, int64(1), ctr.stale.Load())
+	assert.Equal(t, cacheTTL+time.Minute, time.Duration(ctr.staleAge.Load()))
 }
 
 func TestReadThroughStaleRevalidationSuccessRefreshes(t *testing.T) {
@@ -1053,3 +1056,204 @@ func TestPolicyCacheRefreshAhead(t *testing.T) {
 	assert.Equal(t, "v2", got, "the refreshed value is served on the next read")
 	assert.Equal(t, int64(2), calls.Load(), "miss + one background reload")
 }
+
+// ---------------------------------------------------------------------------
+// Admission rejections: serve stale, never cache negatively
+// ---------------------------------------------------------------------------
+
+func TestWithCacheServesStaleWhenCircuitOpen(t *testing.T) {
+	t.Parallel()
+
+	clk := newPolicyClock()
+
+	var opened atomic.Int64
+
+	p := NewPolicy[string](
+		"",
+		WithClock(clk),
+		WithHooks(&Hooks{OnCircuitOpen: func() { opened.Add(1) }}),
+		WithCache(
+			newMemCache[CacheEntry[string]](), func(context.Context) string { return "k" },
+			cacheTTL, StaleIfError(time.Hour),
+		),
+		WithCircuitBreaker(FailureThreshold(1), RecoveryTimeout(time.Hour)),
+	)
+
+	var calls atomic.Int64
+
+	_, err := p.Do(context.Background(), constFn("v", &calls))
+	require.NoError(t, err)
+
+	clk.advance(cacheTTL + time.Minute) // stale
+
+	// The failure trips the breaker; the stale value covers it.
+	got, err := p.Do(context.Background(), errFn(errDownstream, &calls))
+	require.NoError(t, err)
+	assert.Equal(t, "v", got)
+	require.Equal(t, int64(1), opened.Load())
+
+	// The open breaker now rejects before fn runs: stale still wins over
+	// ErrCircuitOpen.
+	got, err = p.Do(context.Background(), constFn("fresh", &calls))
+	require.NoError(t, err)
+	assert.Equal(t, "v", got)
+	assert.Equal(t, int64(2), calls.Load(), "the open breaker kept fn from running")
+}
+
+func TestWithCacheServesStaleWhenRateLimited(t *testing.T) {
+	t.Parallel()
+
+	clk := newPolicyClock()
+
+	var limited atomic.Int64
+
+	p := NewPolicy[string](
+		"",
+		WithClock(clk),
+		WithHooks(&Hooks{OnRateLimited: func() { limited.Add(1) }}),
+		WithCache(
+			newMemCache[CacheEntry[string]](), func(context.Context) string { return "k" },
+			cacheTTL, StaleIfError(time.Hour),
+		),
+		WithRateLimit(1), // a one-token bucket
+	)
+
+	var calls atomic.Int64
+
+	_, err := p.Do(context.Background(), constFn("v", &calls))
+	require.NoError(t, err)
+
+	clk.advance(cacheTTL + time.Minute) // stale; the bucket refills to one token
+
+	// Revalidation spends the token and fails: stale served.
+	_, err = p.Do(context.Background(), errFn(errDownstream, &calls))
+	require.NoError(t, err)
+
+	// The bucket is empty: the limiter rejects, and stale wins over
+	// ErrRateLimited.
+	got, err := p.Do(context.Background(), constFn("fresh", &calls))
+	require.NoError(t, err)
+	assert.Equal(t, "v", got)
+	assert.Equal(t, int64(1), limited.Load())
+	assert.Equal(t, int64(2), calls.Load())
+}
+
+func TestWithCacheOutsideBreakerServesHitWhenOpen(t *testing.T) {
+	t.Parallel()
+
+	var hits atomic.Int64
+
+	p := NewPolicy[string](
+		"",
+		WithClock(newPolicyClock()),
+		WithHooks(&Hooks{OnCacheHit: func() { hits.Add(1) }}),
+		WithCache(newMemCache[CacheEntry[string]](), keyFromCtx, cacheTTL),
+		WithCircuitBreaker(FailureThreshold(1), RecoveryTimeout(time.Hour)),
+	)
+
+	keyed := context.WithValue(context.Background(), testKey{}, "k")
+
+	var calls atomic.Int64
+
+	_, err := p.Do(keyed, constFn("v", &calls))
+	require.NoError(t, err)
+
+	// An uncached failure trips the breaker.
+	_, err = p.Do(context.Background(), errFn(errDownstream, &calls))
+	require.ErrorIs(t, err, errDownstream)
+
+	// The cache is checked first: the fresh hit is served despite the open
+	// circuit.
+	got, err := p.Do(keyed, constFn("fresh", &calls))
+	require.NoError(t, err)
+	assert.Equal(t, "v", got)
+	assert.Equal(t, int64(1), hits.Load())
+	assert.Equal(t, int64(2), calls.Load())
+}
+
+func TestWithCacheInsideBreakerFastFailsBeforeLookup(t *testing.T) {
+	t.Parallel()
+
+	var hits atomic.Int64
+
+	p := NewPolicy[string](
+		"",
+		WithClock(newPolicyClock()),
+		WithHooks(&Hooks{OnCacheHit: func() { hits.Add(1) }}),
+		WithCache(
+			newMemCache[CacheEntry[string]](), keyFromCtx, cacheTTL,
+			CacheInsideBreaker(),
+		),
+		WithCircuitBreaker(FailureThreshold(1), RecoveryTimeout(time.Hour)),
+	)
+
+	keyed := context.WithValue(context.Background(), testKey{}, "k")
+
+	var calls atomic.Int64
+
+	_, err := p.Do(keyed, constFn("v", &calls))
+	require.NoError(t, err)
+
+	// The closed breaker lets hits through.
+	got, err := p.Do(keyed, constFn("fresh", &calls))
+	require.NoError(t, err)
+	assert.Equal(t, "v", got)
+	require.Equal(t, int64(1), hits.Load())
+
+	// An uncached failure trips the breaker.
+	_, err = p.Do(context.Background(), errFn(errDownstream, &calls))
+	require.ErrorIs(t, err, errDownstream)
+
+	// The open breaker rejects before the cache is consulted.
+	_, err = p.Do(keyed, constFn("fresh", &calls))
+	require.ErrorIs(t, err, ErrCircuitOpen)
+	assert.Equal(t, int64(1), hits.Load(), "no lookup while the circuit is open")
+	assert.Equal(t, int64(2), calls.Load())
+}
+
+func TestWithCacheInsideBreakerPatternOrder(t *testing.T) {
+	t.Parallel()
+
+	build := func(opts ...CacheOption) []string {
+		return NewPolicy[string](
+			"",
+			WithCache(newMemCache[CacheEntry[string]](), keyFromCtx, cacheTTL, opts...),
+			WithCircuitBreaker(),
+			WithRateLimit(10),
+		).Patterns()
+	}
+
+	assert.Equal(t, []string{"cache", "circuit_breaker", "rate_limiter"}, build())
+	assert.Equal(t,
+		[]string{"circuit_breaker", "cache", "rate_limiter"},
+		build(CacheInsideBreaker()),
+	)
+}
+
+func TestReadThroughCacheDoesNotNegativeCacheRejections(t *testing.T) {
+	t.Parallel()
+
+	for _, rejection := range []error{
+		ErrCircuitOpen, ErrRateLimited, ErrBulkheadFull, ErrLoadShed,
+	} {
+		t.Run(rejection.Error(), func(t *testing.T) {
+			t.Parallel()
+
+			rc := newStringRTC(
+				newMemCache[CacheEntry[string]](), newPolicyClock(), &Hooks{},
+				NegativeCache(time.Minute),
+			)
+
+			var calls atomic.Int64
+
+			_, err := rc.Do(context.Background(), "k", errFn(rejection, &calls))
+			require.ErrorIs(t, err, rejection)
+
+			// Not replayed: the next call executes and succeeds.
+			got, err := rc.Do(context.Background(), "k", constFn("v", &calls))
+			require.NoError(t, err)
+			assert.Equal(t, "v", got)
+			assert.Equal(t, int64(2), calls.Load())
+		})
+	}
+}
@@ -64,6 +64,10 @@ func (p *Policy[T]) Reconfigure(cfg PolicyConfig) error { //nolint:gocritic // v
 	defer p.reconfigureMu.Unlock()
 
 	// Phase 1 — validate everything into deferred apply actions; no mutation.
+	if err := ValidateConfig(&cfg); err != nil {
+		return err
+	}
+
 	var actions []func()
 
 	timeoutActions, timeoutErr := p.timeoutReconfigureActions(&cfg)
@@ -94,7 +98,9 @@ func (p *Policy[T]) Reconfigure(cfg PolicyConfig) error { //nolint:gocritic // v
 
 		rate := *cfg.RateLimit
 
-		actions = append(actions, func() { p.rateLimiter.Reconfigure(rate) })
+		actions = append(actions, func() {
+			p.rateLimiters.apply(settingRate, func(rl *RateLimiter) { rl.Reconfigure(rate) })
+		})
 	}
 
 	if cfg.AIMD != nil {
@@ -120,7 +126,11 @@ func (p *Policy[T]) Reconfigure(cfg PolicyConfig) error { //nolint:gocritic // v
 
 		actions = append(
 			actions,
-			func() { p.bulkhead.Reconfigure(slots, bhOpts...) },
+			func() {
+				p.bulkheads.apply(settingBulkhead, func(bh *Bulkhead) {
+					bh.Reconfigure(slots, bhOpts...)
+				})
+			},
 		)
 	} else if cfg.hasAnyBulkheadWaitSetting() {
 		// Wait settings without a bulkhead have nothing to apply to — reject the
@@ -236,7 +246,11 @@ func (p *Policy[T]) aimdReconfigureAction(cfg *AIMDConfig) (func(), error) {
 		return nil, fmt.Errorf("r8e: reconfigure: %w", err)
 	}
 
-	return func() { p.rateLimiter.aimd.reconfigure(aimdOpts...) }, nil
+	return func() {
+		p.rateLimiters.apply(settingAIMD, func(rl *RateLimiter) {
+			rl.aimd.reconfigure(aimdOpts...)
+		})
+	}, nil
 }
 
 // sloReconfigureAction validates the SLO governor config overlay and returns the
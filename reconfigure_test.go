@@ -141,6 +141,33 @@ func TestSlowCallConfigRoundTrip(t *testing.T) {
 	assert.Equal(t, 5, p.circuitBreaker.cfg.slowCallMinCalls)
 }
 
+// TestErrorRateConfigRoundTrip checks the error-rate fields build a policy
+// whose breaker has error-rate tripping enabled, and that a bad window is
+// reported against its field.
+func TestErrorRateConfigRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	opts, err := BuildOptions(&PolicyConfig{
+		CircuitBreaker: &CircuitBreakerConfig{
+			ErrorThresholdPercentage: intPtr(40),
+			RequestVolumeThreshold:   intPtr(50),
+			ErrorRateWindow:          strPtr("30s"),
+		},
+	})
+	require.NoError(t, err)
+
+	p := NewPolicy[string]("from-config", opts...)
+	assert.True(t, p.circuitBreaker.errorRateEnabled())
+	assert.Equal(t, 40, p.circuitBreaker.cfg.errorThresholdPercentage)
+	assert.Equal(t, 50, p.circuitBreaker.cfg.requestVolumeThreshold)
+	assert.Equal(t, 30*time.Second, p.circuitBreaker.cfg.errorRateWindow)
+
+	_, err = BuildOptions(&PolicyConfig{
+		CircuitBreaker: &CircuitBreakerConfig{ErrorRateWindow: strPtr("nope")},
+	})
+	assert.ErrorContains(t, err, "error_rate_window")
+}
+
 // TestBulkheadWaitConfigRoundTrip builds a policy with the bounded-wait fields
 // and confirms the bulkhead carries them.
 func TestBulkheadWaitConfigRoundTrip(t *testing.T) {
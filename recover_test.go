@@ -248,6 +248,38 @@ func TestPolicyWithRecoverInsideRetry(t *testing.T) {
 	assert.Equal(t, 3, attempts, "should have retried after panics")
 }
 
+// TestPolicyWithRecoverReleasesBulkheadSlot verifies that recovery runs inside
+// the bulkhead, so a panicking call gives its slot back for the next one.
+func TestPolicyWithRecoverReleasesBulkheadSlot(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	var panics []any
+
+	p := r8e.NewPolicy[string]("test-recover-bulkhead",
+		r8e.WithHooks(&r8e.Hooks{
+			OnPanic: func(v any) { panics = append(panics, v) },
+		}),
+		r8e.WithBulkhead(1),
+		r8e.WithRecover(),
+	)
+
+	_, err := p.Do(ctx, func(_ context.Context) (string, error) {
+		panic("bulkhead panic")
+	})
+
+	require.ErrorIs(t, err, r8e.ErrPanic)
+	assert.Equal(t, []any{"bulkhead panic"}, panics)
+
+	got, err := p.Do(ctx, func(_ context.Context) (string, error) {
+		return "ok", nil
+	})
+
+	require.NoError(t, err, "the only slot must be free again")
+	assert.Equal(t, "ok", got)
+}
+
 func TestPolicyWithRecoverNoRecoverWithoutOption(t *testing.T) {
 	t.Parallel()
 
@@ -1,6 +1,7 @@
 package r8e
 
 import (
+	"slices"
 	"sync"
 	"sync/atomic"
 )
@@ -13,7 +14,21 @@ type (
 	// ReadinessStatus is the result of checking all registered policies.
 	ReadinessStatus struct {
 		Policies []PolicyStatus `json:"policies"`
-		Ready    bool           `json:"ready"`
+		// Reasons names each policy that made Ready false, as "name: state"
+		// (e.g. "payments: circuit_open"), in registration order, so an alert
+		// can carry the cause directly. Empty when Ready is true.
+		Reasons []string `json:"reasons,omitempty"`
+		Ready   bool     `json:"ready"`
+	}
+
+	// LivenessStatus is the result of [Registry.CheckLiveness]. It deliberately
+	// ignores policy health: a tripped breaker means a dependency is down, not
+	// that this process is, so it must never make Kubernetes restart the pod.
+	LivenessStatus struct {
+		// Alive is true while the registry is initialized and answering.
+		Alive bool `json:"alive"`
+		// Policies is the number of registered policies (informational).
+		Policies int `json:"policies"`
 	}
 
 	// HealthReport is the aggregate health of all registered policies. Unlike
@@ -36,8 +51,13 @@ type (
 	// Pattern: Singleton — DefaultRegistry uses sync.OnceValue for safe lazy
 	// init;
 	// explicit registries can be created for testing or multi-tenant scenarios.
+	//
+	// Pattern: Composite — a registry can [Registry.Include] child registries,
+	// so one per subsystem can roll up into an aggregate view.
 	Registry struct {
 		reporters atomic.Pointer[[]HealthReporter]
+		children  atomic.Pointer[[]*Registry]
+		readiness readinessSubscribers
 		mu        sync.Mutex
 	}
 )
@@ -70,7 +90,6 @@ func NewRegistry() *Registry {
 // It is safe for concurrent use but intended for initialization only.
 func (r *Registry) Register(hr HealthReporter) {
 	r.mu.Lock()
-	defer r.mu.Unlock()
 
 	old := *r.reporters.Load()
 	// Copy-on-write. The capacity MUST equal len(old): a concurrent reader holds
@@ -81,14 +100,244 @@ func (r *Registry) Register(hr HealthReporter) {
 	copy(updated, old)
 	updated = append(updated, hr)
 	r.reporters.Store(&updated)
+	r.mu.Unlock()
+
+	r.notifyReadiness()
+}
+
+// Unregister removes every reporter registered under name (policy names need
+// not be unique) and reports whether any was removed. A process that creates
+// transient policies can call it when one is retired, so the registry does not
+// grow without bound. It is safe for concurrent use; a check already in
+// progress finishes against the reporters it started with.
+func (r *Registry) Unregister(name string) bool {
+	r.mu.Lock()
+
+	old := *r.reporters.Load()
+
+	// Copy-on-write, as in Register: readers may still iterate old.
+	kept := make([]HealthReporter, 0, len(old))
+	for _, hr := range old {
+		if hr.Name() != name {
+			kept = append(kept, hr)
+		}
+	}
+
+	if len(kept) == len(old) {
+		r.mu.Unlock()
+
+		return false
+	}
+
+	r.reporters.Store(&kept)
+	r.mu.Unlock()
+
+	r.notifyReadiness()
+
+	return true
+}
+
+// Reset removes every reporter and every [Registry.Include] link — the
+// registries r includes and those including r — returning the registry to its
+// freshly created state; live [Registry.Subscribe] feeds are kept and see the
+// change. It is safe for concurrent use with [Registry.CheckReadiness] and the
+// other checks, which see either the old set or the empty one. Tests that must
+// use [DefaultRegistry] can Reset it in a cleanup; prefer an explicit
+// [NewRegistry] passed with [WithRegistry], which keeps tests from sharing
+// state at all.
+func (r *Registry) Reset() {
+	includeMu.Lock()
+
+	r.mu.Lock()
+
+	var empty []HealthReporter
+
+	r.reporters.Store(&empty)
+
+	var children []*Registry
+	if cur := r.children.Swap(nil); cur != nil {
+		children = *cur
+	}
+
+	r.mu.Unlock()
+
+	for _, child := range children {
+		child.readiness.mu.Lock()
+		child.readiness.parents = slices.DeleteFunc(
+			child.readiness.parents,
+			func(parent *Registry) bool { return parent == r },
+		)
+		child.readiness.mu.Unlock()
+	}
+
+	r.readiness.mu.Lock()
+	parents := r.readiness.parents
+	r.readiness.parents = nil
+	r.readiness.mu.Unlock()
+
+	for _, parent := range parents {
+		parent.exclude(r)
+	}
+
+	includeMu.Unlock()
+
+	r.notifyReadiness()
+
+	for _, parent := range parents {
+		parent.notifyReadiness()
+	}
+}
+
+// exclude drops child from r's included registries. Caller must hold
+// includeMu.
+func (r *Registry) exclude(child *Registry) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	cur := r.children.Load()
+	if cur == nil {
+		return
+	}
+
+	// Copy-on-write, as in Include: readers may still iterate the old slice.
+	kept := slices.DeleteFunc(slices.Clone(*cur), func(c *Registry) bool {
+		return c == child
+	})
+	if len(kept) == 0 {
+		r.children.Store(nil)
+
+		return
+	}
+
+	r.children.Store(&kept)
+}
+
+// Include makes child part of r's aggregate view: from now on
+// [Registry.CheckReadiness], [Registry.CheckLiveness], [Registry.Health],
+// [Registry.Snapshot] and [Registry.AllStats] on r cover the policies
+// registered with child — and with the registries child includes — after r's
+// own, and r's [Registry.Subscribe] feeds see child's transitions. child keeps
+// working on its own, so each subsystem can expose its own probe while r
+// reports on all of them. [Registry.Register], [Registry.Unregister] and
+// [Registry.Reconfigure] act on r's own policies only; [Registry.Reset] also
+// drops r's include links.
+//
+// Including a registry twice, or r itself, has no further effect. It panics if
+// child is nil or already includes r, which would make the view a cycle. It is
+// safe for concurrent use.
+func (r *Registry) Include(child *Registry) {
+	if child == nil {
+		panic("r8e: Include of a nil registry")
+	}
+
+	includeMu.Lock()
+	defer includeMu.Unlock()
+
+	if child == r || r.includes(child) {
+		return
+	}
+
+	if child.includes(r) {
+		panic("r8e: Include would make a registry include itself")
+	}
+
+	r.mu.Lock()
+
+	var old []*Registry
+	if cur := r.children.Load(); cur != nil {
+		old = *cur
+	}
+
+	// Copy-on-write, as in Register: readers may still iterate old.
+	updated := make([]*Registry, len(old), len(old)+1)
+	copy(updated, old)
+	updated = append(updated, child)
+	r.children.Store(&updated)
+	r.mu.Unlock()
+
+	child.readiness.mu.Lock()
+	child.readiness.parents = append(child.readiness.parents, r)
+	child.readiness.mu.Unlock()
+
+	r.notifyReadiness()
+}
+
+//nolint:gochecknoglobals // serializes Include so concurrent calls cannot close a cycle, by design
+var includeMu sync.Mutex
+
+// includes reports whether target is reachable from r through included
+// registries.
+func (r *Registry) includes(target *Registry) bool {
+	children := r.children.Load()
+	if children == nil {
+		return false
+	}
+
+	for _, child := range *children {
+		if child == target || child.includes(target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// allReporters returns r's reporters followed by those of every registry it
+// includes, each registry visited once. Without included registries it is the
+// atomic snapshot itself, so the common case allocates nothing.
+func (r *Registry) allReporters() []HealthReporter {
+	if r.children.Load() == nil {
+		return *r.reporters.Load()
+	}
+
+	var out []HealthReporter
+
+	r.collectReporters(&out, make(map[*Registry]struct{}))
+
+	return out
+}
+
+// collectReporters appends the reporters of r and its included registries not
+// yet in seen to out.
+func (r *Registry) collectReporters(
+	out *[]HealthReporter,
+	seen map[*Registry]struct{},
+) {
+	if _, ok := seen[r]; ok {
+		return
+	}
+
+	seen[r] = struct{}{}
+
+	if reporters := r.reporters.Load(); reporters != nil {
+		*out = append(*out, *reporters...)
+	}
+
+	if children := r.children.Load(); children != nil {
+		for _, child := range *children {
+			child.collectReporters(out, seen)
+		}
+	}
 }
 
 // CheckReadiness iterates all registered reporters and builds a
 // ReadinessStatus. Ready is false only when a policy that opted into readiness
 // impact (WithReadinessImpact) is critically down — a critically unhealthy
-// policy that did not opt in is reported but does not gate traffic.
+// policy that did not opt in is reported but does not gate traffic. Each policy
+// that makes Ready false is listed in Reasons.
 func (r *Registry) CheckReadiness() ReadinessStatus {
-	reporters := *r.reporters.Load()
+	return r.CheckReadinessFiltered(CriticalityNone)
+}
+
+// CheckReadinessFiltered is [Registry.CheckReadiness] with Policies narrowed to
+// those whose current Criticality is at least minimum — pass
+// CriticalityDegraded for a diagnostics view of every impaired policy. Ready
+// and Reasons are computed over all registered policies exactly as
+// CheckReadiness computes them, so a filter never changes the verdict, and a
+// degraded policy never makes Ready false. It reads the same atomic snapshot
+// of the reporters and is safe for concurrent use.
+func (r *Registry) CheckReadinessFiltered(minimum Criticality) ReadinessStatus {
+	reporters := r.allReporters()
 
 	status := ReadinessStatus{
 		Ready:    true,
@@ -97,24 +346,44 @@ func (r *Registry) CheckReadiness() ReadinessStatus {
 
 	for _, hr := range reporters {
 		ps := hr.HealthStatus()
-		status.Policies = append(status.Policies, ps)
+		if ps.Criticality >= minimum {
+			status.Policies = append(status.Policies, ps)
+		}
 
 		// Only a policy that opted into readiness impact (WithReadinessImpact)
 		// removes the pod from rotation — a critically unhealthy policy without
 		// it is reported but does not gate traffic.
 		if ps.AffectsReadiness && ps.criticallyDown() {
 			status.Ready = false
+			status.Reasons = append(status.Reasons, ps.Name+": "+string(ps.State))
 		}
 	}
 
 	return status
 }
 
+// CheckLiveness reports whether the process — as seen through the registry —
+// is responsive. It never inspects policy health, so it stays alive with every
+// breaker open; only an uninitialized registry (nil, or a zero value not built
+// by [NewRegistry]) reports not alive. Wire it to the Kubernetes liveness
+// probe and [Registry.CheckReadiness] to the readiness probe.
+func (r *Registry) CheckLiveness() LivenessStatus {
+	if r == nil {
+		return LivenessStatus{}
+	}
+
+	if r.reporters.Load() == nil {
+		return LivenessStatus{}
+	}
+
+	return LivenessStatus{Alive: true, Policies: len(r.allReporters())}
+}
+
 // Health returns the aggregate health of all registered policies. It always
 // reports the full picture and never gates traffic; wire it to an
 // informational endpoint, not the Kubernetes readiness probe.
 func (r *Registry) Health() HealthReport {
-	reporters := *r.reporters.Load()
+	reporters := r.allReporters()
 
 	report := HealthReport{
 		Policies: make([]PolicyStatus, 0, len(reporters)),
@@ -148,7 +417,7 @@ func (r *Registry) Health() HealthReport {
 // metrics. It is safe for concurrent use and takes no locks on the read path
 // (the reporter list is read via an atomic snapshot).
 func (r *Registry) Snapshot() []PolicyMetrics {
-	reporters := *r.reporters.Load()
+	reporters := r.allReporters()
 
 	out := make([]PolicyMetrics, 0, len(reporters))
 
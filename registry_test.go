@@ -3,6 +3,7 @@ package r8e
 import (
 	"context"
 	"errors"
+	"log/slog"
 	"sync"
 	"testing"
 	"time"
@@ -269,6 +270,206 @@ func TestRegistryConcurrentRegisterAndRead(t *testing.T) {
 	require.Len(t, status.Policies, 10)
 }
 
+// ---------------------------------------------------------------------------
+// TestRegistryUnregister / TestRegistryReset — removing reporters
+// ---------------------------------------------------------------------------
+
+func TestRegistryUnregister(t *testing.T) {
+	t.Parallel()
+
+	reg := NewRegistry()
+	clk := newPolicyClock()
+
+	for _, name := range []string{"orders", "transient", "billing", "transient"} {
+		_ = NewPolicy[string](name, WithClock(clk), WithRegistry(reg))
+	}
+
+	require.True(t, reg.Unregister("transient"), "every reporter with the name goes")
+	require.False(t, reg.Unregister("transient"))
+	require.False(t, reg.Unregister("unknown"))
+
+	var names []string
+	for _, ps := range reg.CheckReadiness().Policies {
+		names = append(names, ps.Name)
+	}
+
+	require.Equal(t, []string{"orders", "billing"}, names)
+}
+
+// policyNames returns the names of the policies in status, in order.
+func policyNames(status ReadinessStatus) []string {
+	names := make([]string, 0, len(status.Policies))
+	for _, ps := range status.Policies {
+		names = append(names, ps.Name)
+	}
+
+	return names
+}
+
+func TestRegistryIncludeAggregates(t *testing.T) {
+	t.Parallel()
+
+	clk := newPolicyClock()
+	parent, payments, inventory := NewRegistry(), NewRegistry(), NewRegistry()
+
+	_ = NewPolicy[string]("gateway", WithClock(clk), WithRegistry(parent))
+	_ = NewPolicy[string]("payments", WithClock(clk), WithRegistry(payments))
+	_ = NewPolicy[string]("inventory", WithClock(clk), WithRegistry(inventory))
+
+	parent.Include(payments)
+	parent.Include(inventory)
+	parent.Include(payments) // no duplicate
+	parent.Include(parent)   // no-op
+
+	assert.Equal(t, []string{"gateway", "payments", "inventory"},
+		policyNames(parent.CheckReadiness()))
+	assert.Equal(t, []string{"payments"}, policyNames(payments.CheckReadiness()))
+	assert.Equal(t, 3, parent.CheckLiveness().Policies)
+	assert.Len(t, parent.Health().Policies, 3)
+	assert.Len(t, parent.Snapshot(), 3)
+	assert.Len(t, parent.AllStats(), 3)
+
+	// A policy registered with a child later shows up in the aggregate.
+	_ = NewPolicy[string]("refunds", WithClock(clk), WithRegistry(payments))
+	assert.Equal(t, []string{"gateway", "payments", "refunds", "inventory"},
+		policyNames(parent.CheckReadiness()))
+}
+
+func TestRegistryIncludeNestedOnce(t *testing.T) {
+	t.Parallel()
+
+	clk := newPolicyClock()
+	root, mid, leaf := NewRegistry(), NewRegistry(), NewRegistry()
+
+	_ = NewPolicy[string]("leaf", WithClock(clk), WithRegistry(leaf))
+
+	mid.Include(leaf)
+	root.Include(mid)
+	root.Include(leaf) // reachable twice, reported once
+
+	assert.Equal(t, []string{"leaf"}, policyNames(root.CheckReadiness()))
+	assert.PanicsWithValue(t, "r8e: Include would make a registry include itself",
+		func() { leaf.Include(root) })
+	assert.PanicsWithValue(t, "r8e: Include of a nil registry",
+		func() { root.Include(nil) })
+}
+
+func TestRegistryIncludeReadiness(t *testing.T) {
+	t.Parallel()
+
+	parent, child := NewRegistry(), NewRegistry()
+	parent.Include(child)
+
+	p := NewPolicy[string]("payments",
+		WithClock(newPolicyClock()),
+		WithRegistry(child),
+		WithReadinessImpact(),
+		WithCircuitBreaker(FailureThreshold(1), RecoveryTimeout(time.Hour)),
+	)
+	openCircuit(t, p)
+
+	status := parent.CheckReadiness()
+	assert.False(t, status.Ready)
+	assert.Equal(t, []string{"payments: circuit_open"}, status.Reasons)
+	assert.Equal(t, HealthUnhealthy, parent.Health().Status)
+}
+
+func TestRegistryReset(t *testing.T) {
+	t.Parallel()
+
+	reg := NewRegistry()
+	clk := newPolicyClock()
+
+	for _, name := range []string{"a", "b", "c"} {
+		_ = NewPolicy[string](name,
+			WithClock(clk),
+			WithRegistry(reg),
+			WithCircuitBreaker(),
+		)
+	}
+
+	require.Len(t, reg.CheckReadiness().Policies, 3)
+
+	reg.Reset()
+
+	status := reg.CheckReadiness()
+	require.Empty(t, status.Policies)
+	require.True(t, status.Ready)
+	require.Empty(t, reg.Snapshot())
+	require.ErrorIs(t, reg.Reconfigure("a", PolicyConfig{}), ErrPolicyNotRegistered)
+
+	// The registry stays usable after a reset.
+	_ = NewPolicy[string]("d", WithClock(clk), WithRegistry(reg))
+	require.Len(t, reg.CheckReadiness().Policies, 1)
+}
+
+func TestRegistryResetDropsIncludes(t *testing.T) {
+	t.Parallel()
+
+	clk := newPolicyClock()
+	root, mid, leaf := NewRegistry(), NewRegistry(), NewRegistry()
+
+	_ = NewPolicy[string]("mid", WithClock(clk), WithRegistry(mid))
+	_ = NewPolicy[string]("leaf", WithClock(clk), WithRegistry(leaf))
+
+	root.Include(mid)
+	mid.Include(leaf)
+	require.Equal(t, []string{"mid", "leaf"}, policyNames(root.CheckReadiness()))
+
+	mid.Reset()
+
+	// mid no longer includes leaf nor is included by root, as when new.
+	assert.Nil(t, mid.children.Load())
+	assert.Nil(t, root.children.Load())
+	assert.Empty(t, leaf.readiness.parents)
+	assert.Empty(t, mid.readiness.parents)
+
+	_ = NewPolicy[string]("mid-again", WithClock(clk), WithRegistry(mid))
+	assert.Empty(t, policyNames(root.CheckReadiness()))
+	assert.Equal(t, []string{"mid-again"}, policyNames(mid.CheckReadiness()))
+	assert.Equal(t, []string{"leaf"}, policyNames(leaf.CheckReadiness()))
+
+	// The former links can be made again, either way round.
+	leaf.Include(mid)
+	assert.Equal(t, []string{"leaf", "mid-again"}, policyNames(leaf.CheckReadiness()))
+}
+
+func TestRegistryResetConcurrentWithReadiness(t *testing.T) {
+	t.Parallel()
+
+	reg := NewRegistry()
+	clk := newPolicyClock()
+
+	var wg sync.WaitGroup
+
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 100 {
+				assert.True(t, reg.CheckReadiness().Ready)
+			}
+		}()
+	}
+
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 10 {
+				_ = NewPolicy[int]("churn", WithClock(clk), WithRegistry(reg))
+				reg.Unregister("churn")
+				reg.Reset()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	reg.Reset()
+	require.Empty(t, reg.CheckReadiness().Policies)
+}
+
 // ---------------------------------------------------------------------------
 // TestDefaultRegistry — returns same instance on multiple calls
 // ---------------------------------------------------------------------------
@@ -348,6 +549,77 @@ func TestAnonymousPolicyNotRegistered(t *testing.T) {
 	require.Empty(t, status.Policies)
 }
 
+// ---------------------------------------------------------------------------
+// TestLabeledAnonymousPolicy — WithLabel tags telemetry without registering
+// ---------------------------------------------------------------------------
+
+func TestLabeledAnonymousPolicy(t *testing.T) {
+	t.Parallel()
+
+	reg := NewRegistry()
+	handler := &recordingHandler{}
+
+	p := NewPolicy[string]("",
+		WithRegistry(reg),
+		WithLabel("checkout-charge"),
+		WithLogger(slog.New(handler)),
+		WithFallback("fb"),
+	)
+
+	_, err := p.Do(context.Background(), func(context.Context) (string, error) {
+		return "", errors.New("down")
+	})
+	require.NoError(t, err)
+
+	require.Nil(t, p.registry)
+	require.Empty(t, reg.CheckReadiness().Policies)
+
+	require.Equal(t, "checkout-charge", p.Label())
+
+	metrics := p.Metrics()
+	require.Empty(t, metrics.Name)
+	require.Equal(t, "checkout-charge", metrics.Label)
+	require.Equal(t, int64(1), metrics.FallbacksUsed)
+
+	attrs := handler.attrs(string(EventFallbackUsed))
+	require.Equal(t, "checkout-charge", attrs["label"].String())
+}
+
+func TestLabelReportedAlongsideName(t *testing.T) {
+	t.Parallel()
+
+	reg := NewRegistry()
+	handler := &recordingHandler{}
+
+	p := NewPolicy[string]("payments",
+		WithRegistry(reg),
+		WithLabel("eu-west"),
+		WithLogger(slog.New(handler)),
+		WithFallback("fb"),
+	)
+
+	_, _ = p.Do(context.Background(), func(context.Context) (string, error) {
+		return "", errors.New("down")
+	})
+
+	snapshot := reg.Snapshot()
+	require.Len(t, snapshot, 1)
+	require.Equal(t, "payments", snapshot[0].Name)
+	require.Equal(t, "eu-west", snapshot[0].Label)
+
+	attrs := handler.attrs(string(EventFallbackUsed))
+	require.Equal(t, "payments", attrs["policy"].String())
+	require.Equal(t, "eu-west", attrs["label"].String())
+
+	// Without a label, records carry no label attribute.
+	plain := &recordingHandler{}
+	_, _ = NewPolicy[string]("", WithLogger(slog.New(plain)), WithFallback("fb")).
+		Do(context.Background(), func(context.Context) (string, error) {
+			return "", errors.New("down")
+		})
+	require.NotContains(t, plain.attrs(string(EventFallbackUsed)), "label")
+}
+
 // ---------------------------------------------------------------------------
 // BenchmarkCheckReadiness — benchmark with multiple registered policies
 // ---------------------------------------------------------------------------
@@ -371,3 +643,28 @@ func BenchmarkCheckReadiness(b *testing.B) {
 		_ = reg.CheckReadiness()
 	}
 }
+
+// ---------------------------------------------------------------------------
+// TestRegistryCheckLiveness — liveness ignores policy health
+// ---------------------------------------------------------------------------
+
+func TestRegistryCheckLiveness(t *testing.T) {
+	t.Parallel()
+
+	reg := NewRegistry()
+	p := NewPolicy[string]("live",
+		WithClock(&stubClock{now: time.Now()}),
+		WithRegistry(reg),
+		WithReadinessImpact(),
+		WithCircuitBreaker(FailureThreshold(1), RecoveryTimeout(time.Hour)),
+	)
+
+	openCircuit(t, p)
+
+	require.False(t, reg.CheckReadiness().Ready)
+	assert.Equal(t, LivenessStatus{Alive: true, Policies: 1}, reg.CheckLiveness())
+
+	var nilReg *Registry
+	assert.False(t, nilReg.CheckLiveness().Alive)
+	assert.False(t, (&Registry{}).CheckLiveness().Alive)
+}
@@ -3,6 +3,7 @@ package r8e
 import (
 	"context"
 	"fmt"
+	"math/rand/v2"
 	"time"
 )
 
@@ -10,8 +11,13 @@ type (
 	// retryConfig holds the optional configuration for retry behavior.
 	retryConfig struct {
 		retryIf           func(error) bool
+		backoffFromError  func(attempt int, err error) (time.Duration, bool)
+		beforeRetry       func(ctx context.Context, attempt int) error
 		maxDelay          time.Duration
 		perAttemptTimeout time.Duration
+		maxElapsed        time.Duration
+		minPerAttempt     time.Duration
+		initialJitter     time.Duration
 	}
 
 	// RetryOption configures retry behavior.
@@ -31,8 +37,45 @@ type (
 		Opts        []RetryOption
 		MaxAttempts int
 	}
+
+	// RetryError is returned when every retry attempt failed. It carries the
+	// full attempt history for debugging flaky dependencies, and matches
+	// errors.Is(err, [ErrRetriesExhausted]). Unwrap yields the sentinel, then
+	// the attempt errors newest first, so errors.As and errors.Is find the
+	// final failure ahead of earlier ones.
+	RetryError struct {
+		// Errors holds each attempt's error, in attempt order.
+		Errors []error
+		// Attempts is the number of attempts made.
+		Attempts int
+		// Elapsed is the time from the first attempt to the last failure,
+		// including backoff waits.
+		Elapsed time.Duration
+	}
 )
 
+// Error implements the error interface, reporting the last attempt's error.
+func (e *RetryError) Error() string {
+	if len(e.Errors) == 0 {
+		return ErrRetriesExhausted.Error()
+	}
+
+	return ErrRetriesExhausted.Error() + ": " + e.Errors[len(e.Errors)-1].Error()
+}
+
+// Unwrap returns [ErrRetriesExhausted] followed by the attempt errors, newest
+// first.
+func (e *RetryError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Errors)+1)
+	errs = append(errs, ErrRetriesExhausted)
+
+	for i := len(e.Errors) - 1; i >= 0; i-- {
+		errs = append(errs, e.Errors[i])
+	}
+
+	return errs
+}
+
 // MaxDelay caps the backoff delay to a maximum value.
 func MaxDelay(d time.Duration) RetryOption {
 	return func(cfg *retryConfig) {
@@ -40,13 +83,83 @@ func MaxDelay(d time.Duration) RetryOption {
 	}
 }
 
-// PerAttemptTimeout sets a timeout for each individual retry attempt.
+// PerAttemptTimeout sets a timeout for each individual retry attempt. The
+// attempt's context derives from the call's, so its deadline is the earliest of
+// the caller's deadline, [WithTimeout] and d: a per-attempt timeout longer than
+// the time left never extends it.
 func PerAttemptTimeout(d time.Duration) RetryOption {
 	return func(cfg *retryConfig) {
 		cfg.perAttemptTimeout = d
 	}
 }
 
+// MaxElapsedTime caps the total time spent retrying, whatever the attempt
+// count: before each backoff, if the time elapsed since the first attempt plus
+// the next delay (after [MaxDelay] and any Retry-After hint) would exceed d, no
+// further attempt is made and the retries are reported exhausted
+// ([ErrRetriesExhausted]). It decides whether a new attempt may start, not how
+// long one runs — bound that with [PerAttemptTimeout], making the worst case d
+// plus one per-attempt timeout. A non-positive d disables the cap.
+func MaxElapsedTime(d time.Duration) RetryOption {
+	return func(cfg *retryConfig) {
+		cfg.maxElapsed = d
+	}
+}
+
+// MinTimePerAttempt sets the least time an attempt needs to be worth starting.
+// Retry always checks the context deadline before a backoff: when the time left
+// until ctx.Deadline() is no more than the next delay plus d, the sequence
+// stops without sleeping and returns the last attempt's error (not
+// context.DeadlineExceeded — the context is still live). With the default d of
+// zero, retry only skips attempts that could not even start before the
+// deadline. A negative d is treated as zero. The check is always on, and the
+// time left is measured on the policy [Clock] — ctx.Deadline() minus the
+// clock's Now — so under a fake clock set ahead of the real time, a real ctx
+// deadline can look too close and stop the retries early.
+func MinTimePerAttempt(d time.Duration) RetryOption {
+	return func(cfg *retryConfig) {
+		cfg.minPerAttempt = max(d, 0)
+	}
+}
+
+// InitialJitter waits a random delay in [0, maxJitter) before the first
+// attempt, not only between retries, so a fleet of replicas booting together
+// staggers its first calls to a shared dependency instead of arriving as one
+// herd. The wait runs on the retry's [Clock] timer and precedes every retry
+// sequence — each call through the policy — and a ctx done during it returns
+// ctx.Err() without any attempt. It is not counted by [MaxElapsedTime]. A
+// non-positive maxJitter disables it (the default).
+func InitialJitter(maxJitter time.Duration) RetryOption {
+	return func(cfg *retryConfig) {
+		cfg.initialJitter = maxJitter
+	}
+}
+
+// BackoffFromError lets the failed attempt's error choose the wait before the
+// next retry, e.g. from a custom error carrying its own RetryAfter(). fn gets
+// the same 0-indexed attempt as [BackoffStrategy.Delay] and the attempt's
+// error; when it returns ok, its delay replaces both the strategy's backoff and
+// any Retry-After hint, otherwise the usual computation applies. [MaxDelay]
+// still caps the result. A negative delay is treated as zero.
+func BackoffFromError(fn func(attempt int, err error) (time.Duration, bool)) RetryOption {
+	return func(cfg *retryConfig) {
+		cfg.backoffFromError = fn
+	}
+}
+
+// OnBeforeRetry sets fn to run before each retry, once the backoff has elapsed
+// and just before the attempt starts — the place to re-resolve DNS, pick a new
+// endpoint, or drop a cached connection so the retry does not hit the same dead
+// target. attempt is the 1-indexed retry about to run, the number the OnRetry
+// hook reported for it. A non-nil error from fn ends the sequence at once: it
+// is returned as is, not wrapped in [ErrRetriesExhausted], and no further
+// attempt is made.
+func OnBeforeRetry(fn func(ctx context.Context, attempt int) error) RetryOption {
+	return func(cfg *retryConfig) {
+		cfg.beforeRetry = fn
+	}
+}
+
 // RetryIf sets a custom predicate that determines whether an error is
 // retryable,
 // in addition to the Transient/Permanent classification.
@@ -61,7 +174,9 @@ func RetryIf(fn func(error) bool) RetryOption {
 
 // DoRetry executes fn with retry logic. It retries up to params.MaxAttempts
 // times using the given BackoffStrategy. It respects Transient/Permanent error
-// classification.
+// classification. It stops early when ctx's deadline leaves too little time
+// for the next backoff and attempt, measured on params.Clock (see
+// [MinTimePerAttempt]).
 //
 //nolint:ireturn // generic type parameter T, not an interface
 func DoRetry[T any](
@@ -74,14 +189,27 @@ func DoRetry[T any](
 		opt(&cfg)
 	}
 
-	// When maxAttempts is 0 or 1, execute exactly once.
+	// When maxAttempts is 0 or 1, or the call was marked by SkipRetry, execute
+	// exactly once.
 	maxAttempts := max(params.MaxAttempts, 1)
+	if isSkipRetry(ctx) {
+		maxAttempts = 1
+	}
 
 	var (
 		zero    T
 		lastErr error
+		errs    []error
 	)
 
+	if cfg.initialJitter > 0 {
+		if err := sleepInitialJitter(ctx, params.Clock, cfg.initialJitter); err != nil {
+			return zero, err
+		}
+	}
+
+	start := params.Clock.Now()
+
 	for attempt := range maxAttempts {
 		// A retry attempt (attempt > 0) must claim a concurrency-budget permit
 		// before it runs, so a burst of simultaneous retries cannot pile load
@@ -96,24 +224,40 @@ func DoRetry[T any](
 			return zero, fmt.Errorf("%w: %w", ErrConcurrencyBudgetExceeded, lastErr)
 		}
 
-		// A non-nil permit is released when the attempt returns — including on a
-		// panic unwind — so a panicking fn (with no WithRecover) cannot leak it.
-		// Only retries (attempt > 0) hold one; the first attempt acquired none.
+		// The process-wide retry cap (see SetGlobalRetryLimit) is the last
+		// safety valve: over it, the retry is skipped and the real downstream
+		// error returned, like a retry-budget suppression.
+		if attempt > 0 && !globalRetries.tryAcquire() {
+			params.Concurrency.release()
+
+			return zero, lastErr //nolint:wrapcheck // real downstream error
+		}
+
+		// A non-nil permit and the global retry slot are released when the
+		// attempt returns — including on a panic unwind — so a panicking fn
+		// (with no WithRecover) cannot leak them. Only retries (attempt > 0)
+		// hold them; the first attempt acquired none.
 		var permit *ConcurrencyBudget
 		if attempt > 0 {
 			permit = params.Concurrency
 		}
 
-		result, err := runRetryAttempt(ctx, fn, cfg, permit)
+		result, err := runRetryAttempt(ctx, fn, cfg, permit, attempt > 0)
 
-		// On success: credit the retry budget and return immediately.
+		// On success: credit the retry budget and return immediately. A success
+		// after the first attempt is a recovered, degraded call.
 		if err == nil {
 			params.Budget.recordSuccess()
 
+			if attempt > 0 {
+				markDegraded(ctx)
+			}
+
 			return result, nil
 		}
 
 		lastErr = err
+		errs = append(errs, err)
 
 		// If error is Permanent: stop immediately. A non-retryable failure
 		// leaves the budget untouched — it cannot drive a retry storm.
@@ -145,9 +289,10 @@ func DoRetry[T any](
 			return zero, lastErr //nolint:wrapcheck // real downstream error
 		}
 
-		// Compute the wait before the next attempt: strategy backoff, a
-		// Retry-After override, then the MaxDelay cap.
-		delay := nextBackoffDelay(attempt, err, params.Strategy, cfg.maxDelay)
+		// Compute the wait before the next attempt: an error-driven delay, or
+		// the strategy backoff with any Retry-After override, then the MaxDelay
+		// cap.
+		delay := nextBackoffDelay(attempt, err, params.Strategy, cfg)
 
 		// Honor a total time budget: stop early rather than sleep a backoff that
 		// would exhaust the remaining budget and launch an attempt that cannot
@@ -163,6 +308,23 @@ func DoRetry[T any](
 			return zero, fmt.Errorf("%w: %w", ErrTimeBudgetExceeded, lastErr)
 		}
 
+		// Honor the context deadline: an attempt that would start too close to
+		// it (or past it) is wasted work, so stop now with the real downstream
+		// error. Only a context that is already done reports its own error.
+		if deadlineTooClose(ctx, params.Clock, delay+cfg.minPerAttempt) {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return zero, ctxErr //nolint:wrapcheck // preserving context error identity
+			}
+
+			return zero, lastErr //nolint:wrapcheck // real downstream error
+		}
+
+		// Honor MaxElapsedTime: a backoff that would carry the retry sequence
+		// past its cap ends it now, as exhausted, rather than sleeping first.
+		if cfg.maxElapsed > 0 && params.Clock.Since(start)+delay > cfg.maxElapsed {
+			break
+		}
+
 		// Emit OnRetry hook with 1-indexed attempt number.
 		params.Hooks.emitRetry(attempt+1, err)
 
@@ -176,16 +338,60 @@ func DoRetry[T any](
 
 			return zero, ctx.Err() //nolint:wrapcheck // preserving context error identity
 		}
+
+		// Let the caller refresh the target before the retry; its error ends
+		// the sequence as is.
+		if cfg.beforeRetry != nil {
+			if hookErr := cfg.beforeRetry(ctx, attempt+1); hookErr != nil {
+				return zero, hookErr
+			}
+		}
+	}
+
+	// All attempts exhausted (or MaxElapsedTime reached): report the full
+	// history, matching ErrRetriesExhausted.
+	params.Hooks.emitRetriesExhausted(len(errs), lastErr)
+
+	return zero, &RetryError{
+		Errors:   errs,
+		Attempts: len(errs),
+		Elapsed:  params.Clock.Since(start),
+	}
+}
+
+// sleepInitialJitter waits a uniform random delay in [0, maxJitter) on clock
+// before the first attempt (see [InitialJitter]), returning ctx.Err() when ctx
+// ends first.
+func sleepInitialJitter(ctx context.Context, clock Clock, maxJitter time.Duration) error {
+	timer := clock.NewTimer(time.Duration(rand.Int64N(int64(maxJitter))))
+
+	select {
+	case <-timer.C():
+		return nil
+	case <-ctx.Done():
+		timer.Stop()
+
+		return ctx.Err() //nolint:wrapcheck // preserving context error identity
 	}
+}
 
-	// All attempts exhausted: wrap last error with ErrRetriesExhausted.
-	return zero, fmt.Errorf("%w: %w", ErrRetriesExhausted, lastErr)
+// deadlineTooClose reports whether ctx carries a deadline that leaves no more
+// than need before it, measured against clock (like [RespectInboundDeadline]).
+// A ctx without a deadline is never too close.
+func deadlineTooClose(ctx context.Context, clock Clock, need time.Duration) bool {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return false
+	}
+
+	return deadline.Sub(clock.Now()) <= need
 }
 
 // runRetryAttempt executes one attempt of fn, optionally under a per-attempt
 // timeout, and releases the concurrency-budget permit (when permit is non-nil)
-// as the attempt returns. The release is deferred so it runs even if fn panics,
-// keeping the permit accounting balanced without a WithRecover boundary.
+// and the global retry slot (when retry is set) as the attempt returns. The
+// releases are deferred so they run even if fn panics, keeping the accounting
+// balanced without a WithRecover boundary.
 //
 //nolint:ireturn // generic type parameter T, not an interface
 func runRetryAttempt[T any](
@@ -193,11 +399,16 @@ func runRetryAttempt[T any](
 	fn func(context.Context) (T, error),
 	cfg retryConfig,
 	permit *ConcurrencyBudget,
+	retry bool,
 ) (T, error) {
 	if permit != nil {
 		defer permit.release()
 	}
 
+	if retry {
+		defer globalRetries.release()
+	}
+
 	if cfg.perAttemptTimeout > 0 {
 		attemptCtx, attemptCancel := context.WithTimeout(
 			ctx,
@@ -211,26 +422,50 @@ func runRetryAttempt[T any](
 	return fn(ctx)
 }
 
-// nextBackoffDelay computes the wait before the next retry attempt: the
+// nextBackoffDelay computes the wait before the next retry attempt: the delay
+// chosen by the BackoffFromError function when it returns one; otherwise the
 // strategy's backoff for this attempt, overridden by a server-supplied
 // Retry-After hint (with ±10% jitter to avoid a thundering herd) when the error
-// carries one, then capped by maxDelay (which also bounds an over-large
-// Retry-After). A non-positive maxDelay disables the cap.
+// carries one. The result is capped by cfg.maxDelay (which also bounds an
+// over-large Retry-After); a non-positive maxDelay disables the cap.
 func nextBackoffDelay(
 	attempt int,
 	err error,
 	strategy BackoffStrategy,
-	maxDelay time.Duration,
+	cfg retryConfig, //nolint:gocritic // small config read once per backoff
 ) time.Duration {
-	delay := strategy.Delay(attempt)
+	delay, ok := errorDrivenDelay(attempt, err, cfg.backoffFromError)
+	if !ok {
+		if capped, isCapped := strategy.(cappedBackoff); isCapped && cfg.maxDelay > 0 {
+			delay = capped.cappedDelay(attempt, cfg.maxDelay)
+		} else {
+			delay = strategy.Delay(attempt)
+		}
 
-	if after, ok := retryAfterFromError(err); ok {
-		delay = jitteredRetryAfter(after)
+		if after, hinted := retryAfterFromError(err); hinted {
+			delay = jitteredRetryAfter(after)
+		}
 	}
 
-	if maxDelay > 0 && delay > maxDelay {
-		delay = maxDelay
+	if cfg.maxDelay > 0 && delay > cfg.maxDelay {
+		delay = cfg.maxDelay
 	}
 
 	return delay
 }
+
+// errorDrivenDelay asks fn (when set) for the delay err calls for, flooring a
+// negative answer at zero.
+func errorDrivenDelay(
+	attempt int,
+	err error,
+	fn func(int, error) (time.Duration, bool),
+) (time.Duration, bool) {
+	if fn == nil {
+		return 0, false
+	}
+
+	delay, ok := fn(attempt, err)
+
+	return max(delay, 0), ok
+}
@@ -3,7 +3,9 @@ package r8e
 import (
 	"context"
 	"errors"
+	"fmt"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -235,6 +237,288 @@ func TestDoRetryAllRetriesExhausted(t *testing.T) {
 	require.ErrorIs(t, err, ErrRetriesExhausted)
 }
 
+func TestDoRetryExhaustedReturnsRetryError(t *testing.T) {
+	t.Parallel()
+
+	errs := []error{
+		Transient(errors.New("fail 1")),
+		Transient(errors.New("fail 2")),
+		Transient(errors.New("fail 3")),
+	}
+	attempt := 0
+
+	_, err := DoRetry[string](
+		context.Background(),
+		func(_ context.Context) (string, error) {
+			attempt++
+			return "", errs[attempt-1]
+		},
+		RetryParams{
+			MaxAttempts: 3,
+			Strategy:    ConstantBackoff(100 * time.Millisecond),
+			Hooks:       &Hooks{},
+			Clock:       newImmediateTestClock(),
+		},
+	)
+
+	var retryErr *RetryError
+	require.ErrorAs(t, err, &retryErr)
+	require.ErrorIs(t, err, ErrRetriesExhausted)
+	assert.Equal(t, 3, retryErr.Attempts)
+	assert.Equal(t, errs, retryErr.Errors)
+	assert.GreaterOrEqual(t, retryErr.Elapsed, time.Duration(0))
+	assert.Equal(t, "retries exhausted: transient: fail 3", err.Error())
+
+	// Every attempt error is reachable; the newest is found first.
+	for _, e := range errs {
+		require.ErrorIs(t, err, e)
+	}
+
+	unwrapped := retryErr.Unwrap()
+	require.Len(t, unwrapped, 4)
+	assert.Equal(t, ErrRetriesExhausted, unwrapped[0])
+	assert.Equal(t, errs[2], unwrapped[1])
+}
+
+func TestRetryErrorThroughPolicy(t *testing.T) {
+	t.Parallel()
+
+	p := NewPolicy[string]("",
+		WithClock(newImmediateTestClock()),
+		WithRetry(4, ConstantBackoff(time.Millisecond)),
+	)
+
+	_, err := p.Do(context.Background(), func(_ context.Context) (string, error) {
+		return "", errors.New("down")
+	})
+
+	var retryErr *RetryError
+	require.ErrorAs(t, err, &retryErr)
+	require.ErrorIs(t, err, ErrRetriesExhausted)
+	assert.Equal(t, 4, retryErr.Attempts)
+	assert.Len(t, retryErr.Errors, 4)
+}
+
+// ---------------------------------------------------------------------------
+// Tests: MaxElapsedTime caps the total retry duration
+// ---------------------------------------------------------------------------
+
+// elapsedTestClock is a fake clock whose timers fire immediately after moving
+// the clock forward by their duration, so backoff sleeps advance Since.
+type elapsedTestClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *elapsedTestClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *elapsedTestClock) Since(t time.Time) time.Duration { return c.Now().Sub(t) }
+
+func (c *elapsedTestClock) NewTimer(d time.Duration) Timer {
+	c.advance(d)
+	t := newTestTimer()
+	t.fire()
+	return t
+}
+
+func (c *elapsedTestClock) advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func TestDoRetryMaxElapsedTimeStopsMidSequence(t *testing.T) {
+	t.Parallel()
+	clk := &elapsedTestClock{now: time.Now()}
+	var retries []int
+	attempt := 0
+
+	_, err := DoRetry[string](
+		context.Background(),
+		func(_ context.Context) (string, error) {
+			attempt++
+			clk.advance(10 * time.Millisecond) // each attempt takes 10ms
+			return "", errors.New("fail")
+		},
+		RetryParams{
+			MaxAttempts: 10,
+			Strategy:    ConstantBackoff(100 * time.Millisecond),
+			Hooks:       &Hooks{OnRetry: func(n int, _ error) { retries = append(retries, n) }},
+			Clock:       clk,
+			Opts:        []RetryOption{MaxElapsedTime(250 * time.Millisecond)},
+		},
+	)
+
+	// t=10ms +100ms backoff -> 110 <= 250, retry; t=120 +100 -> 220, retry;
+	// t=230 +100 -> 330 > 250: stop before sleeping, with 3 attempts made.
+	var retryErr *RetryError
+	require.ErrorAs(t, err, &retryErr)
+	require.ErrorIs(t, err, ErrRetriesExhausted)
+	assert.Equal(t, 3, attempt)
+	assert.Equal(t, 3, retryErr.Attempts)
+	assert.Equal(t, []int{1, 2}, retries)
+	assert.Equal(t, 230*time.Millisecond, retryErr.Elapsed)
+}
+
+func TestDoRetryMaxElapsedTimeUsesCappedDelay(t *testing.T) {
+	t.Parallel()
+	clk := &elapsedTestClock{now: time.Now()}
+	attempt := 0
+
+	_, err := DoRetry[string](
+		context.Background(),
+		func(_ context.Context) (string, error) {
+			attempt++
+			return "", errors.New("fail")
+		},
+		RetryParams{
+			MaxAttempts: 4,
+			Strategy:    ConstantBackoff(time.Hour),
+			Hooks:       &Hooks{},
+			Clock:       clk,
+			Opts: []RetryOption{
+				MaxDelay(10 * time.Millisecond),
+				MaxElapsedTime(time.Second),
+			},
+		},
+	)
+
+	// The cap is checked against the MaxDelay-capped wait (10ms), not the raw
+	// one-hour backoff, so every attempt runs.
+	require.ErrorIs(t, err, ErrRetriesExhausted)
+	assert.Equal(t, 4, attempt)
+}
+
+// ---------------------------------------------------------------------------
+// Tests: retry respects the context deadline
+// ---------------------------------------------------------------------------
+
+// retryUntilDeadline runs a 5-attempt retry (10ms attempts, 100ms backoff)
+// under a context whose deadline is window after the start of the fake clock,
+// and returns the attempts made and the final error.
+func retryUntilDeadline(window time.Duration, opts ...RetryOption) (int, error) {
+	clk := &elapsedTestClock{now: time.Now()}
+	ctx := inboundDeadlineCtx{deadline: clk.Now().Add(window)}
+	attempts := 0
+
+	_, err := DoRetry[string](
+		ctx,
+		func(_ context.Context) (string, error) {
+			attempts++
+			clk.advance(10 * time.Millisecond)
+			return "", fmt.Errorf("attempt %d failed", attempts)
+		},
+		RetryParams{
+			MaxAttempts: 5,
+			Strategy:    ConstantBackoff(100 * time.Millisecond),
+			Clock:       clk,
+			Opts:        opts,
+		},
+	)
+
+	return attempts, err
+}
+
+func TestDoRetryStopsWhenDeadlineLeavesNoRoom(t *testing.T) {
+	t.Parallel()
+
+	// Attempt 1 ends at 10ms (190ms left > 100ms backoff); attempt 2 ends at
+	// 120ms with only 80ms left, so the third attempt could not start in time.
+	attempts, err := retryUntilDeadline(200 * time.Millisecond)
+
+	assert.Equal(t, 2, attempts)
+	require.EqualError(t, err, "attempt 2 failed")
+	assert.NotErrorIs(t, err, context.DeadlineExceeded)
+	assert.NotErrorIs(t, err, ErrRetriesExhausted)
+}
+
+func TestDoRetryMinTimePerAttempt(t *testing.T) {
+	t.Parallel()
+
+	// 190ms left after attempt 1 covers the 100ms backoff but not the extra
+	// 100ms the next attempt is declared to need.
+	attempts, err := retryUntilDeadline(200*time.Millisecond, MinTimePerAttempt(100*time.Millisecond))
+
+	assert.Equal(t, 1, attempts)
+	require.EqualError(t, err, "attempt 1 failed")
+}
+
+func TestDoRetryDeadlineFarAwayRunsAllAttempts(t *testing.T) {
+	t.Parallel()
+
+	attempts, err := retryUntilDeadline(time.Hour)
+
+	assert.Equal(t, 5, attempts)
+	require.ErrorIs(t, err, ErrRetriesExhausted)
+}
+
+func TestDoRetryDeadlineMeasuredOnPolicyClock(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Hour)
+	defer cancel()
+
+	run := func(now time.Time) int {
+		clk := newPolicyClock()
+		clk.now = now
+		attempts := 0
+
+		_, _ = DoRetry[string](
+			ctx,
+			func(_ context.Context) (string, error) {
+				attempts++
+
+				return "", errors.New("fail")
+			},
+			RetryParams{
+				MaxAttempts: 3,
+				Strategy:    ConstantBackoff(time.Millisecond),
+				Clock:       clk,
+			},
+		)
+
+		return attempts
+	}
+
+	// The real deadline is an hour away, but the fake clock reads the time
+	// left from its own Now: set two hours ahead, the deadline looks past and
+	// the retries stop after the first attempt, though ctx is still live.
+	assert.Equal(t, 1, run(time.Now().Add(2*time.Hour)))
+	require.NoError(t, ctx.Err())
+
+	// A fake clock behind the real time leaves every attempt room to run.
+	assert.Equal(t, 3, run(time.Now().Add(-2*time.Hour)))
+}
+
+func TestDoRetryDeadlineStopReturnsContextErrorWhenDone(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+
+	attempts := 0
+	_, err := DoRetry[string](
+		ctx,
+		func(ctx context.Context) (string, error) {
+			attempts++
+			<-ctx.Done()
+			return "", errors.New("fail")
+		},
+		RetryParams{
+			MaxAttempts: 5,
+			Strategy:    ConstantBackoff(time.Second),
+			Clock:       newImmediateTestClock(),
+		},
+	)
+
+	assert.Equal(t, 1, attempts)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
 // ---------------------------------------------------------------------------
 // Tests: MaxDelay caps the backoff
 // ---------------------------------------------------------------------------
@@ -275,6 +559,262 @@ func TestDoRetryMaxDelayCapsBackoff(t *testing.T) {
 	}
 }
 
+// ---------------------------------------------------------------------------
+// Tests: BackoffFromError lets the error choose the delay
+// ---------------------------------------------------------------------------
+
+var errSlowDown = errors.New("slow down")
+
+// slowDownOnOddAttempts asks for a fixed 2s wait after odd attempts failing
+// with errSlowDown and defers to the strategy otherwise.
+func slowDownOnOddAttempts(attempt int, err error) (time.Duration, bool) {
+	if attempt%2 == 1 && errors.Is(err, errSlowDown) {
+		return 2 * time.Second, true
+	}
+
+	return 0, false
+}
+
+func TestDoRetryBackoffFromError(t *testing.T) {
+	t.Parallel()
+	clk := newImmediateTestClock()
+
+	_, err := DoRetry[string](
+		context.Background(),
+		func(_ context.Context) (string, error) {
+			return "", Transient(errSlowDown)
+		},
+		RetryParams{
+			MaxAttempts: 5,
+			Strategy:    ExponentialBackoff(100 * time.Millisecond),
+			Clock:       clk,
+			Opts:        []RetryOption{BackoffFromError(slowDownOnOddAttempts)},
+		},
+	)
+	require.ErrorIs(t, err, ErrRetriesExhausted)
+
+	// Even attempts keep the exponential backoff (100ms, 400ms); odd ones
+	// wait the 2s the error asked for.
+	assert.Equal(t, []time.Duration{
+		100 * time.Millisecond,
+		2 * time.Second,
+		400 * time.Millisecond,
+		2 * time.Second,
+	}, clk.getDurations())
+}
+
+func TestDoRetryBackoffFromErrorCappedAndOverridesRetryAfter(t *testing.T) {
+	t.Parallel()
+	clk := newImmediateTestClock()
+
+	_, _ = DoRetry[string](
+		context.Background(),
+		func(_ context.Context) (string, error) {
+			// The error carries a Retry-After hint; the error-driven delay
+			// still wins, then MaxDelay caps it.
+			return "", RetryAfterError(Transient(errSlowDown), time.Minute)
+		},
+		RetryParams{
+			MaxAttempts: 3,
+			Strategy:    ConstantBackoff(10 * time.Millisecond),
+			Clock:       clk,
+			Opts: []RetryOption{
+				BackoffFromError(func(int, error) (time.Duration, bool) {
+					return 5 * time.Second, true
+				}),
+				MaxDelay(time.Second),
+			},
+		},
+	)
+
+	assert.Equal(t, []time.Duration{time.Second, time.Second}, clk.getDurations())
+}
+
+// ---------------------------------------------------------------------------
+// Tests: OnBeforeRetry refreshes the target between attempts
+// ---------------------------------------------------------------------------
+
+func TestDoRetryOnBeforeRetryFiresBeforeEachRetry(t *testing.T) {
+	t.Parallel()
+
+	var (
+		events    []string
+		endpoint  int
+		endpoints []int
+	)
+
+	hooks := &Hooks{OnRetry: func(attempt int, _ error) {
+		events = append(events, fmt.Sprintf("on_retry %d", attempt))
+	}}
+
+	_, err := DoRetry[string](
+		context.Background(),
+		func(_ context.Context) (string, error) {
+			endpoints = append(endpoints, endpoint)
+			events = append(events, "attempt")
+
+			return "", errors.New("endpoint down")
+		},
+		RetryParams{
+			MaxAttempts: 3,
+			Strategy:    ConstantBackoff(time.Millisecond),
+			Clock:       newImmediateTestClock(),
+			Hooks:       hooks,
+			Opts: []RetryOption{
+				OnBeforeRetry(func(_ context.Context, attempt int) error {
+					events = append(events, fmt.Sprintf("before_retry %d", attempt))
+					endpoint++ // re-resolve to the next endpoint
+
+					return nil
+				}),
+			},
+		},
+	)
+
+	require.ErrorIs(t, err, ErrRetriesExhausted)
+	assert.Equal(t, []string{
+		"attempt",
+		"on_retry 1", "before_retry 1", "attempt",
+		"on_retry 2", "before_retry 2", "attempt",
+	}, events)
+	assert.Equal(t, []int{0, 1, 2}, endpoints, "each retry used a refreshed target")
+}
+
+func TestDoRetryOnBeforeRetryErrorStopsRetries(t *testing.T) {
+	t.Parallel()
+
+	errNoEndpoint := errors.New("no healthy endpoint left")
+
+	var calls int
+
+	_, err := DoRetry[string](
+		context.Background(),
+		func(_ context.Context) (string, error) {
+			calls++
+
+			return "", errors.New("endpoint down")
+		},
+		RetryParams{
+			MaxAttempts: 5,
+			Strategy:    ConstantBackoff(time.Millisecond),
+			Clock:       newImmediateTestClock(),
+			Opts: []RetryOption{
+				OnBeforeRetry(func(_ context.Context, attempt int) error {
+					if attempt == 2 {
+						return errNoEndpoint
+					}
+
+					return nil
+				}),
+			},
+		},
+	)
+
+	require.Equal(t, errNoEndpoint, err, "returned as is")
+	require.NotErrorIs(t, err, ErrRetriesExhausted)
+	assert.Equal(t, 2, calls, "no attempt after the refresh failed")
+}
+
+// ---------------------------------------------------------------------------
+// Tests: OnRetriesExhausted fires once, only when every attempt failed
+// ---------------------------------------------------------------------------
+
+type exhaustedCall struct {
+	attempts int
+	lastErr  error
+}
+
+func exhaustedRecorder(calls *[]exhaustedCall) *Hooks {
+	return &Hooks{OnRetriesExhausted: func(attempts int, lastErr error) {
+		*calls = append(*calls, exhaustedCall{attempts: attempts, lastErr: lastErr})
+	}}
+}
+
+func TestDoRetryOnRetriesExhaustedFiresOnce(t *testing.T) {
+	t.Parallel()
+
+	var (
+		calls   []exhaustedCall
+		attempt int
+	)
+
+	lastErr := errors.New("attempt 4 failed")
+
+	_, err := DoRetry[string](
+		context.Background(),
+		func(_ context.Context) (string, error) {
+			attempt++
+			if attempt == 4 {
+				return "", lastErr
+			}
+
+			return "", fmt.Errorf("attempt %d failed", attempt)
+		},
+		RetryParams{
+			MaxAttempts: 4,
+			Strategy:    ConstantBackoff(time.Millisecond),
+			Clock:       newImmediateTestClock(),
+			Hooks:       exhaustedRecorder(&calls),
+		},
+	)
+
+	require.ErrorIs(t, err, ErrRetriesExhausted)
+	require.Len(t, calls, 1)
+	assert.Equal(t, 4, calls[0].attempts)
+	assert.Equal(t, lastErr, calls[0].lastErr)
+}
+
+func TestDoRetryOnRetriesExhaustedNotFiredOnEarlyStop(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		fn   func(context.Context) (string, error)
+		opts []RetryOption
+	}{
+		{
+			name: "success",
+			fn:   func(context.Context) (string, error) { return "ok", nil },
+		},
+		{
+			name: "permanent error",
+			fn: func(context.Context) (string, error) {
+				return "", Permanent(errors.New("bad request"))
+			},
+		},
+		{
+			name: "retry if rejects",
+			fn: func(context.Context) (string, error) {
+				return "", errors.New("not found")
+			},
+			opts: []RetryOption{RetryIf(func(error) bool { return false })},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls []exhaustedCall
+
+			_, err := DoRetry[string](
+				context.Background(),
+				tt.fn,
+				RetryParams{
+					MaxAttempts: 3,
+					Strategy:    ConstantBackoff(time.Millisecond),
+					Clock:       newImmediateTestClock(),
+					Hooks:       exhaustedRecorder(&calls),
+					Opts:        tt.opts,
+				},
+			)
+
+			require.NotErrorIs(t, err, ErrRetriesExhausted)
+			assert.Empty(t, calls)
+		})
+	}
+}
+
 // ---------------------------------------------------------------------------
 // Tests: PerAttemptTimeout cancels slow individual attempts
 // ---------------------------------------------------------------------------
@@ -312,6 +852,69 @@ func TestDoRetryPerAttemptTimeout(t *testing.T) {
 	require.Equal(t, 3, attempt)
 }
 
+// policyAttemptBudget runs one call through a policy and reports how long
+// before the deadline fn's context carried its attempt started.
+func policyAttemptBudget(t *testing.T, ctx context.Context, opts ...Option) time.Duration {
+	t.Helper()
+
+	p := NewPolicy[string]("", opts...)
+
+	var budget time.Duration
+
+	_, err := p.Do(ctx, func(ctx context.Context) (string, error) {
+		deadline, ok := ctx.Deadline()
+		require.True(t, ok, "fn must see a deadline")
+
+		budget = time.Until(deadline)
+
+		return "ok", nil
+	})
+	require.NoError(t, err)
+
+	return budget
+}
+
+func TestPolicyPerAttemptTimeoutEarlierThanTimeoutWins(t *testing.T) {
+	t.Parallel()
+
+	budget := policyAttemptBudget(t, context.Background(),
+		WithTimeout(time.Second),
+		WithRetry(3, ConstantBackoff(time.Millisecond), PerAttemptTimeout(100*time.Millisecond)),
+	)
+
+	assert.LessOrEqual(t, budget, 100*time.Millisecond)
+	assert.Greater(t, budget, 50*time.Millisecond)
+}
+
+func TestPolicyTimeoutEarlierThanPerAttemptTimeoutWins(t *testing.T) {
+	t.Parallel()
+
+	budget := policyAttemptBudget(t, context.Background(),
+		WithTimeout(100*time.Millisecond),
+		WithRetry(3, ConstantBackoff(time.Millisecond), PerAttemptTimeout(time.Second)),
+	)
+
+	assert.LessOrEqual(t, budget, 100*time.Millisecond,
+		"a longer per-attempt timeout must not extend the policy timeout")
+	assert.Greater(t, budget, 50*time.Millisecond)
+}
+
+func TestPolicyParentDeadlineEarlierThanBothWins(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	budget := policyAttemptBudget(t, ctx,
+		WithTimeout(time.Second),
+		WithRetry(3, ConstantBackoff(time.Millisecond), PerAttemptTimeout(500*time.Millisecond)),
+	)
+
+	assert.LessOrEqual(t, budget, 100*time.Millisecond,
+		"neither the timeout nor the per-attempt timeout may extend the caller's deadline")
+	assert.Greater(t, budget, 50*time.Millisecond)
+}
+
 // ---------------------------------------------------------------------------
 // Tests: RetryIf predicate controls retryability
 // ---------------------------------------------------------------------------
@@ -428,6 +1031,87 @@ func TestDoRetryContextCancellationDuringSleep(t *testing.T) {
 	require.ErrorIs(t, retErr, context.Canceled)
 }
 
+// ---------------------------------------------------------------------------
+// Tests: InitialJitter staggers the first attempt
+// ---------------------------------------------------------------------------
+
+func TestDoRetryInitialJitterBeforeFirstAttempt(t *testing.T) {
+	t.Parallel()
+
+	const maxJitter = 50 * time.Millisecond
+
+	for range 20 {
+		clk := newImmediateTestClock()
+
+		got, err := DoRetry[string](
+			context.Background(),
+			func(_ context.Context) (string, error) {
+				// The jitter timer is armed before the first attempt runs.
+				assert.Len(t, clk.getDurations(), 1)
+
+				return "ok", nil
+			},
+			RetryParams{
+				MaxAttempts: 3,
+				Strategy:    ConstantBackoff(time.Second),
+				Clock:       clk,
+				Opts:        []RetryOption{InitialJitter(maxJitter)},
+			},
+		)
+		require.NoError(t, err)
+		assert.Equal(t, "ok", got)
+
+		durations := clk.getDurations()
+		require.Len(t, durations, 1)
+		assert.GreaterOrEqual(t, durations[0], time.Duration(0))
+		assert.Less(t, durations[0], maxJitter)
+	}
+}
+
+func TestDoRetryInitialJitterCancelled(t *testing.T) {
+	t.Parallel()
+
+	clk := newTestClock()
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var attempts atomic.Int32
+
+	done := make(chan error, 1)
+
+	go func() {
+		_, err := DoRetry[string](
+			ctx,
+			func(_ context.Context) (string, error) {
+				attempts.Add(1)
+				return "ok", nil
+			},
+			RetryParams{
+				MaxAttempts: 3,
+				Strategy:    ConstantBackoff(time.Second),
+				Clock:       clk,
+				Opts:        []RetryOption{InitialJitter(time.Hour)},
+			},
+		)
+		done <- err
+	}()
+
+	for clk.timerCount() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	cancel()
+
+	select {
+	case err := <-done:
+		require.ErrorIs(t, err, context.Canceled)
+	case <-time.After(5 * time.Second):
+		t.Fatal("DoRetry did not return after cancellation during the initial jitter")
+	}
+
+	assert.Zero(t, attempts.Load(), "no attempt runs once the jitter is cancelled")
+	assert.True(t, clk.getTimer(0).stopped, "the jitter timer is stopped")
+}
+
 // ---------------------------------------------------------------------------
 // Tests: Zero/one maxAttempts executes exactly once
 // ---------------------------------------------------------------------------
@@ -780,3 +1464,112 @@ func BenchmarkRetry(b *testing.B) {
 		)
 	}
 }
+
+// ---------------------------------------------------------------------------
+// Tests: WithTimerFunc replaces timers but not Now/Since
+// ---------------------------------------------------------------------------
+
+// timerOnlyClock is a clock whose own timers must never be armed: the tests
+// pair it with WithTimerFunc and count any call that slips through.
+type timerOnlyClock struct {
+	elapsedTestClock
+
+	clockTimers atomic.Int64
+}
+
+func (c *timerOnlyClock) NewTimer(d time.Duration) Timer {
+	c.clockTimers.Add(1)
+
+	return c.elapsedTestClock.NewTimer(d)
+}
+
+// roundingTimers returns a timer func that snaps each duration up to a 100ms
+// tick, records it, advances clk by it, and fires at once.
+func roundingTimers(clk *timerOnlyClock, mu *sync.Mutex, got *[]time.Duration) func(time.Duration) Timer {
+	const tick = 100 * time.Millisecond
+
+	return func(d time.Duration) Timer {
+		rounded := (d + tick - 1) / tick * tick
+
+		mu.Lock()
+		*got = append(*got, rounded)
+		mu.Unlock()
+
+		clk.advance(rounded)
+
+		timer := newTestTimer()
+		timer.fire()
+
+		return timer
+	}
+}
+
+func TestWithTimerFuncDrivesRetrySleeps(t *testing.T) {
+	t.Parallel()
+
+	clk := &timerOnlyClock{elapsedTestClock: elapsedTestClock{now: time.Now()}}
+	start := clk.Now()
+
+	var (
+		mu    sync.Mutex
+		slept []time.Duration
+	)
+
+	p := NewPolicy[string]("",
+		WithClock(clk),
+		WithTimerFunc(roundingTimers(clk, &mu, &slept)),
+		WithRetry(3, ConstantBackoff(30*time.Millisecond)),
+	)
+
+	_, err := p.Do(context.Background(), func(context.Context) (string, error) {
+		return "", errors.New("fail")
+	})
+	require.ErrorIs(t, err, ErrRetriesExhausted)
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	assert.Equal(t, []time.Duration{100 * time.Millisecond, 100 * time.Millisecond}, slept)
+	assert.Zero(t, clk.clockTimers.Load(), "the clock's own NewTimer is bypassed")
+	// Now/Since still come from the clock, which the custom timers advanced.
+	assert.Equal(t, 200*time.Millisecond, clk.Since(start))
+}
+
+func TestWithTimerFuncDrivesBlockingRateLimitWait(t *testing.T) {
+	t.Parallel()
+
+	clk := &timerOnlyClock{elapsedTestClock: elapsedTestClock{now: time.Now()}}
+
+	var (
+		mu    sync.Mutex
+		slept []time.Duration
+	)
+
+	p := NewPolicy[string]("",
+		WithTimerFunc(roundingTimers(clk, &mu, &slept)),
+		WithClock(clk), // order-independent
+		WithRateLimit(5, RateLimitLeaky(), RateLimitBlocking()),
+	)
+
+	fn := func(context.Context) (string, error) { return "ok", nil }
+
+	for range 2 {
+		_, err := p.Do(context.Background(), fn)
+		require.NoError(t, err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	assert.Equal(t, []time.Duration{200 * time.Millisecond}, slept)
+	assert.Zero(t, clk.clockTimers.Load())
+}
+
+func TestWithTimerFuncNilIsIgnored(t *testing.T) {
+	t.Parallel()
+
+	clk := newTestClock()
+	setup := resolveSetup([]Option{WithClock(clk), WithTimerFunc(nil)})
+
+	assert.Same(t, clk, setup.clock)
+}
@@ -23,6 +23,19 @@ type (
 		RetryAfter() (time.Duration, bool)
 	}
 
+	// RetryAfterer is the single-value form of [RetryAfterProvider] for errors
+	// whose transport has no notion of an absent hint (e.g. a gRPC status
+	// carrying a RetryInfo detail, or a custom client's throttling error). A
+	// positive duration overrides the configured backoff exactly like a
+	// Retry-After header; zero or negative means "no hint" and the strategy
+	// applies. [DoRetry] finds it anywhere in the error chain via errors.As;
+	// a [RetryAfterProvider] in the same chain takes precedence.
+	RetryAfterer interface {
+		// RetryAfter returns how long to wait before the next attempt; a
+		// non-positive value means no hint.
+		RetryAfter() time.Duration
+	}
+
 	// retryAfterError wraps an error with a fixed retry-after delay.
 	retryAfterError struct {
 		err   error
@@ -55,13 +68,21 @@ func (e *retryAfterError) RetryAfter() (time.Duration, bool) {
 }
 
 // retryAfterFromError returns the retry-after hint carried by err (or any error
-// it wraps), if any.
+// it wraps), if any. A [RetryAfterProvider] anywhere in the chain takes
+// precedence; otherwise a [RetryAfterer] with a positive delay is used.
 func retryAfterFromError(err error) (time.Duration, bool) {
 	var provider RetryAfterProvider
 	if errors.As(err, &provider) {
 		return provider.RetryAfter()
 	}
 
+	var afterer RetryAfterer
+	if errors.As(err, &afterer) {
+		if after := afterer.RetryAfter(); after > 0 {
+			return after, true
+		}
+	}
+
 	return 0, false
 }
 
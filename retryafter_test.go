@@ -163,6 +163,88 @@ func TestDoRetryRetryAfterCappedByMaxDelay(t *testing.T) {
 	}
 }
 
+// ---------------------------------------------------------------------------
+// RetryAfterer — single-value hint for non-HTTP errors
+// ---------------------------------------------------------------------------
+
+// throttledError is a non-HTTP error carrying a server-directed delay through
+// the single-value RetryAfterer form (as a gRPC RetryInfo adapter would).
+type throttledError struct{ after time.Duration }
+
+func (e *throttledError) Error() string             { return "throttled" }
+func (e *throttledError) RetryAfter() time.Duration { return e.after }
+
+func TestRetryAfterFromErrorRetryAfterer(t *testing.T) {
+	t.Parallel()
+
+	after, ok := retryAfterFromError(Transient(&throttledError{after: time.Second}))
+	require.True(t, ok)
+	assert.Equal(t, time.Second, after)
+
+	// A non-positive value means "no hint".
+	_, ok = retryAfterFromError(&throttledError{})
+	assert.False(t, ok)
+
+	// A RetryAfterProvider in the same chain takes precedence.
+	after, ok = retryAfterFromError(
+		RetryAfterError(&throttledError{after: time.Second}, 3*time.Second),
+	)
+	require.True(t, ok)
+	assert.Equal(t, 3*time.Second, after)
+}
+
+func TestDoRetryHonorsRetryAfterer(t *testing.T) {
+	t.Parallel()
+
+	clk := newImmediateTestClock()
+
+	_, err := DoRetry[string](
+		context.Background(),
+		func(_ context.Context) (string, error) {
+			return "", &throttledError{after: 500 * time.Millisecond}
+		},
+		RetryParams{
+			MaxAttempts: 3,
+			Strategy:    ConstantBackoff(10 * time.Millisecond),
+			Hooks:       &Hooks{},
+			Clock:       clk,
+		},
+	)
+	require.ErrorIs(t, err, ErrRetriesExhausted)
+
+	durations := clk.getDurations()
+	require.Len(t, durations, 2)
+
+	for _, d := range durations {
+		assert.GreaterOrEqual(t, d, 450*time.Millisecond, "should track RetryAfterer, not the 10ms backoff")
+		assert.LessOrEqual(t, d, 550*time.Millisecond)
+	}
+}
+
+func TestDoRetryWithoutRetryAftererUsesStrategy(t *testing.T) {
+	t.Parallel()
+
+	clk := newImmediateTestClock()
+
+	_, err := DoRetry[string](
+		context.Background(),
+		func(_ context.Context) (string, error) {
+			return "", &throttledError{} // no hint: strategy applies
+		},
+		RetryParams{
+			MaxAttempts: 3,
+			Strategy:    ConstantBackoff(10 * time.Millisecond),
+			Hooks:       &Hooks{},
+			Clock:       clk,
+		},
+	)
+	require.ErrorIs(t, err, ErrRetriesExhausted)
+
+	for _, d := range clk.getDurations() {
+		assert.Equal(t, 10*time.Millisecond, d)
+	}
+}
+
 // FuzzJitteredRetryAfter asserts the jittered delay never panics and stays
 // within ±10% of the input for any int64 duration — including values near
 // math.MaxInt64 where the upper end would otherwise overflow and wrap negative.
@@ -1,7 +1,11 @@
 package r8e
 
 import (
+	"container/list"
 	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -22,11 +26,60 @@ type (
 		cache            Cache[K, V]
 		onStaleServed    func(K)
 		onCacheRefreshed func(K)
-		ttl              time.Duration
+		// sizeOf and bytes bound the estimated memory held by cached values
+		// (see MaxBytes); both nil when unbounded.
+		sizeOf func(V) int64
+		bytes  *byteBudget[K]
+		// clock schedules background refresh (see StaleCacheClock); RealClock
+		// by default.
+		clock Clock
+		// refresh is the background refresher; nil unless
+		// WithBackgroundRefresh was given.
+		refresh         *staleRefresher[K, V]
+		refreshInterval time.Duration
+		// refreshTimeout bounds each background refresh (see
+		// BackgroundRefreshTimeout); the interval when unset.
+		refreshTimeout time.Duration
+		ttl            time.Duration
+		// servingStale is set by a Do that served a cached value on failure
+		// and cleared by one that succeeded (see ServingStale).
+		servingStale atomic.Bool
+		// returnError makes a stale serve return ErrServingStale (see
+		// StaleCacheReturnError).
+		returnError bool
+	}
+
+	// staleRefresher proactively re-runs the last successful loader of every
+	// cached key on a fixed interval, keeping a [StaleCache] warm.
+	staleRefresher[K comparable, V any] struct {
+		ctx      context.Context //nolint:containedctx // lifetime of the refresher, cancelled by Close
+		cancel   context.CancelFunc
+		loaders  map[K]func(context.Context, K) (V, error)
+		inFlight map[K]struct{}
+		wg       sync.WaitGroup
+		mu       sync.Mutex
 	}
 
 	// StaleCacheOption configures a [StaleCache].
 	StaleCacheOption[K comparable, V any] func(*StaleCache[K, V])
+
+	// byteBudget is an LRU index of the keys a [StaleCache] has stored and
+	// their estimated sizes. It does not hold values — the [Cache] does — it
+	// only decides which keys to Delete so the estimated total stays under
+	// max.
+	byteBudget[K comparable] struct {
+		lru   *list.List // of *budgetEntry[K], most recently used at front
+		index map[K]*list.Element
+		mu    sync.Mutex
+		max   int64
+		total int64
+	}
+
+	// budgetEntry is one key tracked by a byteBudget.
+	budgetEntry[K comparable] struct {
+		key  K
+		size int64
+	}
 )
 
 // OnStaleServed sets a callback invoked when a stale cached value is served.
@@ -43,6 +96,88 @@ func OnCacheRefreshed[K comparable, V any](fn func(K)) StaleCacheOption[K, V] {
 	}
 }
 
+// StaleCacheReturnError makes [StaleCache.Do] report a stale serve: instead of
+// (stale, nil) it returns (stale, err), where err wraps the downstream error
+// as [ErrServingStale]. Callers test errors.Is(err, ErrServingStale) and keep
+// using the value, and can still inspect the original failure through the same
+// chain. Without it a stale serve is silent but for [OnStaleServed] and
+// [StaleCache.ServingStale].
+func StaleCacheReturnError[K comparable, V any]() StaleCacheOption[K, V] {
+	return func(sc *StaleCache[K, V]) {
+		sc.returnError = true
+	}
+}
+
+// MaxBytes bounds the estimated memory of the values a [StaleCache] keeps.
+// Every stored value is measured with sizeOf; when the running total exceeds
+// n, the least-recently-used keys (stored or served stale longest ago) are
+// deleted from the underlying [Cache] until it fits again. A single value
+// larger than n is not cached at all. This complements an entry-count bound
+// in the cache itself: a few large values can exhaust memory long before the
+// entry limit is reached.
+//
+// The total is an estimate on top of the cache's own eviction: a key the
+// cache expired or evicted independently keeps counting until it is evicted
+// here or stored again. n <= 0 or a nil sizeOf leaves the cache unbounded.
+func MaxBytes[K comparable, V any](
+	n int64,
+	sizeOf func(V) int64,
+) StaleCacheOption[K, V] {
+	return func(sc *StaleCache[K, V]) {
+		if n <= 0 || sizeOf == nil {
+			sc.sizeOf, sc.bytes = nil, nil
+
+			return
+		}
+
+		sc.sizeOf = sizeOf
+		sc.bytes = newByteBudget[K](n)
+	}
+}
+
+// WithBackgroundRefresh makes a [StaleCache] keep itself warm: every interval
+// (measured on the cache's [Clock], see [StaleCacheClock]) it re-runs, in the
+// background, the loader of the last successful Do for each key still cached,
+// storing a fresh value and firing [OnCacheRefreshed] on success. A failed
+// refresh leaves the cached value in place. Pick an interval shorter than the
+// ttl so entries are refreshed before they expire; a key that has dropped out
+// of the cache is no longer refreshed.
+//
+// Refreshes of one key never overlap — a key whose previous refresh is still
+// running is skipped for that tick — and run under a context that [StaleCache.Close]
+// cancels. Each refresh is also bounded by a deadline on the cache's clock, one
+// interval by default (see [BackgroundRefreshTimeout]), so a loader stuck on a
+// slow dependency gives its key back to a later tick. Call Close when the cache is no longer needed to stop the
+// background goroutine. A non-positive interval disables background refresh.
+func WithBackgroundRefresh[K comparable, V any](
+	interval time.Duration,
+) StaleCacheOption[K, V] {
+	return func(sc *StaleCache[K, V]) {
+		sc.refreshInterval = interval
+	}
+}
+
+// BackgroundRefreshTimeout bounds each [WithBackgroundRefresh] reload at d on
+// the cache's [Clock]: the loader's context is cancelled once d has elapsed,
+// and the key is refreshed again on a later tick. A non-positive d keeps the
+// default, the refresh interval.
+func BackgroundRefreshTimeout[K comparable, V any](d time.Duration) StaleCacheOption[K, V] {
+	return func(sc *StaleCache[K, V]) {
+		sc.refreshTimeout = d
+	}
+}
+
+// StaleCacheClock sets the [Clock] that schedules [WithBackgroundRefresh].
+// Defaults to [RealClock]; tests inject a fake clock to drive refresh ticks
+// deterministically. A nil clock is ignored.
+func StaleCacheClock[K comparable, V any](clock Clock) StaleCacheOption[K, V] {
+	return func(sc *StaleCache[K, V]) {
+		if clock != nil {
+			sc.clock = clock
+		}
+	}
+}
+
 // NewStaleCache creates a keyed stale cache backed by the given [Cache].
 // The ttl determines how long cached entries remain valid.
 func NewStaleCache[K comparable, V any](
@@ -52,6 +187,7 @@ func NewStaleCache[K comparable, V any](
 ) *StaleCache[K, V] {
 	sc := &StaleCache[K, V]{
 		cache: cache,
+		clock: RealClock{},
 		ttl:   ttl,
 	}
 
@@ -59,11 +195,43 @@ func NewStaleCache[K comparable, V any](
 		opt(sc)
 	}
 
+	if sc.refreshInterval > 0 {
+		if sc.refreshTimeout <= 0 {
+			sc.refreshTimeout = sc.refreshInterval
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		sc.refresh = &staleRefresher[K, V]{
+			ctx:      ctx,
+			cancel:   cancel,
+			loaders:  make(map[K]func(context.Context, K) (V, error)),
+			inFlight: make(map[K]struct{}),
+		}
+
+		sc.refresh.wg.Add(1)
+
+		go sc.refreshLoop()
+	}
+
 	return sc
 }
 
+// Close stops background refresh (see [WithBackgroundRefresh]): it cancels
+// in-flight refreshes and waits for the background goroutines to exit. It is
+// safe to call more than once and a no-op without background refresh. Do
+// keeps working after Close, just without proactive refresh.
+func (sc *StaleCache[K, V]) Close() {
+	if sc.refresh == nil {
+		return
+	}
+
+	sc.refresh.cancel()
+	sc.refresh.wg.Wait()
+}
+
 // Do executes fn with the given key. On success, the result is cached.
-// On failure, a cached value is returned if one exists within TTL.
+// On failure, a cached value is returned if one exists within TTL — with a nil
+// error, or wrapped as [ErrServingStale] under [StaleCacheReturnError].
 //
 //nolint:ireturn,revive // generic type parameter V, not an interface; Do
 // matches Policy.Do naming.
@@ -74,7 +242,12 @@ func (sc *StaleCache[K, V]) Do(
 ) (V, error) {
 	result, err := fn(ctx, key)
 	if err == nil {
-		sc.cache.Set(key, result, sc.ttl)
+		sc.servingStale.Store(false)
+		sc.store(key, result, sc.ttl)
+
+		if sc.refresh != nil {
+			sc.refresh.remember(key, fn)
+		}
 
 		if sc.onCacheRefreshed != nil {
 			sc.onCacheRefreshed(key)
@@ -85,10 +258,20 @@ func (sc *StaleCache[K, V]) Do(
 
 	// Failure: check for a cached entry.
 	if cached, ok := sc.cache.Get(key); ok {
+		if sc.bytes != nil {
+			sc.bytes.touch(key)
+		}
+
+		sc.servingStale.Store(true)
+
 		if sc.onStaleServed != nil {
 			sc.onStaleServed(key)
 		}
 
+		if sc.returnError {
+			return cached, fmt.Errorf("%w: %w", ErrServingStale, err)
+		}
+
 		return cached, nil
 	}
 
@@ -97,3 +280,192 @@ func (sc *StaleCache[K, V]) Do(
 
 	return zero, err //nolint:wrapcheck // caller's error returned as-is
 }
+
+// Seed pre-populates key with a known-good value, so a cold start whose very
+// first call fails still has something to serve. The seed is stored as if it
+// had been loaded age ago: it expires after the remaining ttl − age like any
+// other entry, and an age at or past the ttl seeds nothing. A later successful
+// Do replaces it. Seed counts against [MaxBytes] and is not refreshed in the
+// background, having no loader to re-run.
+func (sc *StaleCache[K, V]) Seed(key K, value V, age time.Duration) {
+	remaining := sc.ttl - max(age, 0)
+	if remaining <= 0 {
+		return
+	}
+
+	sc.store(key, value, remaining)
+}
+
+// ServingStale reports whether the most recent Do to complete served a
+// cached value because its call failed, as opposed to returning a fresh
+// result. It is cache-wide, not per key: a success for any key clears it.
+// Use it as a degraded signal in health checks.
+func (sc *StaleCache[K, V]) ServingStale() bool {
+	return sc.servingStale.Load()
+}
+
+// store writes value under key for ttl, first enforcing the [MaxBytes] budget
+// when one is configured. The budget stays locked until the cache holds what it
+// accounted for, so concurrent stores of one key cannot leave the cache holding
+// a value of another size than the budget recorded.
+func (sc *StaleCache[K, V]) store(key K, value V, ttl time.Duration) {
+	if sc.bytes == nil {
+		sc.cache.Set(key, value, ttl)
+
+		return
+	}
+
+	size := sc.sizeOf(value)
+
+	sc.bytes.mu.Lock()
+	defer sc.bytes.mu.Unlock()
+
+	evicted, fits := sc.bytes.admitLocked(key, size)
+	for _, k := range evicted {
+		sc.cache.Delete(k)
+	}
+
+	if fits {
+		sc.cache.Set(key, value, ttl)
+	}
+}
+
+// refreshLoop ticks every refreshInterval until Close, refreshing every
+// remembered key on each tick.
+func (sc *StaleCache[K, V]) refreshLoop() {
+	defer sc.refresh.wg.Done()
+
+	for {
+		timer := sc.clock.NewTimer(sc.refreshInterval)
+
+		select {
+		case <-sc.refresh.ctx.Done():
+			timer.Stop()
+
+			return
+		case <-timer.C():
+		}
+
+		sc.refreshAll()
+	}
+}
+
+// refreshAll starts a background refresh for every remembered key that is
+// still cached and not already refreshing, and forgets keys that have left
+// the cache.
+func (sc *StaleCache[K, V]) refreshAll() {
+	r := sc.refresh
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for key, loader := range r.loaders {
+		if _, busy := r.inFlight[key]; busy {
+			continue
+		}
+
+		if _, cached := sc.cache.Get(key); !cached {
+			delete(r.loaders, key)
+
+			continue
+		}
+
+		r.inFlight[key] = struct{}{}
+		r.wg.Add(1)
+
+		go sc.refreshKey(key, loader)
+	}
+}
+
+// refreshKey re-runs loader for key, bounded by refreshTimeout on the cache's
+// clock, and stores a successful result.
+func (sc *StaleCache[K, V]) refreshKey(
+	key K,
+	loader func(context.Context, K) (V, error),
+) {
+	r := sc.refresh
+
+	defer func() {
+		r.mu.Lock()
+		delete(r.inFlight, key)
+		r.mu.Unlock()
+		r.wg.Done()
+	}()
+
+	ctx, cancel := newBudgetDeadlineCtx(r.ctx, sc.clock, sc.clock.Now().Add(sc.refreshTimeout))
+	defer cancel()
+
+	value, err := loader(ctx, key)
+	if err != nil || ctx.Err() != nil {
+		return
+	}
+
+	sc.store(key, value, sc.ttl)
+
+	if sc.onCacheRefreshed != nil {
+		sc.onCacheRefreshed(key)
+	}
+}
+
+// remember records loader as the function that refreshes key.
+func (r *staleRefresher[K, V]) remember(
+	key K,
+	loader func(context.Context, K) (V, error),
+) {
+	r.mu.Lock()
+	r.loaders[key] = loader
+	r.mu.Unlock()
+}
+
+// newByteBudget returns an empty budget capped at maxBytes.
+func newByteBudget[K comparable](maxBytes int64) *byteBudget[K] {
+	return &byteBudget[K]{
+		lru:   list.New(),
+		index: make(map[K]*list.Element),
+		max:   maxBytes,
+	}
+}
+
+// admitLocked records key at size as most recently used and returns the keys
+// that must be deleted to stay within the budget. When size alone exceeds the
+// budget the key is dropped instead (and returned among the evicted, so a
+// previous value does not linger) and fits is false. Must be called under b.mu.
+func (b *byteBudget[K]) admitLocked(key K, size int64) (evicted []K, fits bool) {
+	size = max(size, 0)
+
+	if elem, ok := b.index[key]; ok {
+		b.total -= elem.Value.(*budgetEntry[K]).size //nolint:forcetypeassert // list holds only *budgetEntry
+		b.lru.Remove(elem)
+		delete(b.index, key)
+	}
+
+	if size > b.max {
+		return []K{key}, false
+	}
+
+	b.index[key] = b.lru.PushFront(&budgetEntry[K]{key: key, size: size})
+	b.total += size
+
+	for b.total > b.max {
+		oldest := b.lru.Back()
+		entry := oldest.Value.(*budgetEntry[K]) //nolint:forcetypeassert // list holds only *budgetEntry
+
+		b.lru.Remove(oldest)
+		delete(b.index, entry.key)
+		b.total -= entry.size
+
+		evicted = append(evicted, entry.key)
+	}
+
+	return evicted, true
+}
+
+// touch marks key as most recently used; unknown keys are ignored.
+func (b *byteBudget[K]) touch(key K) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if elem, ok := b.index[key]; ok {
+		b.lru.MoveToFront(elem)
+	}
+}
@@ -17,14 +17,16 @@ import (
 // Helpers
 // ---------------------------------------------------------------------------
 
-// testCache is a simple in-memory cache for testing.
+// testCache is a simple in-memory cache for testing. It never expires
+// entries but records the TTL each was last set with.
 type testCache[K comparable, V any] struct {
 	mu   sync.RWMutex
 	data map[K]V
+	ttls map[K]time.Duration
 }
 
 func newTestCache[K comparable, V any]() *testCache[K, V] {
-	return &testCache[K, V]{data: make(map[K]V)}
+	return &testCache[K, V]{data: make(map[K]V), ttls: make(map[K]time.Duration)}
 }
 
 func (c *testCache[K, V]) Get(key K) (V, bool) {
@@ -36,11 +38,12 @@ func (c *testCache[K, V]) Get(key K) (V, bool) {
 	return v, ok
 }
 
-func (c *testCache[K, V]) Set(key K, value V, _ time.Duration) {
+func (c *testCache[K, V]) Set(key K, value V, ttl time.Duration) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
 	c.data[key] = value
+	c.ttls[key] = ttl
 }
 
 func (c *testCache[K, V]) Delete(key K) {
@@ -125,6 +128,151 @@ func TestStaleCacheFirstCallFailsNoCacheReturnsError(t *testing.T) {
 	require.Equal(t, 0, result)
 }
 
+// ---------------------------------------------------------------------------
+// Stale serve -> nil error by default, ErrServingStale on request
+// ---------------------------------------------------------------------------
+
+func TestStaleCacheStaleServeErrorModes(t *testing.T) {
+	downstream := errors.New("backend down")
+
+	tests := []struct {
+		name    string
+		opts    []r8e.StaleCacheOption[string, string]
+		wantErr bool
+	}{
+		{name: "default", wantErr: false},
+		{
+			name:    "return error",
+			opts:    []r8e.StaleCacheOption[string, string]{r8e.StaleCacheReturnError[string, string]()},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sc := r8e.NewStaleCache(newTestCache[string, string](), time.Minute, tt.opts...)
+			sc.Seed("user:1", "cached", 0)
+
+			result, err := sc.Do(
+				context.Background(),
+				"user:1",
+				func(_ context.Context, _ string) (string, error) {
+					return "", downstream
+				},
+			)
+			require.Equal(t, "cached", result, "the stale value is served either way")
+
+			if !tt.wantErr {
+				require.NoError(t, err)
+
+				return
+			}
+
+			require.ErrorIs(t, err, r8e.ErrServingStale)
+			require.ErrorIs(t, err, downstream, "the original failure is carried")
+		})
+	}
+}
+
+func TestStaleCacheReturnErrorPassesThroughWithoutEntry(t *testing.T) {
+	downstream := errors.New("backend down")
+	sc := r8e.NewStaleCache(
+		newTestCache[string, string](),
+		time.Minute,
+		r8e.StaleCacheReturnError[string, string](),
+	)
+
+	result, err := sc.Do(
+		context.Background(),
+		"missing",
+		func(_ context.Context, _ string) (string, error) {
+			return "", downstream
+		},
+	)
+	require.Empty(t, result)
+	require.ErrorIs(t, err, downstream)
+	require.NotErrorIs(t, err, r8e.ErrServingStale, "nothing stale was served")
+
+	result, err = sc.Do(
+		context.Background(),
+		"missing",
+		func(_ context.Context, _ string) (string, error) {
+			return "fresh", nil
+		},
+	)
+	require.NoError(t, err, "a success is never reported as stale")
+	require.Equal(t, "fresh", result)
+}
+
+// ---------------------------------------------------------------------------
+// Seed -> first call fails, the seed is served
+// ---------------------------------------------------------------------------
+
+func TestStaleCacheSeedServedOnFirstFailure(t *testing.T) {
+	cache := newTestCache[string, string]()
+	sc := r8e.NewStaleCache(cache, time.Minute)
+
+	sc.Seed("config", "known-good", 0)
+	require.False(t, sc.ServingStale())
+
+	result, err := sc.Do(
+		context.Background(),
+		"config",
+		func(_ context.Context, _ string) (string, error) {
+			return "", errors.New("cold start failure")
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, "known-good", result)
+	require.True(t, sc.ServingStale())
+
+	// A success replaces the seed and clears the stale signal.
+	result, err = sc.Do(
+		context.Background(),
+		"config",
+		func(_ context.Context, _ string) (string, error) {
+			return "fresh", nil
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, "fresh", result)
+	require.False(t, sc.ServingStale())
+	require.Equal(t, time.Minute, cache.ttls["config"])
+}
+
+func TestStaleCacheSeedAgeShortensTTL(t *testing.T) {
+	cache := newTestCache[string, string]()
+	sc := r8e.NewStaleCache(cache, time.Minute)
+
+	sc.Seed("aged", "v", 45*time.Second)
+	require.Equal(t, 15*time.Second, cache.ttls["aged"])
+
+	// An age at or past the ttl would be expired already: nothing is seeded.
+	sc.Seed("expired", "v", time.Minute)
+	sc.Seed("older", "v", time.Hour)
+
+	_, ok := cache.Get("expired")
+	require.False(t, ok)
+
+	_, ok = cache.Get("older")
+	require.False(t, ok)
+}
+
+func TestStaleCacheSeedCountsAgainstMaxBytes(t *testing.T) {
+	cache := newTestCache[string, string]()
+	sc := r8e.NewStaleCache(cache, time.Minute,
+		r8e.MaxBytes[string, string](10, func(v string) int64 { return int64(len(v)) }))
+
+	sc.Seed("a", "123456", 0)
+	sc.Seed("b", "123456", 0) // evicts "a" to fit
+
+	_, ok := cache.Get("a")
+	require.False(t, ok)
+
+	_, ok = cache.Get("b")
+	require.True(t, ok)
+}
+
 // ---------------------------------------------------------------------------
 // Different keys have separate cache entries
 // ---------------------------------------------------------------------------
@@ -454,6 +602,428 @@ func TestStaleCacheKeyPassedToFunction(t *testing.T) {
 	require.Equal(t, "my-key", receivedKey)
 }
 
+// ---------------------------------------------------------------------------
+// MaxBytes: LRU eviction by estimated value size
+// ---------------------------------------------------------------------------
+
+// totalBytes sums the sizes of every value held by cache.
+func (c *testCache[K, V]) totalBytes(sizeOf func(V) int64) int64 {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	var total int64
+	for _, v := range c.data {
+		total += sizeOf(v)
+	}
+
+	return total
+}
+
+// storeBytes runs a successful Do that caches value under key.
+func storeBytes(sc *r8e.StaleCache[string, []byte], key string, value []byte) {
+	_, _ = sc.Do(
+		context.Background(),
+		key,
+		func(_ context.Context, _ string) ([]byte, error) {
+			return value, nil
+		},
+	)
+}
+
+func byteLen(v []byte) int64 { return int64(len(v)) }
+
+func TestStaleCacheMaxBytesEvictsLRU(t *testing.T) {
+	t.Parallel()
+
+	cache := newTestCache[string, []byte]()
+	sc := r8e.NewStaleCache(cache, time.Minute,
+		r8e.MaxBytes[string, []byte](10, byteLen),
+	)
+
+	storeBytes(sc, "a", make([]byte, 4))
+	storeBytes(sc, "b", make([]byte, 4))
+	storeBytes(sc, "c", make([]byte, 4)) // 12 > 10: evicts "a"
+
+	_, ok := cache.Get("a")
+	assert.False(t, ok, "least recently used key must be evicted")
+	_, ok = cache.Get("b")
+	assert.True(t, ok)
+	_, ok = cache.Get("c")
+	assert.True(t, ok)
+	assert.LessOrEqual(t, cache.totalBytes(byteLen), int64(10))
+
+	// Replacing a key with a larger value re-measures it.
+	storeBytes(sc, "c", make([]byte, 8)) // b(4)+c(8) = 12: evicts "b"
+
+	_, ok = cache.Get("b")
+	assert.False(t, ok)
+	assert.Equal(t, int64(8), cache.totalBytes(byteLen))
+}
+
+func TestStaleCacheMaxBytesKeepsRecentlyServed(t *testing.T) {
+	t.Parallel()
+
+	cache := newTestCache[string, []byte]()
+	sc := r8e.NewStaleCache(cache, time.Minute,
+		r8e.MaxBytes[string, []byte](10, byteLen),
+	)
+
+	storeBytes(sc, "a", make([]byte, 4))
+	storeBytes(sc, "b", make([]byte, 4))
+
+	// Serving "a" stale makes it the most recently used.
+	_, err := sc.Do(
+		context.Background(),
+		"a",
+		func(_ context.Context, _ string) ([]byte, error) {
+			return nil, errors.New("down")
+		},
+	)
+	require.NoError(t, err)
+
+	storeBytes(sc, "c", make([]byte, 4))
+
+	_, ok := cache.Get("a")
+	assert.True(t, ok, "recently served key must survive")
+	_, ok = cache.Get("b")
+	assert.False(t, ok)
+	assert.LessOrEqual(t, cache.totalBytes(byteLen), int64(10))
+}
+
+func TestStaleCacheMaxBytesOversizedValueNotCached(t *testing.T) {
+	t.Parallel()
+
+	cache := newTestCache[string, []byte]()
+	sc := r8e.NewStaleCache(cache, time.Minute,
+		r8e.MaxBytes[string, []byte](10, byteLen),
+	)
+
+	storeBytes(sc, "small", make([]byte, 2))
+	storeBytes(sc, "big", make([]byte, 4))
+	storeBytes(sc, "big", make([]byte, 11)) // larger than the whole budget
+
+	_, ok := cache.Get("big")
+	assert.False(t, ok, "an oversized value must not be cached, nor its old one kept")
+	_, ok = cache.Get("small")
+	assert.True(t, ok, "an oversized value must not evict others")
+}
+
+// slowSetCache is a testCache whose Set of a value of slowLen bytes signals
+// setting and then lingers, so a concurrent store can race it.
+type slowSetCache struct {
+	*testCache[string, []byte]
+	setting chan struct{}
+	slowLen int
+}
+
+func (c *slowSetCache) Set(key string, value []byte, ttl time.Duration) {
+	if len(value) == c.slowLen {
+		close(c.setting)
+		time.Sleep(20 * time.Millisecond)
+	}
+
+	c.testCache.Set(key, value, ttl)
+}
+
+func TestStaleCacheMaxBytesConcurrentStoresOfOneKey(t *testing.T) {
+	t.Parallel()
+
+	cache := &slowSetCache{
+		testCache: newTestCache[string, []byte](),
+		setting:   make(chan struct{}),
+		slowLen:   9,
+	}
+	sc := r8e.NewStaleCache(cache, time.Minute,
+		r8e.MaxBytes[string, []byte](10, byteLen),
+	)
+
+	var wg sync.WaitGroup
+
+	wg.Go(func() { storeBytes(sc, "k", make([]byte, 9)) })
+
+	// A second store of "k" lands while the first is still writing it.
+	<-cache.setting
+	storeBytes(sc, "k", make([]byte, 2))
+	wg.Wait()
+
+	// The later store wins in the cache as in the budget.
+	held, _ := cache.Get("k")
+	require.Len(t, held, 2)
+
+	// Fill the rest of the budget: had the cache kept another size of "k"
+	// than the budget recorded, this would overflow it.
+	storeBytes(sc, "other", make([]byte, 8))
+
+	assert.Equal(t, int64(10), cache.totalBytes(byteLen))
+}
+
+// ---------------------------------------------------------------------------
+// WithBackgroundRefresh: proactive reload on the clock
+// ---------------------------------------------------------------------------
+
+// tickClock is a fake clock whose timers fire only when Advance moves time
+// past their deadline.
+type tickClock struct {
+	mu     sync.Mutex
+	now    time.Time
+	timers []*tickTimer
+}
+
+type tickTimer struct {
+	ch       chan time.Time
+	deadline time.Time
+	fired    bool
+	stopped  bool
+}
+
+func newTickClock() *tickClock {
+	return &tickClock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+}
+
+func (c *tickClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+func (c *tickClock) Since(t time.Time) time.Duration { return c.Now().Sub(t) }
+
+//nolint:ireturn // satisfies the r8e.Timer interface by design
+func (c *tickClock) NewTimer(d time.Duration) r8e.Timer {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	tm := &tickTimer{ch: make(chan time.Time, 1), deadline: c.now.Add(d)}
+	c.timers = append(c.timers, tm)
+
+	return &tickTimerHandle{clock: c, timer: tm}
+}
+
+// pending reports how many timers are armed and not yet fired or stopped.
+func (c *tickClock) pending() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	n := 0
+	for _, tm := range c.timers {
+		if !tm.fired && !tm.stopped {
+			n++
+		}
+	}
+
+	return n
+}
+
+// Advance moves time forward by d, firing every timer now due.
+func (c *tickClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+	for _, tm := range c.timers {
+		if !tm.fired && !tm.stopped && !c.now.Before(tm.deadline) {
+			tm.fired = true
+			tm.ch <- c.now
+		}
+	}
+}
+
+type tickTimerHandle struct {
+	clock *tickClock
+	timer *tickTimer
+}
+
+func (h *tickTimerHandle) C() <-chan time.Time { return h.timer.ch }
+
+func (h *tickTimerHandle) Stop() bool {
+	h.clock.mu.Lock()
+	defer h.clock.mu.Unlock()
+
+	was := !h.timer.fired && !h.timer.stopped
+	h.timer.stopped = true
+
+	return was
+}
+
+func (*tickTimerHandle) Reset(time.Duration) bool { return false }
+
+func TestStaleCacheBackgroundRefreshReloadsWithoutDo(t *testing.T) {
+	t.Parallel()
+
+	clk := newTickClock()
+	cache := newTestCache[string, int]()
+
+	var refreshed atomic.Int64
+
+	sc := r8e.NewStaleCache(cache, time.Minute,
+		r8e.WithBackgroundRefresh[string, int](10*time.Second),
+		r8e.StaleCacheClock[string, int](clk),
+		r8e.OnCacheRefreshed[string, int](func(string) { refreshed.Add(1) }),
+	)
+	defer sc.Close()
+
+	var loads atomic.Int64
+
+	loader := func(_ context.Context, _ string) (int, error) {
+		return int(loads.Add(1)), nil
+	}
+
+	v, err := sc.Do(context.Background(), "k", loader)
+	require.NoError(t, err)
+	require.Equal(t, 1, v)
+
+	for want := int64(2); want <= 3; want++ {
+		require.Eventually(t, func() bool { return clk.pending() == 1 }, time.Second, time.Millisecond)
+		clk.Advance(10 * time.Second)
+
+		require.Eventually(t, func() bool {
+			cached, ok := cache.Get("k")
+
+			return ok && int64(cached) == want
+		}, time.Second, time.Millisecond, "loader must run on the tick, without Do")
+	}
+
+	assert.Equal(t, int64(3), loads.Load())
+	assert.Eventually(t, func() bool { return refreshed.Load() == 3 }, time.Second, time.Millisecond)
+}
+
+func TestStaleCacheBackgroundRefreshDoesNotOverlap(t *testing.T) {
+	t.Parallel()
+
+	clk := newTickClock()
+	cache := newTestCache[string, int]()
+	sc := r8e.NewStaleCache(cache, time.Minute,
+		r8e.WithBackgroundRefresh[string, int](time.Second),
+		r8e.BackgroundRefreshTimeout[string, int](time.Hour),
+		r8e.StaleCacheClock[string, int](clk),
+	)
+
+	var (
+		loads, running, maxRunning atomic.Int64
+		release                    = make(chan struct{})
+	)
+
+	loader := func(ctx context.Context, _ string) (int, error) {
+		if loads.Add(1) == 1 {
+			return 0, nil // the foreground Do
+		}
+
+		n := running.Add(1)
+		defer running.Add(-1)
+
+		if n > maxRunning.Load() {
+			maxRunning.Store(n)
+		}
+
+		select {
+		case <-release:
+			return 1, nil
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		}
+	}
+
+	_, err := sc.Do(context.Background(), "k", loader)
+	require.NoError(t, err)
+
+	// Three ticks while the first refresh is still blocked; from the first on,
+	// the blocked refresh's deadline is armed beside the refresh timer.
+	for armed := 1; armed <= 3; armed++ {
+		require.Eventually(t, func() bool { return clk.pending() == min(armed, 2) }, time.Second, time.Millisecond)
+		clk.Advance(time.Second)
+	}
+
+	require.Eventually(t, func() bool { return clk.pending() == 2 }, time.Second, time.Millisecond)
+	assert.Equal(t, int64(2), loads.Load(), "a busy key must be skipped, not refreshed again")
+	assert.Equal(t, int64(1), maxRunning.Load())
+
+	// Close cancels the blocked refresh and waits for it.
+	sc.Close()
+	sc.Close() // idempotent
+	assert.Zero(t, running.Load())
+	close(release)
+}
+
+func TestStaleCacheBackgroundRefreshBoundsStuckLoader(t *testing.T) {
+	t.Parallel()
+
+	clk := newTickClock()
+	cache := newTestCache[string, int]()
+	sc := r8e.NewStaleCache(cache, time.Minute,
+		r8e.WithBackgroundRefresh[string, int](10*time.Second),
+		r8e.BackgroundRefreshTimeout[string, int](5*time.Second),
+		r8e.StaleCacheClock[string, int](clk),
+	)
+	defer sc.Close()
+
+	var loads atomic.Int64
+
+	loader := func(ctx context.Context, _ string) (int, error) {
+		switch n := loads.Add(1); n {
+		case 1:
+			return 1, nil // the foreground Do
+		case 2:
+			<-ctx.Done() // stuck until the refresh deadline
+
+			return 0, ctx.Err()
+		default:
+			return int(n), nil
+		}
+	}
+
+	_, err := sc.Do(context.Background(), "k", loader)
+	require.NoError(t, err)
+
+	// The first tick starts the refresh that blocks; its deadline and the
+	// refresh timer are then both armed.
+	require.Eventually(t, func() bool { return clk.pending() == 1 }, time.Second, time.Millisecond)
+	clk.Advance(10 * time.Second)
+	require.Eventually(t, func() bool { return clk.pending() == 2 }, time.Second, time.Millisecond)
+
+	clk.Advance(5 * time.Second) // the stuck refresh's deadline
+
+	// Later ticks refresh the key again instead of skipping it as busy.
+	require.Eventually(t, func() bool {
+		if clk.pending() == 1 {
+			clk.Advance(5 * time.Second)
+		}
+
+		cached, ok := cache.Get("k")
+
+		return ok && cached >= 3
+	}, time.Second, time.Millisecond, "a stuck refresh must not hold its key until Close")
+}
+
+func TestStaleCacheBackgroundRefreshForgetsEvictedKeys(t *testing.T) {
+	t.Parallel()
+
+	clk := newTickClock()
+	cache := newTestCache[string, int]()
+	sc := r8e.NewStaleCache(cache, time.Minute,
+		r8e.WithBackgroundRefresh[string, int](time.Second),
+		r8e.StaleCacheClock[string, int](clk),
+	)
+	defer sc.Close()
+
+	var loads atomic.Int64
+
+	_, err := sc.Do(context.Background(), "k", func(_ context.Context, _ string) (int, error) {
+		return int(loads.Add(1)), nil
+	})
+	require.NoError(t, err)
+
+	cache.Delete("k") // expired or evicted by the backend
+
+	for range 2 {
+		require.Eventually(t, func() bool { return clk.pending() == 1 }, time.Second, time.Millisecond)
+		clk.Advance(time.Second)
+	}
+
+	require.Eventually(t, func() bool { return clk.pending() == 1 }, time.Second, time.Millisecond)
+	assert.Equal(t, int64(1), loads.Load(), "a key no longer cached is not refreshed")
+}
+
 // ---------------------------------------------------------------------------
 // Benchmark: concurrent Do calls that hit cache
 // ---------------------------------------------------------------------------
@@ -483,3 +1053,39 @@ func BenchmarkStaleCacheHit(b *testing.B) {
 		}
 	})
 }
+
+// ---------------------------------------------------------------------------
+// Wrapping a policy: its own rejections serve stale too
+// ---------------------------------------------------------------------------
+
+func TestStaleCacheServesStaleOnPolicyRejection(t *testing.T) {
+	t.Parallel()
+
+	policy := r8e.NewPolicy[string]("",
+		r8e.WithCircuitBreaker(r8e.FailureThreshold(1), r8e.RecoveryTimeout(time.Hour)),
+	)
+	sc := r8e.NewStaleCache(newTestCache[string, string](), time.Minute)
+
+	call := func(fn func(context.Context) (string, error)) (string, error) {
+		return sc.Do(context.Background(), "key1",
+			func(ctx context.Context, _ string) (string, error) {
+				return policy.Do(ctx, fn)
+			})
+	}
+
+	_, err := call(func(context.Context) (string, error) { return "cached-value", nil })
+	require.NoError(t, err)
+
+	// Trip the breaker.
+	_, err = call(func(context.Context) (string, error) { return "", errors.New("down") })
+	require.NoError(t, err)
+
+	ran := false
+	result, err := call(func(context.Context) (string, error) {
+		ran = true
+		return "fresh", nil
+	})
+	require.NoError(t, err, "ErrCircuitOpen is replaced by the stale value")
+	require.Equal(t, "cached-value", result)
+	require.False(t, ran)
+}
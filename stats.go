@@ -0,0 +1,82 @@
+package r8e
+
+type (
+	// PolicyStats is a compact set of lifetime counters of a policy — what it
+	// was asked to do and how the calls ended — for callers that want a few
+	// numbers without a metrics backend. [Policy.Metrics] carries the full set
+	// together with live state.
+	PolicyStats struct {
+		// Calls counts every Do and DoWithResult, including calls rejected
+		// before fn ran. Once calls have quiesced it equals Successes +
+		// Failures; the counters are read one at a time, so a snapshot taken
+		// while calls are finishing may be briefly off by those calls.
+		Calls int64 `json:"calls"`
+		// Successes counts calls that returned a nil error, including ones
+		// rescued by a retry, a hedge, a fallback or a stale value.
+		Successes int64 `json:"successes"`
+		// Failures counts calls that returned an error.
+		Failures         int64 `json:"failures"`
+		Retries          int64 `json:"retries"`
+		Timeouts         int64 `json:"timeouts"`
+		RateLimited      int64 `json:"rate_limited"`
+		BulkheadRejected int64 `json:"bulkhead_rejected"`
+		HedgesFired      int64 `json:"hedges_fired"`
+		FallbacksUsed    int64 `json:"fallbacks_used"`
+		// StaleServed counts results served past their freshness — by the cache
+		// (see [StaleIfError]) or by [WithLastGood] — in place of an error.
+		StaleServed int64 `json:"stale_served"`
+	}
+
+	// statsReporter is implemented by every [Policy]; [Registry.AllStats] uses
+	// it to collect stats across policies with different type parameters.
+	statsReporter interface {
+		Name() string
+		Stats() PolicyStats
+	}
+)
+
+// Stats returns the policy's lifetime counters. The counters are maintained
+// internally whatever hooks are set, and reading them takes no lock.
+func (p *Policy[T]) Stats() PolicyStats {
+	return PolicyStats{
+		Calls:            p.metrics.calls.Load(),
+		Successes:        p.metrics.successes.Load(),
+		Failures:         p.metrics.failures.Load(),
+		Retries:          p.metrics.retries.Load(),
+		Timeouts:         p.metrics.timeouts.Load(),
+		RateLimited:      p.metrics.rateLimited.Load(),
+		BulkheadRejected: p.metrics.bulkheadRejected.Load(),
+		HedgesFired:      p.metrics.hedgesTriggered.Load(),
+		FallbacksUsed:    p.metrics.fallbacksUsed.Load(),
+		StaleServed:      p.metrics.cacheStaleServed.Load(),
+	}
+}
+
+// AllStats returns the [PolicyStats] of every registered policy, keyed by
+// policy name. Like [Registry.Snapshot] it takes no locks on the read path.
+func (r *Registry) AllStats() map[string]PolicyStats {
+	reporters := r.allReporters()
+
+	out := make(map[string]PolicyStats, len(reporters))
+
+	for _, hr := range reporters {
+		if sr, ok := hr.(statsReporter); ok {
+			out[sr.Name()] = sr.Stats()
+		}
+	}
+
+	return out
+}
+
+// recordCall counts one finished call and whether it failed.
+func (m *policyMetrics) recordCall(err error) {
+	m.calls.Add(1)
+
+	if err != nil {
+		m.failures.Add(1)
+
+		return
+	}
+
+	m.successes.Add(1)
+}
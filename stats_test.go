@@ -0,0 +1,109 @@
+package r8e
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicyStatsCountsMixedCalls(t *testing.T) {
+	p := NewPolicy[string]("",
+		WithRetry(2, ConstantBackoff(time.Millisecond)),
+	)
+
+	ok := func(context.Context) (string, error) { return "ok", nil }
+	fail := func(context.Context) (string, error) { return "", errors.New("down") }
+
+	for range 3 {
+		_, err := p.Do(context.Background(), ok)
+		require.NoError(t, err)
+	}
+
+	for range 2 {
+		_, err := p.Do(context.Background(), fail)
+		require.Error(t, err)
+	}
+
+	// A call rescued by its retry is a success.
+	_, err := p.Do(context.Background(), flakyFn(1))
+	require.NoError(t, err)
+
+	assert.Equal(t, PolicyStats{
+		Calls:     6,
+		Successes: 4,
+		Failures:  2,
+		Retries:   3, // one per failing call, one for the rescued call
+	}, p.Stats())
+}
+
+func TestPolicyStatsPatternCounters(t *testing.T) {
+	ok := func(context.Context) (string, error) { return "ok", nil }
+	fail := func(context.Context) (string, error) { return "", errors.New("down") }
+
+	served := NewPolicy[string]("",
+		WithClock(newPolicyClock()),
+		WithFallback("default"),
+		WithLastGood(),
+	)
+
+	// Nothing remembered yet: the fallback answers.
+	got, err := served.Do(context.Background(), fail)
+	require.NoError(t, err)
+	assert.Equal(t, "default", got)
+
+	_, err = served.Do(context.Background(), ok)
+	require.NoError(t, err)
+
+	// Now the remembered result answers, ahead of the fallback.
+	got, err = served.Do(context.Background(), fail)
+	require.NoError(t, err)
+	assert.Equal(t, "ok", got)
+
+	assert.Equal(t, PolicyStats{
+		Calls:         3,
+		Successes:     3,
+		FallbacksUsed: 1,
+		StaleServed:   1,
+	}, served.Stats())
+
+	limited := NewPolicy[string]("",
+		WithClock(newPolicyClock()),
+		WithRateLimit(1),
+	)
+
+	_, err = limited.Do(context.Background(), ok)
+	require.NoError(t, err)
+
+	_, err = limited.Do(context.Background(), ok)
+	require.ErrorIs(t, err, ErrRateLimited)
+
+	assert.Equal(t, PolicyStats{
+		Calls:       2,
+		Successes:   1,
+		Failures:    1,
+		RateLimited: 1,
+	}, limited.Stats())
+}
+
+func TestRegistryAllStats(t *testing.T) {
+	reg := NewRegistry()
+
+	alpha := NewPolicy[string]("alpha", WithRegistry(reg))
+	_ = NewPolicy[int]("beta", WithRegistry(reg))
+
+	_, err := alpha.Do(context.Background(), func(context.Context) (string, error) {
+		return "ok", nil
+	})
+	require.NoError(t, err)
+
+	all := reg.AllStats()
+	require.Len(t, all, 2)
+	assert.Equal(t, PolicyStats{Calls: 1, Successes: 1}, all["alpha"])
+	assert.Equal(t, PolicyStats{}, all["beta"])
+
+	assert.Empty(t, NewRegistry().AllStats())
+}
@@ -0,0 +1,58 @@
+package r8e
+
+// ---------------------------------------------------------------------------
+// PolicyTemplate[T] — resolve options once, instantiate many policies
+// ---------------------------------------------------------------------------.
+
+// PolicyTemplate is a pre-resolved policy configuration from which many
+// independent policies can be instantiated cheaply — typically one per tenant,
+// shard, or downstream host sharing the same resilience settings.
+//
+// [NewPolicyTemplate] applies and validates the options once; [PolicyTemplate.New]
+// then skips option processing and builds the pattern chain into a slice sized
+// up-front. Every instance gets fresh runtime state — its own circuit breaker,
+// rate limiter, bulkhead, adaptive limiters, reloadable cells, metrics, and
+// latency window — so one tenant tripping its breaker never affects another.
+// The immutable configuration (strategies, options, hooks, clock) is shared.
+//
+// Budgets created by [WithRetryBudget] and [WithConcurrencyBudget] are rebuilt
+// per instance, just as separate [NewPolicy] calls would create separate ones.
+// Objects handed to the options are shared: a budget attached with
+// [WithSharedRetryBudget] or [WithSharedConcurrencyBudget], a [Cache], or a set
+// of [DependsOn] reporters is common to every instance.
+//
+// A PolicyTemplate is immutable and safe for concurrent use.
+type PolicyTemplate[T any] struct {
+	setup    policySetup
+	entryCap int
+}
+
+// NewPolicyTemplate resolves opts into a reusable template. It panics on the
+// same misconfigurations as [NewPolicy], once, at template construction.
+func NewPolicyTemplate[T any](opts ...Option) *PolicyTemplate[T] {
+	setup := resolveSetup(opts)
+
+	return &PolicyTemplate[T]{
+		setup:    setup,
+		entryCap: setup.patternCount(),
+	}
+}
+
+// New instantiates a policy named name from the template, with fresh runtime
+// state. Like [NewPolicy], a named policy auto-registers with the template's
+// registry (or [DefaultRegistry] if none was given).
+func (t *PolicyTemplate[T]) New(name string) *Policy[T] {
+	setup := t.setup
+
+	if setup.ownedRetryBudget != nil {
+		setup.retryBudget = NewRetryBudget(setup.ownedRetryBudget...)
+	}
+
+	if setup.ownedConcurrencyBudget != nil {
+		setup.concurrencyBudget = NewConcurrencyBudget(
+			setup.ownedConcurrencyBudget...,
+		)
+	}
+
+	return buildPolicy[T](name, &setup, t.entryCap)
+}
@@ -0,0 +1,152 @@
+package r8e
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ---------------------------------------------------------------------------
+// PolicyTemplate — independent runtime state, shared configuration
+// ---------------------------------------------------------------------------
+
+func TestPolicyTemplateInstancesAreIndependent(t *testing.T) {
+	t.Parallel()
+
+	reg := NewRegistry()
+	tmpl := NewPolicyTemplate[string](
+		WithClock(&stubClock{now: time.Now()}),
+		WithRegistry(reg),
+		WithCircuitBreaker(FailureThreshold(1), RecoveryTimeout(time.Hour)),
+		WithRateLimit(1),
+		WithBulkhead(1),
+	)
+
+	tenantA := tmpl.New("tenant-a")
+	tenantB := tmpl.New("tenant-b")
+
+	require.NotSame(t, tenantA.circuitBreaker, tenantB.circuitBreaker)
+	require.NotSame(t, tenantA.rateLimiter, tenantB.rateLimiter)
+	require.NotSame(t, tenantA.bulkhead, tenantB.bulkhead)
+
+	// Trip tenant A's breaker; tenant B keeps serving with its own token.
+	_, err := tenantA.Do(context.Background(), func(_ context.Context) (string, error) {
+		return "", errors.New("boom")
+	})
+	require.Error(t, err)
+	require.Equal(t, CircuitOpen, tenantA.circuitBreaker.State())
+
+	got, err := tenantB.Do(context.Background(), func(_ context.Context) (string, error) {
+		return "ok", nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "ok", got)
+	assert.Equal(t, CircuitClosed, tenantB.circuitBreaker.State())
+
+	// Metrics are per instance.
+	assert.Equal(t, int64(1), tenantA.Metrics().CircuitOpens)
+	assert.Zero(t, tenantB.Metrics().CircuitOpens)
+
+	// Both instances registered under their own names.
+	names := make([]string, 0, 2)
+	for _, ps := range reg.CheckReadiness().Policies {
+		names = append(names, ps.Name)
+	}
+	assert.ElementsMatch(t, []string{"tenant-a", "tenant-b"}, names)
+}
+
+func TestPolicyTemplateMatchesNewPolicy(t *testing.T) {
+	t.Parallel()
+
+	opts := []Option{
+		WithTimeout(time.Second),
+		WithRetry(3, ConstantBackoff(0)),
+		WithFallback("fb"),
+	}
+
+	fromTemplate := NewPolicyTemplate[string](opts...).New("")
+	direct := NewPolicy[string]("", opts...)
+
+	var calls [2]int
+
+	for i, p := range []*Policy[string]{fromTemplate, direct} {
+		got, err := p.Do(context.Background(), func(_ context.Context) (string, error) {
+			calls[i]++
+
+			return "", errors.New("down")
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "fb", got)
+	}
+
+	assert.Equal(t, 3, calls[0])
+	assert.Equal(t, calls[1], calls[0])
+}
+
+func TestPolicyTemplateBudgets(t *testing.T) {
+	t.Parallel()
+
+	shared := NewRetryBudget()
+
+	owned := NewPolicyTemplate[string](
+		WithRetry(2, ConstantBackoff(0)),
+		WithRetryBudget(),
+		WithConcurrencyBudget(),
+	)
+	a, b := owned.New(""), owned.New("")
+	assert.NotSame(t, a.retryBudget, b.retryBudget)
+	assert.NotSame(t, a.concurrencyBudget, b.concurrencyBudget)
+
+	sharing := NewPolicyTemplate[string](
+		WithRetry(2, ConstantBackoff(0)),
+		WithSharedRetryBudget(shared),
+	)
+	assert.Same(t, shared, sharing.New("").retryBudget)
+	assert.Same(t, shared, sharing.New("").retryBudget)
+}
+
+func TestPolicyTemplateValidatesOnce(t *testing.T) {
+	t.Parallel()
+
+	require.PanicsWithValue(t, ErrRetryBudgetWithoutRetry, func() {
+		NewPolicyTemplate[string](WithRetryBudget())
+	})
+}
+
+// BenchmarkPolicyTemplate compares repeated NewPolicy against instantiating
+// from a template with the same configuration:
+//
+//	go test -run '^$' -bench '^BenchmarkPolicyTemplate$' -benchmem ./
+func BenchmarkPolicyTemplate(b *testing.B) {
+	opts := func() []Option {
+		return []Option{
+			WithTimeout(time.Second),
+			WithRetry(3, ExponentialBackoff(10*time.Millisecond)),
+			WithCircuitBreaker(FailureThreshold(5)),
+			WithRateLimit(100),
+			WithBulkhead(10),
+			WithFallback("fb"),
+		}
+	}
+
+	b.Run("NewPolicy", func(b *testing.B) {
+		b.ReportAllocs()
+
+		for b.Loop() {
+			_ = NewPolicy[string]("", opts()...)
+		}
+	})
+
+	b.Run("PolicyTemplate.New", func(b *testing.B) {
+		b.ReportAllocs()
+
+		tmpl := NewPolicyTemplate[string](opts()...)
+		for b.Loop() {
+			_ = tmpl.New("")
+		}
+	})
+}
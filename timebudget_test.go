@@ -991,8 +991,10 @@ func TestWithTimeBudgetRespectInboundIgnoredWhenOff(t *testing.T) {
 	clk := newBudgetClock()
 	exceeded := 0
 
-	// Same inbound deadline, but WITHOUT RespectInboundDeadline the gate ignores
-	// it: the hour-long configured budget governs, so every attempt runs.
+	// Same inbound deadline, but WITHOUT RespectInboundDeadline the budget gate
+	// ignores it: the hour-long configured budget never fires. Retry still
+	// checks the context deadline itself and skips a retry that cannot start in
+	// time, returning the bare downstream error.
 	policy := NewPolicy[string]("ignore-inbound",
 		WithClock(clk),
 		WithHooks(&Hooks{OnTimeBudgetExceeded: func() { exceeded++ }}),
@@ -1009,11 +1011,12 @@ func TestWithTimeBudgetRespectInboundIgnoredWhenOff(t *testing.T) {
 		return "", Transient(errors.New("down"))
 	})
 
-	require.ErrorIs(t, err, ErrRetriesExhausted)
+	require.Error(t, err)
 	assert.NotErrorIs(t, err, ErrTimeBudgetExceeded)
-	assert.Equal(t, 2, attempts,
-		"without RespectInboundDeadline the inbound deadline is ignored")
-	assert.Zero(t, exceeded)
+	assert.NotErrorIs(t, err, ErrRetriesExhausted)
+	assert.Equal(t, 1, attempts, "retry's own deadline check stops the sequence")
+	assert.Zero(t, exceeded,
+		"without RespectInboundDeadline the budget ignores the inbound deadline")
 }
 
 func TestWithTimeBudgetRespectInboundIgnoresLaterInbound(t *testing.T) {
@@ -1120,10 +1123,13 @@ func TestReconfigureRespectInboundDeadline(t *testing.T) {
 		return attempts, err
 	}
 
-	// OFF: the inbound deadline is ignored, retries run to exhaustion.
+	// OFF: the budget ignores the inbound deadline; only retry's own deadline
+	// check stops the sequence, with the bare downstream error.
 	attempts, err := run()
-	require.ErrorIs(t, err, ErrRetriesExhausted)
-	assert.Equal(t, 3, attempts)
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, ErrTimeBudgetExceeded)
+	assert.Equal(t, 1, attempts)
+	assert.Zero(t, exceeded)
 
 	// Turn it ON via Reconfigure — the inbound deadline now gates the retry.
 	on := true
@@ -1134,13 +1140,16 @@ func TestReconfigureRespectInboundDeadline(t *testing.T) {
 	assert.Equal(t, 1, attempts)
 	assert.Positive(t, exceeded)
 
-	// Turn it back OFF — the inbound deadline is ignored again.
+	// Turn it back OFF — the budget ignores the inbound deadline again.
 	off := false
 	require.NoError(t, policy.Reconfigure(PolicyConfig{RespectInboundDeadline: &off}))
 
+	before := exceeded
 	attempts, err = run()
-	require.ErrorIs(t, err, ErrRetriesExhausted)
-	assert.Equal(t, 3, attempts)
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, ErrTimeBudgetExceeded)
+	assert.Equal(t, 1, attempts)
+	assert.Equal(t, before, exceeded)
 }
 
 func TestReconfigureRespectInboundDeadlineAbsent(t *testing.T) {
@@ -2,6 +2,7 @@ package r8e
 
 import (
 	"context"
+	"errors"
 	"sync/atomic"
 	"time"
 )
@@ -20,11 +21,23 @@ type (
 
 	// timeoutConfig collects the optional [WithTimeout] settings before the policy
 	// builds the timeout middleware. adaptive is non-nil once [AdaptiveTimeout] was
-	// passed.
+	// passed; cooperative is set by [CooperativeTimeout] and partial by
+	// [PartialTimeout].
 	timeoutConfig struct {
-		adaptive *adaptiveTimeoutConfig
+		adaptive    *adaptiveTimeoutConfig
+		cooperative bool
+		partial     bool
 	}
 
+	// timeoutRunner is the shape shared by [DoTimeout] and
+	// [DoCooperativeTimeout], so the timeout middleware can run either.
+	timeoutRunner[T any] func(
+		ctx context.Context,
+		timeout time.Duration,
+		fn func(context.Context) (T, error),
+		hooks *Hooks,
+	) (T, error)
+
 	// AdaptiveTimeoutOption configures percentile-driven adaptive timeout (see
 	// [AdaptiveTimeout]).
 	AdaptiveTimeoutOption func(*adaptiveTimeoutConfig)
@@ -93,8 +106,9 @@ func DoTimeout[T any](
 		return zero, ctx.Err() //nolint:wrapcheck // preserving context error identity
 	}
 
-	// Create derived context with timeout.
-	timeoutCtx, cancel := context.WithTimeout(ctx, timeout)
+	// Create derived context with timeout. Its cause marks the deadline as the
+	// policy's own, which the adaptive limiter counts as a drop.
+	timeoutCtx, cancel := context.WithTimeoutCause(ctx, timeout, ErrTimeout)
 	defer cancel()
 
 	// Run fn in a goroutine and collect result via channel.
@@ -127,6 +141,193 @@ func DoTimeout[T any](
 	}
 }
 
+// DoCooperativeTimeout executes fn with a timeout on the calling goroutine. It
+// hands fn a context carrying the deadline and relies on fn to honor it: when
+// fn returns an error after the deadline has passed, ErrTimeout is returned.
+// Unlike [DoTimeout] no goroutine is spawned, so nothing can leak, but a fn that
+// ignores its context is not interrupted — the call lasts as long as fn does
+// and its result, successful or not, is returned as is unless the deadline
+// expired with an error.
+//
+//nolint:ireturn // generic type parameter T, not an interface
+func DoCooperativeTimeout[T any](
+	ctx context.Context,
+	timeout time.Duration,
+	fn func(context.Context) (T, error),
+	hooks *Hooks,
+) (T, error) {
+	return doCooperativeTimeout(ctx, timeout, fn, hooks, false)
+}
+
+// DoPartialTimeout executes fn with a timeout on the calling goroutine, like
+// [DoCooperativeTimeout], but keeps what fn managed to produce: when fn returns
+// after the deadline with an error matching [ErrTimeout] or
+// context.DeadlineExceeded — typically ctx.Err() once it sees ctx.Done() — its
+// value is returned alongside ErrTimeout instead of the zero value. Any other
+// error after the deadline is reported as (zero, ErrTimeout), and a call that
+// succeeds returns its own result. fn MUST respect ctx: the call lasts as long
+// as fn does.
+//
+//nolint:ireturn // generic type parameter T, not an interface
+func DoPartialTimeout[T any](
+	ctx context.Context,
+	timeout time.Duration,
+	fn func(context.Context) (T, error),
+	hooks *Hooks,
+) (T, error) {
+	return doCooperativeTimeout(ctx, timeout, fn, hooks, true)
+}
+
+// doCooperativeTimeout is the shared body of [DoCooperativeTimeout] and
+// [DoPartialTimeout]. keepPartial keeps fn's value when it stopped at the
+// deadline with a deadline error; otherwise a timed-out call returns the zero
+// value.
+//
+//nolint:ireturn,revive // generic type parameter T; keepPartial selects the runner
+func doCooperativeTimeout[T any](
+	ctx context.Context,
+	timeout time.Duration,
+	fn func(context.Context) (T, error),
+	hooks *Hooks,
+	keepPartial bool,
+) (T, error) {
+	var zero T
+
+	// If the parent context is already done, return its error immediately.
+	if ctx.Err() != nil {
+		return zero, ctx.Err() //nolint:wrapcheck // preserving context error identity
+	}
+
+	timeoutCtx, cancel := context.WithTimeoutCause(ctx, timeout, ErrTimeout)
+	defer cancel()
+
+	result, err := fn(timeoutCtx)
+	if err == nil || timeoutCtx.Err() == nil {
+		return result, err
+	}
+
+	// Same attribution as DoTimeout: a done parent means external cancellation.
+	if ctx.Err() != nil {
+		return zero, ctx.Err() //nolint:wrapcheck // preserving context error identity
+	}
+
+	hooks.emitTimeout()
+
+	if keepPartial &&
+		(errors.Is(err, ErrTimeout) || errors.Is(err, context.DeadlineExceeded)) {
+		return result, ErrTimeout
+	}
+
+	return zero, ErrTimeout
+}
+
+// DoTimeoutSoftHard executes fn with a two-phase timeout. Once soft has elapsed
+// on clock it emits OnSoftTimeout and lets fn carry on; once hard has elapsed it
+// cancels fn and returns [ErrTimeout] exactly as [DoTimeout] does. A call that
+// finishes between the two returns its own result. A non-positive soft, or one
+// not below hard, never fires.
+//
+//nolint:ireturn // generic type parameter T, not an interface
+func DoTimeoutSoftHard[T any](
+	ctx context.Context,
+	soft, hard time.Duration,
+	fn func(context.Context) (T, error),
+	clock Clock,
+	hooks *Hooks,
+) (T, error) {
+	if soft <= 0 || soft >= hard {
+		return DoTimeout(ctx, hard, fn, hooks)
+	}
+
+	return DoTimeout(ctx, hard, watchSoftTimeout(fn, soft, clock, hooks), hooks)
+}
+
+// watchSoftTimeout wraps fn so that OnSoftTimeout fires if it is still running
+// once soft has elapsed on clock. The watch runs on its own goroutine, which
+// exits as soon as fn returns.
+func watchSoftTimeout[T any](
+	fn func(context.Context) (T, error),
+	soft time.Duration,
+	clock Clock,
+	hooks *Hooks,
+) func(context.Context) (T, error) {
+	return func(ctx context.Context) (T, error) {
+		timer := clock.NewTimer(soft)
+		done := make(chan struct{})
+
+		go func() {
+			select {
+			case <-timer.C():
+				hooks.emitSoftTimeout()
+			case <-done:
+			}
+		}()
+
+		defer func() {
+			timer.Stop()
+			close(done)
+		}()
+
+		return fn(ctx)
+	}
+}
+
+// withSoftTimeout returns run with fn watched for the soft timeout, so the
+// soft phase composes with either runner and with [AdaptiveTimeout].
+func withSoftTimeout[T any](run timeoutRunner[T], soft time.Duration, clock Clock) timeoutRunner[T] {
+	return func(
+		ctx context.Context,
+		timeout time.Duration,
+		fn func(context.Context) (T, error),
+		hooks *Hooks,
+	) (T, error) {
+		return run(ctx, timeout, watchSoftTimeout(fn, soft, clock, hooks), hooks)
+	}
+}
+
+// CooperativeTimeout runs the [WithTimeout] pattern without a goroutine: the
+// call runs on the caller's goroutine with a context carrying the deadline, and
+// a call that fails once the deadline has passed reports [ErrTimeout] (see
+// [DoCooperativeTimeout]). It saves a goroutine and a channel per call and
+// cannot leak, but fn MUST respect ctx: one that ignores cancellation blocks
+// the caller until it returns on its own. It combines with [AdaptiveTimeout].
+// Under [WithTimeoutSoftHard] the soft phase still watches each call from a
+// goroutine and a timer of its own, which exit when the call returns.
+func CooperativeTimeout() TimeoutOption {
+	return func(cfg *timeoutConfig) {
+		cfg.cooperative = true
+	}
+}
+
+// PartialTimeout makes the [WithTimeout] pattern keep a timed-out call's
+// partial result: fn runs on the caller's goroutine as with
+// [CooperativeTimeout], and when it stops at the deadline returning its value
+// with ctx.Err() (or an error matching [ErrTimeout]), the policy returns that
+// value together with [ErrTimeout] rather than the zero value (see
+// [DoPartialTimeout]). Use it for streaming or aggregating calls whose output
+// so far is still useful. fn MUST respect ctx. Patterns outside the timeout
+// see the error as usual — a retry wrapping it retries, a fallback replaces
+// the value — so the partial value reaches the caller only when nothing
+// recovers the call.
+func PartialTimeout() TimeoutOption {
+	return func(cfg *timeoutConfig) {
+		cfg.partial = true
+	}
+}
+
+// runnerFor returns the timeout runner for the configured mode.
+func runnerFor[T any](cooperative, partial bool) timeoutRunner[T] {
+	if partial {
+		return DoPartialTimeout[T]
+	}
+
+	if cooperative {
+		return DoCooperativeTimeout[T]
+	}
+
+	return DoTimeout[T]
+}
+
 // AdaptiveTimeout enables percentile-driven adaptive timeout on a [WithTimeout]
 // pattern. Instead of always bounding a call at the fixed duration d, the policy
 // bounds it at clamp(percentile-latency × multiplier, floor, d), recomputed from a
@@ -3,6 +3,8 @@ package r8e_test
 import (
 	"context"
 	"errors"
+	"fmt"
+	"runtime"
 	"sync/atomic"
 	"testing"
 	"testing/synctest"
@@ -338,6 +340,413 @@ func TestDoTimeoutOnTimeoutHookNotFiredOnParentCancel(t *testing.T) {
 	require.False(t, hookCalled.Load())
 }
 
+// ---------------------------------------------------------------------------
+// Tests: Cooperative timeout -> no goroutine, relies on fn honoring ctx
+// ---------------------------------------------------------------------------
+
+// Not parallel: it counts goroutines, which parallel tests would perturb.
+func TestDoCooperativeTimeoutSpawnsNoGoroutine(t *testing.T) {
+	var spawned atomic.Int64
+
+	count := func(_ context.Context) (string, error) {
+		spawned.Store(int64(runtime.NumGoroutine()))
+
+		return "ok", nil
+	}
+
+	before := runtime.NumGoroutine()
+	result, err := r8e.DoCooperativeTimeout[string](context.Background(), time.Second, count, nil)
+	require.NoError(t, err)
+	require.Equal(t, "ok", result)
+	require.LessOrEqual(t, spawned.Load(), int64(before), "fn ran on the caller's goroutine")
+
+	// The same counter sees the goroutine the racing timeout starts.
+	before = runtime.NumGoroutine()
+	_, err = r8e.DoTimeout[string](context.Background(), time.Second, count, nil)
+	require.NoError(t, err)
+	require.Greater(t, spawned.Load(), int64(before))
+
+	p := r8e.NewPolicy[string]("cooperative-goroutines",
+		r8e.WithTimeout(time.Second, r8e.CooperativeTimeout()))
+
+	before = runtime.NumGoroutine()
+	_, err = p.Do(context.Background(), count)
+	require.NoError(t, err)
+	require.LessOrEqual(t, spawned.Load(), int64(before))
+}
+
+func TestDoCooperativeTimeoutHonoringFnTimesOut(t *testing.T) {
+	t.Parallel()
+
+	synctest.Test(t, func(t *testing.T) {
+		var timeouts atomic.Int64
+
+		p := r8e.NewPolicy[string]("cooperative-timeout",
+			r8e.WithTimeout(10*time.Millisecond, r8e.CooperativeTimeout()),
+			r8e.WithHooks(&r8e.Hooks{OnTimeout: func() { timeouts.Add(1) }}),
+		)
+
+		start := time.Now()
+		result, err := p.Do(context.Background(), func(ctx context.Context) (string, error) {
+			<-ctx.Done()
+
+			return "late", ctx.Err()
+		})
+
+		require.ErrorIs(t, err, r8e.ErrTimeout)
+		require.Empty(t, result)
+		require.Equal(t, 10*time.Millisecond, time.Since(start))
+		require.Equal(t, int64(1), timeouts.Load())
+	})
+}
+
+func TestDoCooperativeTimeoutIgnoringFnBlocks(t *testing.T) {
+	t.Parallel()
+
+	// The documented caveat: a fn that ignores ctx is not interrupted, so the
+	// caller waits for it and gets its result.
+	synctest.Test(t, func(t *testing.T) {
+		start := time.Now()
+		result, err := r8e.DoCooperativeTimeout[string](
+			context.Background(),
+			10*time.Millisecond,
+			func(_ context.Context) (string, error) {
+				time.Sleep(100 * time.Millisecond)
+
+				return "done", nil
+			},
+			nil,
+		)
+
+		require.NoError(t, err)
+		require.Equal(t, "done", result)
+		require.Equal(t, 100*time.Millisecond, time.Since(start))
+	})
+}
+
+func TestDoCooperativeTimeoutParentCancelled(t *testing.T) {
+	t.Parallel()
+
+	var hookCalled atomic.Bool
+
+	hooks := &r8e.Hooks{OnTimeout: func() { hookCalled.Store(true) }}
+	ctx, cancel := context.WithCancel(context.Background())
+
+	_, err := r8e.DoCooperativeTimeout[string](ctx, time.Second,
+		func(ctx context.Context) (string, error) {
+			cancel()
+
+			return "", ctx.Err()
+		},
+		hooks,
+	)
+
+	require.ErrorIs(t, err, context.Canceled)
+	require.NotErrorIs(t, err, r8e.ErrTimeout)
+	require.False(t, hookCalled.Load())
+}
+
+// ---------------------------------------------------------------------------
+// Tests: Partial results on timeout
+// ---------------------------------------------------------------------------
+
+// collectUntilDone returns a function gathering one item per millisecond until
+// its context is done, then returning what it has with ctx.Err().
+func collectUntilDone() func(context.Context) ([]int, error) {
+	return func(ctx context.Context) ([]int, error) {
+		var items []int
+
+		ticker := time.NewTicker(time.Millisecond)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return items, ctx.Err()
+			case <-ticker.C:
+				items = append(items, len(items))
+			}
+		}
+	}
+}
+
+func TestPartialTimeoutPolicyReturnsPartialValue(t *testing.T) {
+	t.Parallel()
+
+	synctest.Test(t, func(t *testing.T) {
+		var timeouts atomic.Int64
+
+		p := r8e.NewPolicy[[]int]("partial-timeout",
+			r8e.WithTimeout(5*time.Millisecond, r8e.PartialTimeout()),
+			r8e.WithHooks(&r8e.Hooks{OnTimeout: func() { timeouts.Add(1) }}),
+		)
+
+		result, err := p.Do(context.Background(), collectUntilDone())
+
+		require.ErrorIs(t, err, r8e.ErrTimeout)
+		require.Equal(t, []int{0, 1, 2, 3, 4}, result)
+		require.Equal(t, int64(1), timeouts.Load())
+	})
+}
+
+func TestPartialTimeoutWithoutOptionDiscardsValue(t *testing.T) {
+	t.Parallel()
+
+	synctest.Test(t, func(t *testing.T) {
+		p := r8e.NewPolicy[[]int]("plain-timeout",
+			r8e.WithTimeout(5*time.Millisecond, r8e.CooperativeTimeout()))
+
+		result, err := p.Do(context.Background(), collectUntilDone())
+
+		require.ErrorIs(t, err, r8e.ErrTimeout)
+		require.Nil(t, result)
+	})
+}
+
+func TestDoPartialTimeout(t *testing.T) {
+	t.Parallel()
+
+	errBoom := errors.New("boom")
+
+	tests := []struct {
+		name    string
+		fn      func(context.Context) (string, error)
+		want    string
+		wantErr error
+	}{
+		{
+			name: "success",
+			fn: func(context.Context) (string, error) {
+				return "full", nil
+			},
+			want: "full",
+		},
+		{
+			name: "error before deadline",
+			fn: func(context.Context) (string, error) {
+				return "ignored", errBoom
+			},
+			want:    "ignored",
+			wantErr: errBoom,
+		},
+		{
+			name: "deadline exceeded keeps value",
+			fn: func(ctx context.Context) (string, error) {
+				<-ctx.Done()
+
+				return "partial", ctx.Err()
+			},
+			want:    "partial",
+			wantErr: r8e.ErrTimeout,
+		},
+		{
+			name: "ErrTimeout keeps value",
+			fn: func(ctx context.Context) (string, error) {
+				<-ctx.Done()
+
+				return "partial", fmt.Errorf("stream: %w", r8e.ErrTimeout)
+			},
+			want:    "partial",
+			wantErr: r8e.ErrTimeout,
+		},
+		{
+			name: "other error after deadline drops value",
+			fn: func(ctx context.Context) (string, error) {
+				<-ctx.Done()
+
+				return "garbled", errBoom
+			},
+			wantErr: r8e.ErrTimeout,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			synctest.Test(t, func(t *testing.T) {
+				result, err := r8e.DoPartialTimeout(
+					context.Background(), 10*time.Millisecond, tt.fn, nil,
+				)
+
+				require.ErrorIs(t, err, tt.wantErr)
+				require.Equal(t, tt.want, result)
+			})
+		})
+	}
+}
+
+func TestDoPartialTimeoutParentCancelled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	result, err := r8e.DoPartialTimeout[string](ctx, time.Second,
+		func(ctx context.Context) (string, error) {
+			cancel()
+
+			return "partial", ctx.Err()
+		},
+		nil,
+	)
+
+	require.ErrorIs(t, err, context.Canceled)
+	require.Empty(t, result)
+}
+
+// ---------------------------------------------------------------------------
+// Tests: Two-phase timeout (soft warning + hard cancel)
+// ---------------------------------------------------------------------------
+
+// sleepFor returns a function that sleeps d then succeeds with "done".
+func sleepFor(d time.Duration) func(context.Context) (string, error) {
+	return func(context.Context) (string, error) {
+		time.Sleep(d)
+
+		return "done", nil
+	}
+}
+
+func TestTimeoutSoftHardFinishesBetweenThresholds(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		name string
+		opts []r8e.TimeoutOption
+	}{
+		{name: "goroutine"},
+		{name: "cooperative", opts: []r8e.TimeoutOption{r8e.CooperativeTimeout()}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			synctest.Test(t, func(t *testing.T) {
+				var soft, hard atomic.Int64
+
+				p := r8e.NewPolicy[string]("",
+					r8e.WithTimeoutSoftHard(10*time.Millisecond, 100*time.Millisecond, tt.opts...),
+					r8e.WithHooks(&r8e.Hooks{
+						OnSoftTimeout: func() { soft.Add(1) },
+						OnTimeout:     func() { hard.Add(1) },
+					}),
+				)
+
+				result, err := p.Do(context.Background(), sleepFor(50*time.Millisecond))
+
+				require.NoError(t, err)
+				require.NotErrorIs(t, err, r8e.ErrTimeout)
+				require.Equal(t, "done", result)
+				require.Equal(t, int64(1), soft.Load())
+				require.Equal(t, int64(0), hard.Load())
+				require.Equal(t, int64(1), p.Metrics().SoftTimeouts)
+			})
+		})
+	}
+}
+
+func TestTimeoutSoftHardFastCallFiresNothing(t *testing.T) {
+	t.Parallel()
+
+	synctest.Test(t, func(t *testing.T) {
+		var soft atomic.Int64
+
+		p := r8e.NewPolicy[string]("",
+			r8e.WithTimeoutSoftHard(10*time.Millisecond, 100*time.Millisecond),
+			r8e.WithHooks(&r8e.Hooks{OnSoftTimeout: func() { soft.Add(1) }}),
+		)
+
+		result, err := p.Do(context.Background(), sleepFor(5*time.Millisecond))
+		require.NoError(t, err)
+		require.Equal(t, "done", result)
+
+		// The watch is gone with the call: nothing fires later either.
+		time.Sleep(time.Second)
+		require.Equal(t, int64(0), soft.Load())
+	})
+}
+
+func TestTimeoutSoftHardPastHardCancels(t *testing.T) {
+	t.Parallel()
+
+	synctest.Test(t, func(t *testing.T) {
+		var soft, hard atomic.Int64
+
+		p := r8e.NewPolicy[string]("",
+			r8e.WithTimeoutSoftHard(10*time.Millisecond, 100*time.Millisecond),
+			r8e.WithHooks(&r8e.Hooks{
+				OnSoftTimeout: func() { soft.Add(1) },
+				OnTimeout:     func() { hard.Add(1) },
+			}),
+		)
+
+		start := time.Now()
+		_, err := p.Do(context.Background(), func(ctx context.Context) (string, error) {
+			<-ctx.Done()
+
+			return "", ctx.Err()
+		})
+
+		require.ErrorIs(t, err, r8e.ErrTimeout)
+		require.Equal(t, 100*time.Millisecond, time.Since(start))
+		require.Equal(t, int64(1), soft.Load())
+		require.Equal(t, int64(1), hard.Load())
+	})
+}
+
+func TestDoTimeoutSoftHard(t *testing.T) {
+	t.Parallel()
+
+	synctest.Test(t, func(t *testing.T) {
+		var soft atomic.Int64
+
+		hooks := &r8e.Hooks{OnSoftTimeout: func() { soft.Add(1) }}
+
+		result, err := r8e.DoTimeoutSoftHard(context.Background(),
+			10*time.Millisecond, 100*time.Millisecond,
+			sleepFor(20*time.Millisecond), r8e.RealClock{}, hooks)
+		require.NoError(t, err)
+		require.Equal(t, "done", result)
+		require.Equal(t, int64(1), soft.Load())
+
+		// A soft threshold not below hard never fires.
+		_, err = r8e.DoTimeoutSoftHard(context.Background(),
+			100*time.Millisecond, 100*time.Millisecond,
+			sleepFor(50*time.Millisecond), r8e.RealClock{}, hooks)
+		require.NoError(t, err)
+		require.Equal(t, int64(1), soft.Load())
+	})
+}
+
+func TestTimeoutSoftHardRejectsSoftNotBelowHard(t *testing.T) {
+	t.Parallel()
+
+	for _, soft := range []time.Duration{0, -time.Second, time.Second, 2 * time.Second} {
+		require.PanicsWithValue(t, r8e.ErrSoftTimeoutNotBelowHard, func() {
+			r8e.NewPolicy[string]("", r8e.WithTimeoutSoftHard(soft, time.Second))
+		}, "soft=%v", soft)
+	}
+}
+
+func TestWithTimeoutClearsSoftTimeout(t *testing.T) {
+	t.Parallel()
+
+	synctest.Test(t, func(t *testing.T) {
+		var soft atomic.Int64
+
+		p := r8e.NewPolicy[string]("",
+			r8e.WithTimeoutSoftHard(10*time.Millisecond, 100*time.Millisecond),
+			r8e.WithHooks(&r8e.Hooks{OnSoftTimeout: func() { soft.Add(1) }}),
+		)
+
+		// A later WithTimeout replaces the two-phase timeout with a plain one.
+		plain := p.With("", r8e.WithTimeout(100*time.Millisecond))
+
+		_, err := plain.Do(context.Background(), sleepFor(50*time.Millisecond))
+		require.NoError(t, err)
+		require.Equal(t, int64(0), soft.Load())
+	})
+}
+
 // ---------------------------------------------------------------------------
 // Benchmark
 // ---------------------------------------------------------------------------
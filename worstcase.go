@@ -0,0 +1,112 @@
+package r8e
+
+import (
+	"math"
+	"time"
+)
+
+// unboundedDuration is what [Policy.WorstCaseDuration] reports when nothing
+// in the policy caps how long a call can run.
+const unboundedDuration = time.Duration(math.MaxInt64)
+
+// WorstCaseDuration returns an upper bound on how long one [Policy.Do] call can
+// take, so a caller orchestrating several policy-backed calls can budget an
+// overall deadline before starting them. It reflects any [Policy.Reconfigure].
+//
+// The bound is the tightest of:
+//   - the [WithTimeout] duration (the ceiling, under [AdaptiveTimeout]);
+//   - the [WithTimeBudget] budget, when [PropagateDeadline] makes it a hard
+//     deadline (a cooperative budget can be overrun by the attempt in flight);
+//   - for [WithRetry] with a [PerAttemptTimeout], every attempt timing out plus
+//     the longest backoff before each retry (capped by [MaxDelay]), or, with
+//     [MaxElapsedTime], that cap plus one per-attempt timeout — in both cases
+//     after the longest [InitialJitter] wait.
+//
+// A policy with none of these lets fn run for as long as it likes, and the
+// result is math.MaxInt64. A server Retry-After hint or a [BackoffFromError]
+// delay lengthens a backoff only up to [MaxDelay] and is otherwise not accounted
+// for, nor are waits in a blocking rate limiter or bulkhead queue unless a
+// timeout or hard budget bounds them.
+func (p *Policy[T]) WorstCaseDuration() time.Duration {
+	bound := p.retryWorstCase()
+
+	if p.timeout != nil {
+		bound = min(bound, time.Duration(p.timeout.Load()))
+	}
+
+	if p.timeBudget != nil {
+		if state := p.timeBudget.Load(); state.propagateDeadline {
+			bound = min(bound, state.budget)
+		}
+	}
+
+	return bound
+}
+
+// retryWorstCase bounds the retry loop: attempts × per-attempt timeout plus the
+// backoffs between them, tightened by MaxElapsedTime, after the initial jitter
+// (which MaxElapsedTime does not count). Without a per-attempt timeout (or
+// without retry) a single attempt is unbounded.
+func (p *Policy[T]) retryWorstCase() time.Duration {
+	if p.retry == nil {
+		return unboundedDuration
+	}
+
+	rt := p.retry.Load()
+
+	var cfg retryConfig
+	for _, opt := range rt.opts {
+		opt(&cfg)
+	}
+
+	if cfg.perAttemptTimeout <= 0 {
+		return unboundedDuration
+	}
+
+	attempts := max(rt.maxAttempts, 1)
+	total := saturatingMul(cfg.perAttemptTimeout, attempts)
+
+	for attempt := range attempts - 1 {
+		delay := backoffCeiling(rt.strategy, attempt)
+		if cfg.maxDelay > 0 {
+			delay = min(delay, cfg.maxDelay)
+		}
+
+		total = saturatingAdd(total, delay)
+	}
+
+	if cfg.maxElapsed > 0 {
+		total = min(total, saturatingAdd(cfg.maxElapsed, cfg.perAttemptTimeout))
+	}
+
+	return saturatingAdd(total, max(cfg.initialJitter, 0))
+}
+
+// backoffCeiling returns the longest delay strategy can produce before the
+// given retry attempt. Jittered strategies report their upper bound; any other
+// strategy is asked for its delay.
+func backoffCeiling(strategy BackoffStrategy, attempt int) time.Duration {
+	switch jitter := strategy.(type) {
+	case *exponentialJitterBackoff:
+		return clampDuration(float64(jitter.base) * math.Pow(2, float64(attempt)))
+	case *jitterBackoff:
+		return clampDuration(float64(jitter.base) * math.Pow(2, float64(attempt)))
+	default:
+		return max(strategy.Delay(attempt), 0)
+	}
+}
+
+// saturatingAdd returns a+b for non-negative durations, clamped to
+// math.MaxInt64 instead of wrapping.
+func saturatingAdd(a, b time.Duration) time.Duration {
+	if a > unboundedDuration-b {
+		return unboundedDuration
+	}
+
+	return a + b
+}
+
+// saturatingMul returns d×n for non-negative values, clamped to math.MaxInt64.
+func saturatingMul(d time.Duration, n int) time.Duration {
+	return clampDuration(float64(d) * float64(n))
+}
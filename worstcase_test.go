@@ -0,0 +1,135 @@
+package r8e
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorstCaseDuration(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		opts []Option
+		want time.Duration
+	}{
+		{
+			name: "no bound",
+			opts: []Option{WithRetry(3, ConstantBackoff(time.Second))},
+			want: time.Duration(math.MaxInt64),
+		},
+		{
+			name: "timeout only",
+			opts: []Option{WithTimeout(2 * time.Second)},
+			want: 2 * time.Second,
+		},
+		{
+			// 4 × 1s attempts + 100ms + 200ms + 400ms backoffs.
+			name: "retry with per-attempt timeout",
+			opts: []Option{WithRetry(4, ExponentialBackoff(100*time.Millisecond),
+				PerAttemptTimeout(time.Second))},
+			want: 4700 * time.Millisecond,
+		},
+		{
+			// 4 × 1s attempts + 100ms + 150ms + 150ms backoffs.
+			name: "max delay caps backoffs",
+			opts: []Option{WithRetry(4, ExponentialBackoff(100*time.Millisecond),
+				PerAttemptTimeout(time.Second), MaxDelay(150*time.Millisecond))},
+			want: 4400 * time.Millisecond,
+		},
+		{
+			// 3 × 500ms attempts + the jitter ceilings 100ms + 200ms.
+			name: "jitter counts its ceiling",
+			opts: []Option{WithRetry(3, ExponentialJitterBackoff(100*time.Millisecond),
+				PerAttemptTimeout(500*time.Millisecond))},
+			want: 1800 * time.Millisecond,
+		},
+		{
+			// 3 × 500ms attempts + the full-jitter ceilings 100ms + 200ms.
+			name: "full jitter counts its ceiling",
+			opts: []Option{WithRetry(3, FullJitterBackoff(100*time.Millisecond),
+				PerAttemptTimeout(500*time.Millisecond))},
+			want: 1800 * time.Millisecond,
+		},
+		{
+			// 4 × 500ms attempts + the equal-jitter ceilings 100ms + 150ms +
+			// 150ms under MaxDelay.
+			name: "equal jitter ceiling capped by max delay",
+			opts: []Option{WithRetry(4, EqualJitterBackoff(100*time.Millisecond),
+				PerAttemptTimeout(500*time.Millisecond), MaxDelay(150*time.Millisecond))},
+			want: 2400 * time.Millisecond,
+		},
+		{
+			// 250ms initial jitter + 2 × 1s attempts + 100ms backoff.
+			name: "initial jitter",
+			opts: []Option{WithRetry(2, ConstantBackoff(100*time.Millisecond),
+				PerAttemptTimeout(time.Second), InitialJitter(250*time.Millisecond))},
+			want: 2350 * time.Millisecond,
+		},
+		{
+			// 250ms initial jitter + min(10 × 500ms + 9 × 100ms, 1s + 500ms).
+			name: "initial jitter outside max elapsed time",
+			opts: []Option{WithRetry(10, ConstantBackoff(100*time.Millisecond),
+				PerAttemptTimeout(500*time.Millisecond), MaxElapsedTime(time.Second),
+				InitialJitter(250*time.Millisecond))},
+			want: 1750 * time.Millisecond,
+		},
+		{
+			// min(10 × 500ms + 9 × 100ms, 1s + 500ms).
+			name: "max elapsed time",
+			opts: []Option{WithRetry(10, ConstantBackoff(100*time.Millisecond),
+				PerAttemptTimeout(500*time.Millisecond), MaxElapsedTime(time.Second))},
+			want: 1500 * time.Millisecond,
+		},
+		{
+			name: "timeout tighter than retry",
+			opts: []Option{
+				WithTimeout(3 * time.Second),
+				WithRetry(4, ExponentialBackoff(100*time.Millisecond),
+					PerAttemptTimeout(time.Second)),
+			},
+			want: 3 * time.Second,
+		},
+		{
+			name: "propagated time budget",
+			opts: []Option{
+				WithTimeout(5 * time.Second),
+				WithTimeBudget(2*time.Second, PropagateDeadline()),
+				WithRetry(3, ConstantBackoff(time.Second)),
+			},
+			want: 2 * time.Second,
+		},
+		{
+			name: "cooperative time budget is not a bound",
+			opts: []Option{
+				WithTimeout(5 * time.Second),
+				WithTimeBudget(2 * time.Second),
+				WithRetry(3, ConstantBackoff(time.Second)),
+			},
+			want: 5 * time.Second,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			p := NewPolicy[string]("worst-case", tt.opts...)
+			assert.Equal(t, tt.want, p.WorstCaseDuration())
+		})
+	}
+}
+
+func TestWorstCaseDurationReflectsReconfigure(t *testing.T) {
+	t.Parallel()
+
+	p := NewPolicy[string]("worst-case-reload", WithTimeout(time.Second))
+	assert.Equal(t, time.Second, p.WorstCaseDuration())
+
+	timeout := "250ms"
+	assert.NoError(t, p.Reconfigure(PolicyConfig{Timeout: &timeout}))
+	assert.Equal(t, 250*time.Millisecond, p.WorstCaseDuration())
+}